@@ -9,8 +9,46 @@ describe("Create an event", () => {
     cy.get(".moj-alert").should("not.exist");
     cy.get("#f-type").select("Application processing");
     cy.get("#f-name").type("Something");
-    cy.get("#f-description").type("More words");
+    // the notes use a rich text editor, which can only be typed into
+    // through its iframe
+    cy.get("iframe[id=f-description_ifr]")
+      .its("0.contentDocument.body")
+      .should("not.be.empty")
+      .then(cy.wrap)
+      .type("More words");
     cy.get("button[type=submit]").click();
     cy.get(".moj-alert").should("exist");
   });
+
+  it("creates an event with attachments", () => {
+    cy.get("#f-type").select("Application processing");
+    cy.get("#f-name").type("Something");
+    cy.get("iframe[id=f-description_ifr]")
+      .its("0.contentDocument.body")
+      .should("not.be.empty")
+      .then(cy.wrap)
+      .type("More words");
+    cy.get("#f-file").selectFile([
+      { contents: Cypress.Buffer.from("Hello there\n"), fileName: "words.txt" },
+      {
+        contents: Cypress.Buffer.from("Hello again\n"),
+        fileName: "more-words.txt",
+      },
+    ]);
+    cy.get("button[type=submit]").click();
+    cy.get(".moj-alert").should("exist");
+  });
+
+  it("rejects attachments that are not allowed", () => {
+    cy.get("#f-type").select("Application processing");
+    cy.get("#f-name").type("Something");
+    cy.get("#f-file").selectFile({
+      contents: Cypress.Buffer.from([0x4d, 0x5a, 0x90, 0x00]),
+      fileName: "program.exe",
+    });
+    cy.get("button[type=submit]").click();
+    cy.get(".govuk-error-summary").contains(
+      "program.exe must be a PDF, Word, Excel, email, image or text file",
+    );
+  });
 });
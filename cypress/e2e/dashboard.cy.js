@@ -82,6 +82,11 @@ describe("The Digital LPA Dashboard", () => {
       expect(elts).to.contain("Another task");
       expect(elts).to.contain("Reassign task");
     });
+    cy.get("[data-role=bulk-task-actions]").should((elts) => {
+      expect(elts).to.contain("Assign selected");
+      expect(elts).to.contain("Change due date");
+      expect(elts).to.contain("Clear selected");
+    });
   });
 
   it("shows warnings list", () => {
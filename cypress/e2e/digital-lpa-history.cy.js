@@ -169,6 +169,36 @@ describe("View digital LPA history", () => {
         hash: "IE",
         source: "sirius",
       },
+      {
+        uuid: "9d0a3c59-8e5b-4a8e-a1c4-0f6c1d3e2b7a",
+        owningCase: {
+          id: 111,
+          uId: "M-NN8A-XMHL-GF69",
+          caseSubtype: "personal-welfare",
+          caseType: "DIGITAL_LPA",
+        },
+        user: {
+          id: 51,
+          phoneNumber: "12345678",
+          teams: [],
+          displayName: "system admin",
+          deleted: false,
+          email: "system.admin@opgtest.com",
+        },
+        sourceType: "Note",
+        type: "INS",
+        changeSet: [],
+        entity: {
+          _class: "Opg\\Core\\Model\\Entity\\Note\\Note",
+          name: "Phone call",
+          description:
+            "<p><strong>Caller:</strong> Anne Barlow</p><ul><li>Asked about fees</li></ul><script>alert(1)</script>",
+          type: "Phone call",
+        },
+        createdOn: "2025-12-17T09:30:00+00:00",
+        hash: "IM",
+        source: "sirius",
+      },
     ];
 
     const mocks = Promise.allSettled([
@@ -200,4 +230,12 @@ describe("View digital LPA history", () => {
     cy.contains("Created: Task");
     cy.contains("Name: Print and post donor form");
   });
+
+  it("shows formatted notes", () => {
+    cy.contains("Created: Note");
+    cy.get(".app-rich-text strong").contains("Caller:");
+    cy.get(".app-rich-text li").contains("Asked about fees");
+    cy.get(".app-rich-text").should("not.contain", "<strong>");
+    cy.get(".app-rich-text script").should("not.exist");
+  });
 });
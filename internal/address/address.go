@@ -0,0 +1,133 @@
+package address
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+type Kind int
+
+const (
+	KindUK Kind = iota
+	KindBFPO
+	KindOverseas
+)
+
+// ukCountries are the values used for Country on UK addresses, both as country
+// codes from the LPA store and as free text entered on older forms
+var ukCountries = map[string]bool{
+	"":                 true,
+	"GB":               true,
+	"UK":               true,
+	"UNITED KINGDOM":   true,
+	"GREAT BRITAIN":    true,
+	"ENGLAND":          true,
+	"WALES":            true,
+	"SCOTLAND":         true,
+	"NORTHERN IRELAND": true,
+}
+
+// KindOf works out whether an address is in the UK, a British Forces Post
+// Office address, or overseas
+func KindOf(a sirius.Address) Kind {
+	if IsBFPO(a.Postcode) {
+		return KindBFPO
+	}
+
+	if !ukCountries[strings.ToUpper(clean(a.Country))] {
+		return KindOverseas
+	}
+
+	return KindUK
+}
+
+// Normalise trims and collapses whitespace in every line of an address, upper
+// cases the postcode and country code, and puts valid UK postcodes and BFPO
+// numbers in their canonical form. Anything it cannot make sense of is left
+// for Validate to report.
+func Normalise(a sirius.Address) sirius.Address {
+	a.Line1 = clean(a.Line1)
+	a.Line2 = clean(a.Line2)
+	a.Line3 = clean(a.Line3)
+	a.Town = clean(a.Town)
+	a.Postcode = strings.ToUpper(clean(a.Postcode))
+
+	if country := clean(a.Country); len(country) == 2 {
+		a.Country = strings.ToUpper(country)
+	} else {
+		a.Country = country
+	}
+
+	switch KindOf(a) {
+	case KindUK:
+		if postcode, err := NormalisePostcode(a.Postcode); err == nil {
+			a.Postcode = postcode
+		}
+	case KindBFPO:
+		if postcode, err := NormaliseBFPO(a.Postcode); err == nil {
+			a.Postcode = postcode
+		}
+	}
+
+	return a
+}
+
+// Validate checks the postcode of a normalised address, returning
+// ErrInvalidPostcode or ErrInvalidBFPO if it is not in the right format.
+// Overseas postcodes and missing postcodes are not checked.
+func Validate(a sirius.Address) error {
+	if a.Postcode == "" {
+		return nil
+	}
+
+	switch KindOf(a) {
+	case KindUK:
+		_, err := NormalisePostcode(a.Postcode)
+		return err
+	case KindBFPO:
+		_, err := NormaliseBFPO(a.Postcode)
+		return err
+	}
+
+	return nil
+}
+
+// MatchesLookup reports whether a manually entered address is one of the
+// results of a postcode lookup. Case, punctuation and spacing are ignored.
+func MatchesLookup(a sirius.Address, results []sirius.PostcodeLookupAddress) bool {
+	postcode, _ := NormalisePostcode(a.Postcode)
+	line1 := comparable(a.Line1)
+	lines := comparable(a.Line1 + " " + a.Line2 + " " + a.Line3)
+
+	for _, result := range results {
+		if resultPostcode, _ := NormalisePostcode(result.Postcode); resultPostcode != postcode {
+			continue
+		}
+
+		if comparable(result.Line1) == line1 || comparable(result.Line1+" "+result.Line2+" "+result.Line3) == lines {
+			return true
+		}
+	}
+
+	return false
+}
+
+func clean(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func comparable(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		if unicode.IsSpace(r) || r == ',' {
+			return ' '
+		}
+		return -1
+	}, s)
+
+	return clean(s)
+}
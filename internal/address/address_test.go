@@ -0,0 +1,86 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKindOf(t *testing.T) {
+	testCases := map[string]struct {
+		address sirius.Address
+		kind    Kind
+	}{
+		"no country":     {address: sirius.Address{Postcode: "SW1A 1AA"}, kind: KindUK},
+		"country code":   {address: sirius.Address{Postcode: "SW1A 1AA", Country: "GB"}, kind: KindUK},
+		"country name":   {address: sirius.Address{Postcode: "BT1 3AA", Country: "Northern Ireland"}, kind: KindUK},
+		"BFPO":           {address: sirius.Address{Postcode: "BFPO 123", Country: "GB"}, kind: KindBFPO},
+		"overseas code":  {address: sirius.Address{Postcode: "75001", Country: "FR"}, kind: KindOverseas},
+		"overseas name":  {address: sirius.Address{Postcode: "S7R 9F9", Country: "Canada"}, kind: KindOverseas},
+		"lowercase code": {address: sirius.Address{Country: "gb"}, kind: KindUK},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.kind, KindOf(tc.address))
+		})
+	}
+}
+
+func TestNormalise(t *testing.T) {
+	testCases := map[string]struct {
+		address  sirius.Address
+		expected sirius.Address
+	}{
+		"UK": {
+			address:  sirius.Address{Line1: "  1  High Street ", Line2: " ", Town: "London ", Postcode: "sw1a1aa", Country: "gb"},
+			expected: sirius.Address{Line1: "1 High Street", Town: "London", Postcode: "SW1A 1AA", Country: "GB"},
+		},
+		"invalid UK postcode is upper cased only": {
+			address:  sirius.Address{Line1: "1 High Street", Postcode: "sw1a ", Country: "GB"},
+			expected: sirius.Address{Line1: "1 High Street", Postcode: "SW1A", Country: "GB"},
+		},
+		"BFPO": {
+			address:  sirius.Address{Line1: "Unit 1", Postcode: "bfpo  12", Country: "GB"},
+			expected: sirius.Address{Line1: "Unit 1", Postcode: "BFPO 12", Country: "GB"},
+		},
+		"overseas": {
+			address:  sirius.Address{Line1: "1 Rue de Rivoli ", Town: "Paris", Postcode: " 75001", Country: "fr"},
+			expected: sirius.Address{Line1: "1 Rue de Rivoli", Town: "Paris", Postcode: "75001", Country: "FR"},
+		},
+		"overseas country name": {
+			address:  sirius.Address{Postcode: "s7r 9f9", Country: " Canada "},
+			expected: sirius.Address{Postcode: "S7R 9F9", Country: "Canada"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Normalise(tc.address))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Validate(sirius.Address{Postcode: "SW1A 1AA", Country: "GB"}))
+	assert.Nil(t, Validate(sirius.Address{Country: "GB"}))
+	assert.Nil(t, Validate(sirius.Address{Postcode: "BFPO 12"}))
+	assert.Nil(t, Validate(sirius.Address{Postcode: "S7R 9F9", Country: "Canada"}))
+	assert.Equal(t, ErrInvalidPostcode, Validate(sirius.Address{Postcode: "SW1A", Country: "GB"}))
+	assert.Equal(t, ErrInvalidBFPO, Validate(sirius.Address{Postcode: "BFPO ABC"}))
+}
+
+func TestMatchesLookup(t *testing.T) {
+	results := []sirius.PostcodeLookupAddress{
+		{Line1: "Flat 1", Line2: "10 High Street", Town: "London", Postcode: "SW1A 1AA"},
+		{Line1: "2, High Street", Town: "London", Postcode: "SW1A 1AA"},
+	}
+
+	assert.True(t, MatchesLookup(sirius.Address{Line1: "2 High Street", Postcode: "sw1a1aa"}, results))
+	assert.True(t, MatchesLookup(sirius.Address{Line1: "FLAT 1", Line2: "10 High St.", Postcode: "SW1A 1AA"}, results))
+	assert.True(t, MatchesLookup(sirius.Address{Line1: "Flat 1, 10 High Street", Postcode: "SW1A 1AA"}, results))
+	assert.False(t, MatchesLookup(sirius.Address{Line1: "3 High Street", Postcode: "SW1A 1AA"}, results))
+	assert.False(t, MatchesLookup(sirius.Address{Line1: "2 High Street", Postcode: "SW1A 2AA"}, results))
+	assert.False(t, MatchesLookup(sirius.Address{Line1: "2 High Street", Postcode: "SW1A 1AA"}, nil))
+}
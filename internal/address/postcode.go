@@ -0,0 +1,46 @@
+package address
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrInvalidPostcode = errors.New("postcode is not a valid UK postcode")
+	ErrInvalidBFPO     = errors.New("BFPO number is not valid")
+)
+
+var (
+	// postcodePattern matches a UK postcode with the spaces removed, following
+	// the format published by the Office for National Statistics
+	postcodePattern = regexp.MustCompile(`^(GIR0AA|[A-PR-UWYZ]([0-9]{1,2}|[A-HK-Y][0-9]{1,2}|[0-9][A-HJKPS-UW]|[A-HK-Y][0-9][ABEHMNPRVWXY])[0-9][ABD-HJLNP-UW-Z]{2})$`)
+	bfpoPattern     = regexp.MustCompile(`^BFPO ?([0-9]{1,4})$`)
+)
+
+// NormalisePostcode returns a UK postcode in its canonical form, in upper case
+// with a single space before the inward code, e.g. "SW1A 1AA"
+func NormalisePostcode(postcode string) (string, error) {
+	compact := strings.ToUpper(strings.Join(strings.Fields(postcode), ""))
+	if !postcodePattern.MatchString(compact) {
+		return "", ErrInvalidPostcode
+	}
+
+	return compact[:len(compact)-3] + " " + compact[len(compact)-3:], nil
+}
+
+// IsBFPO reports whether postcode is a British Forces Post Office number
+// rather than a postcode
+func IsBFPO(postcode string) bool {
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(postcode)), "BFPO")
+}
+
+// NormaliseBFPO returns a BFPO number in its canonical form, e.g. "BFPO 123"
+func NormaliseBFPO(postcode string) (string, error) {
+	match := bfpoPattern.FindStringSubmatch(strings.ToUpper(strings.Join(strings.Fields(postcode), " ")))
+	if match == nil {
+		return "", ErrInvalidBFPO
+	}
+
+	return "BFPO " + match[1], nil
+}
@@ -0,0 +1,62 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalisePostcode(t *testing.T) {
+	testCases := map[string]string{
+		"SW1A 1AA":    "SW1A 1AA",
+		"sw1a1aa":     "SW1A 1AA",
+		" SW1A  1AA ": "SW1A 1AA",
+		"M1 1AE":      "M1 1AE",
+		"b33 8th":     "B33 8TH",
+		"CR2 6XH":     "CR2 6XH",
+		"DN55 1PT":    "DN55 1PT",
+		"W1A 0AX":     "W1A 0AX",
+		"EC1A 1BB":    "EC1A 1BB",
+		"GIR 0AA":     "GIR 0AA",
+		"BT1 3AA":     "BT1 3AA",
+	}
+
+	for postcode, expected := range testCases {
+		t.Run(postcode, func(t *testing.T) {
+			normalised, err := NormalisePostcode(postcode)
+
+			assert.Nil(t, err)
+			assert.Equal(t, expected, normalised)
+		})
+	}
+}
+
+func TestNormalisePostcodeInvalid(t *testing.T) {
+	for _, postcode := range []string{"", "SW1A", "12345", "QA1 1AA", "SW1A 1AAA", "SW1A 1CA", "S7R 9F9"} {
+		t.Run(postcode, func(t *testing.T) {
+			_, err := NormalisePostcode(postcode)
+
+			assert.Equal(t, ErrInvalidPostcode, err)
+		})
+	}
+}
+
+func TestBFPO(t *testing.T) {
+	assert.True(t, IsBFPO("bfpo 123"))
+	assert.True(t, IsBFPO(" BFPO"))
+	assert.False(t, IsBFPO("B1 1AA"))
+
+	normalised, err := NormaliseBFPO(" bfpo  123 ")
+	assert.Nil(t, err)
+	assert.Equal(t, "BFPO 123", normalised)
+
+	normalised, err = NormaliseBFPO("BFPO4")
+	assert.Nil(t, err)
+	assert.Equal(t, "BFPO 4", normalised)
+
+	_, err = NormaliseBFPO("BFPO 12345")
+	assert.Equal(t, ErrInvalidBFPO, err)
+
+	_, err = NormaliseBFPO("BFPO")
+	assert.Equal(t, ErrInvalidBFPO, err)
+}
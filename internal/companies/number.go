@@ -0,0 +1,75 @@
+package companies
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrNumberLength = errors.New("company number must be 8 characters")
+	ErrNumberPrefix = errors.New("company number must be 8 digits or a known prefix followed by 6 digits")
+)
+
+// prefixes are the Companies House prefixes for registers other than
+// companies in England and Wales, which are numbered with 8 digits
+var prefixes = map[string]bool{
+	"AC": true, // assurance companies
+	"CE": true, // charitable incorporated organisations
+	"CS": true, // Scottish charitable incorporated organisations
+	"FC": true, // overseas companies
+	"GE": true, // European economic interest groupings
+	"IP": true, // industrial and provident societies
+	"LP": true, // limited partnerships
+	"NC": true, // Northern Ireland charitable incorporated organisations
+	"NF": true, // Northern Ireland overseas companies
+	"NI": true, // Northern Ireland companies
+	"NL": true, // Northern Ireland limited partnerships
+	"NO": true, // Northern Ireland other companies
+	"NP": true, // Northern Ireland industrial and provident societies
+	"OC": true, // limited liability partnerships
+	"R0": true, // Northern Ireland companies registered before partition
+	"RC": true, // royal charter companies
+	"SC": true, // Scottish companies
+	"SE": true, // European public limited-liability companies
+	"SF": true, // Scottish overseas companies
+	"SL": true, // Scottish limited partnerships
+	"SO": true, // Scottish limited liability partnerships
+	"SP": true, // Scottish industrial and provident societies
+	"SR": true, // Scottish royal charter companies
+	"ZC": true, // unregistered companies
+}
+
+// NormaliseNumber checks number is a Companies House registration number and
+// returns it in its canonical form: upper case, without spaces, and padded with
+// leading zeros if it was entered as a shorter plain number
+func NormaliseNumber(number string) (string, error) {
+	number = strings.ToUpper(strings.Join(strings.Fields(number), ""))
+
+	if number != "" && isDigits(number) && len(number) < 8 {
+		number = strings.Repeat("0", 8-len(number)) + number
+	}
+
+	if len(number) != 8 {
+		return "", ErrNumberLength
+	}
+
+	if isDigits(number) {
+		return number, nil
+	}
+
+	if !prefixes[number[:2]] || !isDigits(number[2:]) {
+		return "", ErrNumberPrefix
+	}
+
+	return number, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
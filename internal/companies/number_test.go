@@ -0,0 +1,36 @@
+package companies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormaliseNumber(t *testing.T) {
+	testCases := map[string]struct {
+		number   string
+		expected string
+		err      error
+	}{
+		"plain number":             {number: "01234567", expected: "01234567"},
+		"short plain number":       {number: "123", expected: "00000123"},
+		"spaces":                   {number: " 0123 4567 ", expected: "01234567"},
+		"Scottish":                 {number: "SC123456", expected: "SC123456"},
+		"Northern Irish lowercase": {number: "ni123456", expected: "NI123456"},
+		"LLP":                      {number: "OC123456", expected: "OC123456"},
+		"empty":                    {number: "", err: ErrNumberLength},
+		"too long":                 {number: "123456789", err: ErrNumberLength},
+		"short with prefix":        {number: "SC12345", err: ErrNumberLength},
+		"unknown prefix":           {number: "XX123456", err: ErrNumberPrefix},
+		"letters after prefix":     {number: "SC12345A", err: ErrNumberPrefix},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			number, err := NormaliseNumber(tc.number)
+
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.expected, number)
+		})
+	}
+}
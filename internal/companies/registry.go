@@ -0,0 +1,101 @@
+package companies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+var ErrNotFound = errors.New("company not found")
+
+// Company is a company as held on a register
+type Company struct {
+	Number  string         `json:"number"`
+	Name    string         `json:"name"`
+	Address sirius.Address `json:"address"`
+}
+
+// CompanyRegistry looks up a company by its registration number. Company
+// returns ErrNotFound if the number is not on the register.
+type CompanyRegistry interface {
+	Company(ctx context.Context, number string) (Company, error)
+}
+
+// FileRegistry is a CompanyRegistry backed by a JSON file containing a list of
+// companies, for use in tests and local development
+type FileRegistry struct {
+	companies map[string]Company
+}
+
+func NewFileRegistry(path string) (*FileRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Company
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	registry := &FileRegistry{companies: map[string]Company{}}
+	for _, company := range list {
+		number, err := NormaliseNumber(company.Number)
+		if err != nil {
+			return nil, err
+		}
+
+		company.Number = number
+		registry.companies[number] = company
+	}
+
+	return registry, nil
+}
+
+func (r *FileRegistry) Company(ctx context.Context, number string) (Company, error) {
+	number, err := NormaliseNumber(number)
+	if err != nil {
+		return Company{}, ErrNotFound
+	}
+
+	company, ok := r.companies[number]
+	if !ok {
+		return Company{}, ErrNotFound
+	}
+
+	return company, nil
+}
+
+// NamesMatch reports whether an entered company name is the same as the name
+// on the register, ignoring case, punctuation and the usual abbreviations of
+// company types
+func NamesMatch(entered, registered string) bool {
+	return normaliseName(entered) == normaliseName(registered)
+}
+
+var nameAbbreviations = strings.NewReplacer(
+	" public limited company", " plc",
+	" limited liability partnership", " llp",
+	" limited", " ltd",
+	" company", " co",
+	" and ", " & ",
+)
+
+func normaliseName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) || r == '&' {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+
+	name = " " + strings.Join(strings.Fields(name), " ") + " "
+	name = nameAbbreviations.Replace(name)
+
+	return strings.TrimSpace(name)
+}
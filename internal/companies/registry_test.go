@@ -0,0 +1,65 @@
+package companies
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileRegistry(t *testing.T) {
+	registry, err := NewFileRegistry("testdata/companies.json")
+	assert.Nil(t, err)
+
+	company, err := registry.Company(context.Background(), "sc 123456")
+	assert.Nil(t, err)
+	assert.Equal(t, Company{
+		Number: "SC123456",
+		Name:   "Highland Trustees PLC",
+		Address: sirius.Address{
+			Line1:    "2 Castle Wynd",
+			Line2:    "Old Town",
+			Town:     "Edinburgh",
+			Postcode: "EH1 2NG",
+			Country:  "GB",
+		},
+	}, company)
+
+	company, err = registry.Company(context.Background(), "1234567")
+	assert.Nil(t, err)
+	assert.Equal(t, "Trusty Trust Corporation Limited", company.Name)
+
+	_, err = registry.Company(context.Background(), "SC999999")
+	assert.Equal(t, ErrNotFound, err)
+
+	_, err = registry.Company(context.Background(), "not a number")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestNewFileRegistryMissingFile(t *testing.T) {
+	_, err := NewFileRegistry("testdata/missing.json")
+	assert.NotNil(t, err)
+}
+
+func TestNamesMatch(t *testing.T) {
+	testCases := []struct {
+		entered    string
+		registered string
+		match      bool
+	}{
+		{"Trusty Trust Corporation Limited", "Trusty Trust Corporation Limited", true},
+		{"trusty trust corporation ltd", "Trusty Trust Corporation Limited", true},
+		{"Trusty Trust Corporation Ltd.", "TRUSTY TRUST CORPORATION LIMITED", true},
+		{"Highland Trustees Public Limited Company", "Highland Trustees PLC", true},
+		{"Smith and Jones LLP", "Smith & Jones Limited Liability Partnership", true},
+		{"Trusty Trust", "Trusty Trust Corporation Limited", false},
+		{"Highland Trustees Ltd", "Highland Trustees PLC", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.entered, func(t *testing.T) {
+			assert.Equal(t, tc.match, NamesMatch(tc.entered, tc.registered))
+		})
+	}
+}
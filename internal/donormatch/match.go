@@ -0,0 +1,230 @@
+// Package donormatch scores existing person records against the details of a
+// donor about to be created, so that caseworkers can be warned before making a
+// second record for someone Sirius already knows about.
+package donormatch
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/address"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+const (
+	surnameWeight   = 0.35
+	firstnameWeight = 0.25
+	dobWeight       = 0.25
+	postcodeWeight  = 0.15
+
+	// similarNameThreshold is the similarity below which names are treated as
+	// different, as Jaro-Winkler gives around 0.5 even to unrelated names
+	similarNameThreshold = 0.8
+
+	// Threshold is the score a person needs to be shown as a possible match
+	Threshold = 0.6
+
+	// MaxMatches is the most possible matches returned by Find
+	MaxMatches = 5
+)
+
+// Candidate is the details entered for a new donor
+type Candidate struct {
+	Firstname   string
+	Surname     string
+	DateOfBirth sirius.DateString
+	Postcode    string
+}
+
+// SearchTerms returns the terms to search for people who could be the
+// candidate. Terms shorter than Sirius allows are left out.
+func (c Candidate) SearchTerms() []string {
+	var terms []string
+
+	if name := strings.TrimSpace(c.Firstname + " " + c.Surname); len(name) >= 3 {
+		terms = append(terms, name)
+	}
+
+	if postcode, err := address.NormalisePostcode(c.Postcode); err == nil {
+		terms = append(terms, postcode)
+	}
+
+	return terms
+}
+
+// Match is an existing person who could be the candidate
+type Match struct {
+	Person  sirius.Person
+	Score   float64
+	Reasons []string
+}
+
+// Percent is the score as a whole percentage, for display
+func (m Match) Percent() int {
+	return int(math.Round(m.Score * 100))
+}
+
+// Score compares a person with the candidate. Names are compared fuzzily;
+// dates of birth and postcodes give partial credit when they are close. A date
+// of birth that is known for both and clearly different counts against the
+// match, as it is the strongest sign that two people with the same name are
+// not the same person.
+func Score(c Candidate, p sirius.Person) Match {
+	m := Match{Person: p}
+
+	surname := NameSimilarity(c.Surname, p.Surname)
+	firstname := firstnameSimilarity(c.Firstname, p)
+
+	// allow for first name and surname having been entered the wrong way round
+	if swapped := (NameSimilarity(c.Firstname, p.Surname) + NameSimilarity(c.Surname, p.Firstname)) / 2 * 0.9; swapped > (surname+firstname)/2 {
+		surname, firstname = swapped, swapped
+	}
+
+	if surname >= similarNameThreshold {
+		m.Score += surnameWeight * surname
+	}
+	if firstname >= similarNameThreshold {
+		m.Score += firstnameWeight * firstname
+	}
+
+	switch {
+	case surname == 1 && firstname == 1:
+		m.Reasons = append(m.Reasons, "Same name")
+	case surname >= similarNameThreshold && firstname >= similarNameThreshold:
+		m.Reasons = append(m.Reasons, "Similar name")
+	case surname >= similarNameThreshold:
+		m.Reasons = append(m.Reasons, "Similar surname")
+	}
+
+	switch compareDates(c.DateOfBirth, p.DateOfBirth) {
+	case dateSame:
+		m.Score += dobWeight
+		m.Reasons = append(m.Reasons, "Same date of birth")
+	case dateClose:
+		m.Score += dobWeight * 0.4
+		m.Reasons = append(m.Reasons, "Similar date of birth")
+	case dateDifferent:
+		m.Score -= 0.2
+	}
+
+	switch comparePostcodes(c.Postcode, p.Postcode) {
+	case postcodeSame:
+		m.Score += postcodeWeight
+		m.Reasons = append(m.Reasons, "Same postcode")
+	case postcodeSameArea:
+		m.Score += postcodeWeight / 3
+		m.Reasons = append(m.Reasons, "Same postcode area")
+	}
+
+	m.Score = math.Max(0, math.Min(1, m.Score))
+	return m
+}
+
+// Find scores people against the candidate and returns the best possible
+// matches, highest scoring first. People appearing more than once, as happens
+// when results from several searches are combined, are only scored once.
+func Find(c Candidate, people []sirius.Person) []Match {
+	var matches []Match
+	seen := map[int]bool{}
+
+	for _, p := range people {
+		if p.ID != 0 {
+			if seen[p.ID] {
+				continue
+			}
+			seen[p.ID] = true
+		}
+
+		if m := Score(c, p); m.Score >= Threshold {
+			matches = append(matches, m)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	if len(matches) > MaxMatches {
+		matches = matches[:MaxMatches]
+	}
+
+	return matches
+}
+
+// firstnameSimilarity compares first names, allowing for an initial in place
+// of a name and for someone going by one of their middle names
+func firstnameSimilarity(firstname string, p sirius.Person) float64 {
+	similarity := NameSimilarity(firstname, p.Firstname)
+
+	a, b := NormaliseName(firstname), NormaliseName(p.Firstname)
+	if (len(a) == 1 || len(b) == 1) && a != "" && b != "" && a[0] == b[0] {
+		similarity = math.Max(similarity, 0.85)
+	}
+
+	for _, middlename := range strings.Fields(p.Middlenames) {
+		similarity = math.Max(similarity, NameSimilarity(firstname, middlename)*0.9)
+	}
+
+	return similarity
+}
+
+type dateComparison int
+
+const (
+	dateUnknown dateComparison = iota
+	dateSame
+	dateClose
+	dateDifferent
+)
+
+// compareDates treats dates as close when two of the day, month and year are
+// the same, or the day and month have been swapped, as these are the usual
+// mistakes when a date is typed in
+func compareDates(a, b sirius.DateString) dateComparison {
+	aParts, aErr := a.SplitDateString()
+	bParts, bErr := b.SplitDateString()
+	if a == "" || b == "" || aErr != nil || bErr != nil {
+		return dateUnknown
+	}
+
+	year, month, day := aParts[0] == bParts[0], aParts[1] == bParts[1], aParts[2] == bParts[2]
+
+	switch {
+	case year && month && day:
+		return dateSame
+	case year && aParts[1] == bParts[2] && aParts[2] == bParts[1]:
+		return dateClose
+	case (year && month) || (year && day) || (month && day):
+		return dateClose
+	default:
+		return dateDifferent
+	}
+}
+
+type postcodeComparison int
+
+const (
+	postcodeUnknown postcodeComparison = iota
+	postcodeSame
+	postcodeSameArea
+	postcodeDifferent
+)
+
+func comparePostcodes(a, b string) postcodeComparison {
+	a, aErr := address.NormalisePostcode(a)
+	b, bErr := address.NormalisePostcode(b)
+	if aErr != nil || bErr != nil {
+		return postcodeUnknown
+	}
+
+	if a == b {
+		return postcodeSame
+	}
+
+	if strings.Fields(a)[0] == strings.Fields(b)[0] {
+		return postcodeSameArea
+	}
+
+	return postcodeDifferent
+}
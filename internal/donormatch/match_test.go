@@ -0,0 +1,151 @@
+package donormatch
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+)
+
+var testCandidate = Candidate{
+	Firstname:   "Zackary",
+	Surname:     "Lemmonds",
+	DateOfBirth: "1965-04-18",
+	Postcode:    "nr162gb",
+}
+
+func TestCandidateSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"Zackary Lemmonds", "NR16 2GB"}, testCandidate.SearchTerms())
+	assert.Equal(t, []string{"Al Jo"}, Candidate{Firstname: "Al", Surname: "Jo", Postcode: "not a postcode"}.SearchTerms())
+	assert.Nil(t, Candidate{Surname: "Li"}.SearchTerms())
+}
+
+func TestScore(t *testing.T) {
+	testCases := map[string]struct {
+		person   sirius.Person
+		score    float64
+		reasons  []string
+		possible bool
+	}{
+		"exact": {
+			person:   sirius.Person{Firstname: "Zackary", Surname: "Lemmonds", DateOfBirth: "1965-04-18", Postcode: "NR16 2GB"},
+			score:    1,
+			reasons:  []string{"Same name", "Same date of birth", "Same postcode"},
+			possible: true,
+		},
+		"misspelt names": {
+			person:   sirius.Person{Firstname: "Zachary", Surname: "Lemonds", DateOfBirth: "1965-04-18", Postcode: "NR16 2GB"},
+			score:    0.97,
+			reasons:  []string{"Similar name", "Same date of birth", "Same postcode"},
+			possible: true,
+		},
+		"initial": {
+			person:   sirius.Person{Firstname: "Z", Surname: "Lemmonds", DateOfBirth: "1965-04-18"},
+			score:    0.81,
+			reasons:  []string{"Similar name", "Same date of birth"},
+			possible: true,
+		},
+		"known by middle name": {
+			person:   sirius.Person{Firstname: "John", Middlenames: "Zackary", Surname: "Lemmonds", DateOfBirth: "1965-04-18"},
+			score:    0.83,
+			reasons:  []string{"Similar name", "Same date of birth"},
+			possible: true,
+		},
+		"names swapped": {
+			person:   sirius.Person{Firstname: "Lemmonds", Surname: "Zackary", DateOfBirth: "1965-04-18"},
+			score:    0.79,
+			reasons:  []string{"Similar name", "Same date of birth"},
+			possible: true,
+		},
+		"day and month swapped": {
+			person:   sirius.Person{Firstname: "Zackary", Surname: "Lemmonds", DateOfBirth: "1965-18-04", Postcode: "NR16 7AB"},
+			score:    0.75,
+			reasons:  []string{"Same name", "Similar date of birth", "Same postcode area"},
+			possible: true,
+		},
+		"same name only": {
+			person:   sirius.Person{Firstname: "Zackary", Surname: "Lemmonds"},
+			score:    0.6,
+			reasons:  []string{"Same name"},
+			possible: true,
+		},
+		"same name different date of birth": {
+			person:  sirius.Person{Firstname: "Zackary", Surname: "Lemmonds", DateOfBirth: "1980-01-01"},
+			score:   0.4,
+			reasons: []string{"Same name"},
+		},
+		"different first name": {
+			person:  sirius.Person{Firstname: "Peter", Surname: "Lemmonds", Postcode: "NR16 2GB"},
+			score:   0.5,
+			reasons: []string{"Similar surname", "Same postcode"},
+		},
+		"different person at same address": {
+			person:  sirius.Person{Firstname: "Ada", Surname: "Brown", DateOfBirth: "1970-02-03", Postcode: "NR16 2GB"},
+			score:   0,
+			reasons: []string{"Same postcode"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := Score(testCandidate, tc.person)
+
+			assert.InDelta(t, tc.score, m.Score, 0.01)
+			assert.Equal(t, tc.reasons, m.Reasons)
+			assert.Equal(t, tc.possible, m.Score >= Threshold)
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	exact := sirius.Person{ID: 1, Firstname: "Zackary", Surname: "Lemmonds", DateOfBirth: "1965-04-18", Postcode: "NR16 2GB"}
+	similar := sirius.Person{ID: 2, Firstname: "Zak", Surname: "Lemonds", DateOfBirth: "1965-04-18"}
+	different := sirius.Person{ID: 3, Firstname: "Ada", Surname: "Brown", Postcode: "NR16 2GB"}
+
+	matches := Find(testCandidate, []sirius.Person{similar, different, exact, similar, exact})
+
+	assert.Len(t, matches, 2)
+	assert.Equal(t, 1, matches[0].Person.ID)
+	assert.Equal(t, 100, matches[0].Percent())
+	assert.Equal(t, 2, matches[1].Person.ID)
+}
+
+func TestFindLimitsMatches(t *testing.T) {
+	var people []sirius.Person
+	for i := 1; i <= MaxMatches+2; i++ {
+		people = append(people, sirius.Person{ID: i, Firstname: "Zackary", Surname: "Lemmonds"})
+	}
+
+	assert.Len(t, Find(testCandidate, people), MaxMatches)
+	assert.Nil(t, Find(testCandidate, nil))
+}
+
+func TestCompareDates(t *testing.T) {
+	testCases := map[string]struct {
+		a, b     sirius.DateString
+		expected dateComparison
+	}{
+		"same":        {"1965-04-18", "1965-04-18", dateSame},
+		"day wrong":   {"1965-04-18", "1965-04-19", dateClose},
+		"month wrong": {"1965-04-18", "1965-05-18", dateClose},
+		"year wrong":  {"1965-04-18", "1966-04-18", dateClose},
+		"swapped":     {"1965-04-11", "1965-11-04", dateClose},
+		"different":   {"1965-04-18", "1980-01-01", dateDifferent},
+		"unknown":     {"", "1965-04-18", dateUnknown},
+		"not a date":  {"18/04/1965", "1965-04-18", dateUnknown},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, compareDates(tc.a, tc.b))
+		})
+	}
+}
+
+func TestComparePostcodes(t *testing.T) {
+	assert.Equal(t, postcodeSame, comparePostcodes("nr162gb", "NR16 2GB"))
+	assert.Equal(t, postcodeSameArea, comparePostcodes("NR16 2GB", "NR16 7AB"))
+	assert.Equal(t, postcodeDifferent, comparePostcodes("NR16 2GB", "SW1A 1AA"))
+	assert.Equal(t, postcodeUnknown, comparePostcodes("", "SW1A 1AA"))
+	assert.Equal(t, postcodeUnknown, comparePostcodes("75001", "SW1A 1AA"))
+}
@@ -0,0 +1,104 @@
+package donormatch
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormaliseName lowercases a name and removes accents and punctuation so that
+// "Zoë O'Brien-Smith" and "zoe obrien smith" compare as equal
+func NormaliseName(name string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	name, _, _ = transform.String(t, name)
+
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r):
+			return unicode.ToLower(r)
+		case r == '-' || unicode.IsSpace(r):
+			return ' '
+		default:
+			return -1
+		}
+	}, name)
+
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// NameSimilarity scores how alike two names are, from 0 for nothing in common
+// to 1 for the same name once normalised. It uses Jaro-Winkler similarity,
+// which favours names that share a prefix, so it is forgiving of the typing
+// errors and misspellings seen in caseworker-entered names.
+func NameSimilarity(a, b string) float64 {
+	a, b = NormaliseName(a), NormaliseName(b)
+	if a == "" || b == "" {
+		return 0
+	}
+
+	return jaroWinkler([]rune(a), []rune(b))
+}
+
+func jaroWinkler(a, b []rune) float64 {
+	similarity := jaro(a, b)
+	if similarity < 0.7 {
+		return similarity
+	}
+
+	prefix := 0
+	for prefix < min(4, len(a), len(b)) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	return similarity + float64(prefix)*0.1*(1-similarity)
+}
+
+func jaro(a, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	window := max(len(a), len(b))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	aMatched := make([]bool, len(a))
+	bMatched := make([]bool, len(b))
+	matches := 0
+
+	for i := range a {
+		for j := max(0, i-window); j < min(len(b), i+window+1); j++ {
+			if !bMatched[j] && a[i] == b[j] {
+				aMatched[i], bMatched[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	j := 0
+	for i := range a {
+		if !aMatched[i] {
+			continue
+		}
+		for !bMatched[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+}
@@ -0,0 +1,52 @@
+package donormatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormaliseName(t *testing.T) {
+	testCases := map[string]string{
+		"Zoë O'Brien-Smith":  "zoe obrien smith",
+		"  JOHN   smith ":    "john smith",
+		"Siân":               "sian",
+		"Mary-Jane  Watson.": "mary jane watson",
+		"":                   "",
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, expected, NormaliseName(name))
+		})
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"MARTHA", "MARHTA", 0.961},
+		{"DWAYNE", "DUANE", 0.840},
+		{"DIXON", "DICKSONX", 0.813},
+		{"Smith", "smith", 1},
+		{"Zoë", "Zoe", 1},
+		{"Jones", "", 0},
+		{"", "", 0},
+		{"abc", "xyz", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+"/"+tc.b, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, NameSimilarity(tc.a, tc.b), 0.001)
+			assert.InDelta(t, tc.expected, NameSimilarity(tc.b, tc.a), 0.001)
+		})
+	}
+}
+
+func TestNameSimilarityOrdering(t *testing.T) {
+	assert.Greater(t, NameSimilarity("Lemmonds", "Lemonds"), NameSimilarity("Lemmonds", "Lennox"))
+	assert.Greater(t, NameSimilarity("Catherine", "Katherine"), NameSimilarity("Catherine", "Caroline"))
+	assert.Less(t, NameSimilarity("Smith", "Jones"), similarNameThreshold)
+}
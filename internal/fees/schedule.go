@@ -0,0 +1,85 @@
+package fees
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+)
+
+var ErrNoSchedule = errors.New("no fee schedule applies on this date")
+
+// Reduction is a type of fee reduction from the feeReductionType ref data,
+// along with the share of the full fee that is still payable when it applies
+type Reduction struct {
+	Handle         string
+	PayablePercent int
+}
+
+func (r Reduction) Payable(fee shared.Money) shared.Money {
+	return fee * shared.Money(r.PayablePercent) / 100
+}
+
+// Schedule is the set of fees that applies to applications received on or after
+// EffectiveFrom, until the next schedule takes effect
+type Schedule struct {
+	EffectiveFrom time.Time
+	// Fees are keyed by case subtype, "" is used for any subtype not listed
+	Fees       map[string]shared.Money
+	Reductions []Reduction
+}
+
+func (s Schedule) Fee(subtype string) shared.Money {
+	if fee, ok := s.Fees[subtype]; ok {
+		return fee
+	}
+
+	return s.Fees[""]
+}
+
+func (s Schedule) Reduction(handle string) (Reduction, bool) {
+	for _, reduction := range s.Reductions {
+		if reduction.Handle == handle {
+			return reduction, true
+		}
+	}
+
+	return Reduction{}, false
+}
+
+var standardReductions = []Reduction{
+	{Handle: "REMISSION", PayablePercent: 50},
+	{Handle: "EXEMPTION", PayablePercent: 0},
+	{Handle: "HARDSHIP", PayablePercent: 0},
+}
+
+// schedules must be kept in order of EffectiveFrom
+var schedules = []Schedule{
+	{
+		EffectiveFrom: time.Date(2013, time.October, 1, 0, 0, 0, 0, time.UTC),
+		Fees:          map[string]shared.Money{"": 11000},
+		Reductions:    standardReductions,
+	},
+	{
+		EffectiveFrom: time.Date(2017, time.April, 1, 0, 0, 0, 0, time.UTC),
+		Fees:          map[string]shared.Money{"": 8200},
+		Reductions:    standardReductions,
+	},
+	{
+		EffectiveFrom: time.Date(2025, time.November, 17, 0, 0, 0, 0, time.UTC),
+		Fees:          map[string]shared.Money{"": 9200},
+		Reductions:    standardReductions,
+	},
+}
+
+// ScheduleFor returns the fee schedule in effect for an application received on
+// date
+func ScheduleFor(date time.Time) (Schedule, error) {
+	for i := len(schedules) - 1; i >= 0; i-- {
+		if !date.Before(schedules[i].EffectiveFrom) {
+			return schedules[i], nil
+		}
+	}
+
+	return Schedule{}, ErrNoSchedule
+}
@@ -0,0 +1,68 @@
+package fees
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleFor(t *testing.T) {
+	testCases := map[string]struct {
+		date time.Time
+		fee  shared.Money
+	}{
+		"first day of 2013 schedule": {
+			date: time.Date(2013, time.October, 1, 0, 0, 0, 0, time.UTC),
+			fee:  11000,
+		},
+		"day before 2017 schedule": {
+			date: time.Date(2017, time.March, 31, 0, 0, 0, 0, time.UTC),
+			fee:  11000,
+		},
+		"first day of 2017 schedule": {
+			date: time.Date(2017, time.April, 1, 0, 0, 0, 0, time.UTC),
+			fee:  8200,
+		},
+		"first day of 2025 schedule": {
+			date: time.Date(2025, time.November, 17, 0, 0, 0, 0, time.UTC),
+			fee:  9200,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			schedule, err := ScheduleFor(tc.date)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.fee, schedule.Fee("pfa"))
+			assert.Equal(t, tc.fee, schedule.Fee(""))
+		})
+	}
+}
+
+func TestScheduleForBeforeFirstSchedule(t *testing.T) {
+	_, err := ScheduleFor(time.Date(2013, time.September, 30, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, ErrNoSchedule, err)
+}
+
+func TestSchedulesAreInOrder(t *testing.T) {
+	for i := 1; i < len(schedules); i++ {
+		assert.True(t, schedules[i-1].EffectiveFrom.Before(schedules[i].EffectiveFrom))
+	}
+}
+
+func TestScheduleFeeForSubtype(t *testing.T) {
+	schedule := Schedule{Fees: map[string]shared.Money{"": 8200, "hw": 4100}}
+
+	assert.Equal(t, shared.Money(4100), schedule.Fee("hw"))
+	assert.Equal(t, shared.Money(8200), schedule.Fee("pfa"))
+}
+
+func TestReductionPayable(t *testing.T) {
+	assert.Equal(t, shared.Money(4100), Reduction{PayablePercent: 50}.Payable(8200))
+	assert.Equal(t, shared.Money(4600), Reduction{PayablePercent: 50}.Payable(9200))
+	assert.Equal(t, shared.Money(0), Reduction{PayablePercent: 0}.Payable(8200))
+}
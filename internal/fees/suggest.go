@@ -0,0 +1,154 @@
+package fees
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+var ErrNoReceiptDate = errors.New("case has no receipt date")
+
+// ReductionOption is a fee reduction that could be applied to a case, with the
+// amount that would be left to pay if it were
+type ReductionOption struct {
+	Handle  string
+	Label   string
+	Payable shared.Money
+}
+
+// Suggestion is the fee expected for a case and the fee reduction, if any, that
+// fits what has been paid. Explanation describes how it was reached, one step
+// per line.
+type Suggestion struct {
+	Schedule    Schedule
+	Fee         shared.Money
+	Paid        shared.Money
+	Reduced     shared.Money
+	Outstanding shared.Money
+	Reductions  []ReductionOption
+	Suggested   *ReductionOption
+	Explanation []string
+}
+
+// Suggest works out the fee for a case from its receipt date and subtype.
+// reductionTypes is the feeReductionType ref data; reductions in the schedule
+// but not in the ref data are not offered.
+func Suggest(caseitem sirius.Case, payments []sirius.Payment, reductionTypes []sirius.RefDataItem) (Suggestion, error) {
+	if caseitem.ReceiptDate == "" {
+		return Suggestion{}, ErrNoReceiptDate
+	}
+
+	receiptDate, err := caseitem.ReceiptDate.Time()
+	if err != nil {
+		return Suggestion{}, err
+	}
+
+	schedule, err := ScheduleFor(receiptDate)
+	if err != nil {
+		return Suggestion{}, err
+	}
+
+	fee := schedule.Fee(caseitem.SubType)
+	ledger := sirius.NewPaymentLedger(fee, payments)
+
+	s := Suggestion{
+		Schedule:    schedule,
+		Fee:         fee,
+		Reduced:     ledger.TotalReductions,
+		Outstanding: ledger.AmountDue(),
+	}
+
+	if ledger.TotalPaid > ledger.TotalRefunds {
+		s.Paid = ledger.TotalPaid - ledger.TotalRefunds
+	}
+
+	for _, item := range reductionTypes {
+		if reduction, ok := schedule.Reduction(item.Handle); ok {
+			s.Reductions = append(s.Reductions, ReductionOption{
+				Handle:  item.Handle,
+				Label:   item.Label,
+				Payable: reduction.Payable(fee),
+			})
+		}
+	}
+
+	receivedOn, _ := caseitem.ReceiptDate.ToSirius()
+	s.explain("The application was received on %s, so the fees from %s apply", receivedOn, schedule.EffectiveFrom.Format("02/01/2006"))
+
+	if name := subtypeName(caseitem.SubType); name != "" {
+		s.explain("The fee for a %s LPA is %s", name, fee)
+	} else {
+		s.explain("The fee is %s", fee)
+	}
+
+	if caseitem.ExpectedPaymentTotal != 0 && caseitem.ExpectedPaymentTotal != fee {
+		s.explain("Sirius currently expects %s for this case", caseitem.ExpectedPaymentTotal)
+	}
+
+	if ledger.TotalRefunds > 0 {
+		s.explain("%s has been paid after %s of refunds", s.Paid, ledger.TotalRefunds)
+	} else {
+		s.explain("%s has been paid", s.Paid)
+	}
+
+	if s.Reduced > 0 {
+		s.explain("Fee reductions of %s have already been applied", s.Reduced)
+	}
+
+	switch {
+	case s.Outstanding == 0:
+		s.explain("The fee is covered, so no further reduction is needed")
+
+	case s.Reduced > 0:
+		s.explain("%s is still due", s.Outstanding)
+
+	case s.Paid > 0:
+		for i, option := range s.Reductions {
+			if option.Payable > 0 && option.Payable <= s.Paid && (s.Suggested == nil || option.Payable > s.Suggested.Payable) {
+				s.Suggested = &s.Reductions[i]
+			}
+		}
+
+		if s.Suggested != nil {
+			s.explain("What has been paid covers the %s fee of %s, so a %s may apply", strings.ToLower(s.Suggested.Label), s.Suggested.Payable, strings.ToLower(s.Suggested.Label))
+		} else {
+			s.explain("%s is still due", s.Outstanding)
+		}
+
+	default:
+		var labels []string
+		for _, option := range s.Reductions {
+			if option.Payable == 0 {
+				labels = append(labels, strings.ToLower(option.Label))
+			}
+		}
+
+		if len(labels) > 0 {
+			s.explain("Nothing has been paid, so the full fee is due unless the donor qualifies for %s", strings.Join(labels, " or "))
+		} else {
+			s.explain("Nothing has been paid, so the full fee is due")
+		}
+	}
+
+	return s, nil
+}
+
+func (s *Suggestion) explain(format string, a ...any) {
+	s.Explanation = append(s.Explanation, fmt.Sprintf(format, a...))
+}
+
+func subtypeName(subtype string) string {
+	switch subtype {
+	case "hw":
+		return "health and welfare"
+	case "personal-welfare":
+		return "personal welfare"
+	case "pfa", "property-and-affairs":
+		return "property and affairs"
+	}
+
+	return ""
+}
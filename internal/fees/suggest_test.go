@@ -0,0 +1,166 @@
+package fees
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+)
+
+var reductionTypes = []sirius.RefDataItem{
+	{Handle: "REMISSION", Label: "Remission"},
+	{Handle: "EXEMPTION", Label: "Exemption"},
+	{Handle: "HARDSHIP", Label: "Hardship"},
+	{Handle: "UNKNOWN", Label: "Not in schedule"},
+}
+
+func TestSuggest(t *testing.T) {
+	testCases := map[string]struct {
+		caseitem    sirius.Case
+		payments    []sirius.Payment
+		fee         shared.Money
+		paid        shared.Money
+		outstanding shared.Money
+		suggested   string
+		explanation []string
+	}{
+		"nothing paid": {
+			caseitem:    sirius.Case{ReceiptDate: "2022-05-03", SubType: "pfa"},
+			fee:         8200,
+			outstanding: 8200,
+			explanation: []string{
+				"The application was received on 03/05/2022, so the fees from 01/04/2017 apply",
+				"The fee for a property and affairs LPA is £82.00",
+				"£0.00 has been paid",
+				"Nothing has been paid, so the full fee is due unless the donor qualifies for exemption or hardship",
+			},
+		},
+		"full fee paid": {
+			caseitem: sirius.Case{ReceiptDate: "2022-05-03", SubType: "hw", ExpectedPaymentTotal: 8200},
+			payments: []sirius.Payment{{Amount: 8200, Source: "PHONE"}},
+			fee:      8200,
+			paid:     8200,
+			explanation: []string{
+				"The application was received on 03/05/2022, so the fees from 01/04/2017 apply",
+				"The fee for a health and welfare LPA is £82.00",
+				"£82.00 has been paid",
+				"The fee is covered, so no further reduction is needed",
+			},
+		},
+		"half fee paid": {
+			caseitem:    sirius.Case{ReceiptDate: "2026-01-05", SubType: "property-and-affairs", ExpectedPaymentTotal: 8200},
+			payments:    []sirius.Payment{{Amount: 4600, Source: "ONLINE"}},
+			fee:         9200,
+			paid:        4600,
+			outstanding: 4600,
+			suggested:   "REMISSION",
+			explanation: []string{
+				"The application was received on 05/01/2026, so the fees from 17/11/2025 apply",
+				"The fee for a property and affairs LPA is £92.00",
+				"Sirius currently expects £82.00 for this case",
+				"£46.00 has been paid",
+				"What has been paid covers the remission fee of £46.00, so a remission may apply",
+			},
+		},
+		"part paid below any reduction": {
+			caseitem:    sirius.Case{ReceiptDate: "2016-02-01"},
+			payments:    []sirius.Payment{{Amount: 2000, Source: "PHONE"}},
+			fee:         11000,
+			paid:        2000,
+			outstanding: 9000,
+			explanation: []string{
+				"The application was received on 01/02/2016, so the fees from 01/10/2013 apply",
+				"The fee is £110.00",
+				"£20.00 has been paid",
+				"£90.00 is still due",
+			},
+		},
+		"reduction already applied": {
+			caseitem: sirius.Case{ReceiptDate: "2022-05-03", SubType: "personal-welfare"},
+			payments: []sirius.Payment{
+				{Amount: 2000, Source: "PHONE"},
+				{Amount: 4100, Source: sirius.FeeReductionSource, FeeReductionType: "REMISSION"},
+			},
+			fee:         8200,
+			paid:        2000,
+			outstanding: 2100,
+			explanation: []string{
+				"The application was received on 03/05/2022, so the fees from 01/04/2017 apply",
+				"The fee for a personal welfare LPA is £82.00",
+				"£20.00 has been paid",
+				"Fee reductions of £41.00 have already been applied",
+				"£21.00 is still due",
+			},
+		},
+		"refund issued": {
+			caseitem: sirius.Case{ReceiptDate: "2022-05-03", SubType: "pfa"},
+			payments: []sirius.Payment{
+				{Amount: 8200, Source: "PHONE"},
+				{Amount: -4100, Source: "PHONE"},
+			},
+			fee:         8200,
+			paid:        4100,
+			outstanding: 4100,
+			suggested:   "REMISSION",
+			explanation: []string{
+				"The application was received on 03/05/2022, so the fees from 01/04/2017 apply",
+				"The fee for a property and affairs LPA is £82.00",
+				"£41.00 has been paid after £41.00 of refunds",
+				"What has been paid covers the remission fee of £41.00, so a remission may apply",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			suggestion, err := Suggest(tc.caseitem, tc.payments, reductionTypes)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.fee, suggestion.Fee)
+			assert.Equal(t, tc.paid, suggestion.Paid)
+			assert.Equal(t, tc.outstanding, suggestion.Outstanding)
+			assert.Equal(t, tc.explanation, suggestion.Explanation)
+
+			if tc.suggested == "" {
+				assert.Nil(t, suggestion.Suggested)
+			} else if assert.NotNil(t, suggestion.Suggested) {
+				assert.Equal(t, tc.suggested, suggestion.Suggested.Handle)
+			}
+		})
+	}
+}
+
+func TestSuggestReductions(t *testing.T) {
+	suggestion, err := Suggest(sirius.Case{ReceiptDate: "2022-05-03"}, nil, reductionTypes)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []ReductionOption{
+		{Handle: "REMISSION", Label: "Remission", Payable: 4100},
+		{Handle: "EXEMPTION", Label: "Exemption", Payable: 0},
+		{Handle: "HARDSHIP", Label: "Hardship", Payable: 0},
+	}, suggestion.Reductions)
+}
+
+func TestSuggestWithoutSchedule(t *testing.T) {
+	testCases := map[string]struct {
+		receiptDate sirius.DateString
+		err         error
+	}{
+		"no receipt date": {
+			err: ErrNoReceiptDate,
+		},
+		"before first schedule": {
+			receiptDate: "2012-01-01",
+			err:         ErrNoSchedule,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Suggest(sirius.Case{ReceiptDate: tc.receiptDate}, nil, reductionTypes)
+
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
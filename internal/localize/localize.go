@@ -0,0 +1,78 @@
+package localize
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+const CookieName = "language"
+
+var (
+	English = language.BritishEnglish
+	Welsh   = language.Make("cy")
+
+	Supported = []language.Tag{English, Welsh}
+)
+
+var messages = catalog.NewBuilder(catalog.Fallback(English))
+
+func init() {
+	for key, msg := range welsh {
+		if err := messages.SetString(Welsh, key, msg); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Parse returns the supported language for a short code such as "en" or "cy",
+// defaulting to English when the code is not recognised
+func Parse(s string) (language.Tag, bool) {
+	for _, tag := range Supported {
+		if Code(tag) == s {
+			return tag, true
+		}
+	}
+
+	return English, false
+}
+
+// Code returns the short code for a language, as used in the language cookie
+// and the lang attribute of the page
+func Code(lang language.Tag) string {
+	base, _ := lang.Base()
+	return base.String()
+}
+
+// NewPrinter returns a printer which translates English message keys into lang,
+// falling back to the key itself when there is no translation
+func NewPrinter(lang language.Tag) *message.Printer {
+	return message.NewPrinter(lang, message.Catalog(messages))
+}
+
+// Translate returns the message for key in lang without formatting it, so text
+// such as "100% complete" is shown as it is
+func Translate(lang language.Tag, key string) string {
+	if lang == Welsh {
+		if msg, ok := welsh[key]; ok {
+			return msg
+		}
+	}
+
+	return key
+}
+
+// HasTranslation reports whether key can be displayed in lang; English is
+// always available as the keys are the English messages
+func HasTranslation(lang language.Tag, key string) bool {
+	if lang == English {
+		return true
+	}
+
+	if lang == Welsh {
+		_, ok := welsh[key]
+		return ok
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package localize
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestParse(t *testing.T) {
+	lang, ok := Parse("cy")
+	assert.True(t, ok)
+	assert.Equal(t, Welsh, lang)
+
+	lang, ok = Parse("en")
+	assert.True(t, ok)
+	assert.Equal(t, English, lang)
+
+	lang, ok = Parse("fr")
+	assert.False(t, ok)
+	assert.Equal(t, English, lang)
+}
+
+func TestCode(t *testing.T) {
+	assert.Equal(t, "en", Code(English))
+	assert.Equal(t, "cy", Code(Welsh))
+}
+
+func TestNewPrinter(t *testing.T) {
+	assert.Equal(t, "Factual", NewPrinter(English).Sprintf("Factual"))
+	assert.Equal(t, "Ffeithiol", NewPrinter(Welsh).Sprintf("Factual"))
+	assert.Equal(t, "Gwrthwynebiad - cadarnhawyd", NewPrinter(Welsh).Sprintf("Objection - %s", "cadarnhawyd"))
+	assert.Equal(t, "Not in the catalogue", NewPrinter(Welsh).Sprintf("Not in the catalogue"))
+}
+
+func TestTranslate(t *testing.T) {
+	assert.Equal(t, "Factual", Translate(English, "Factual"))
+	assert.Equal(t, "Ffeithiol", Translate(Welsh, "Factual"))
+	assert.Equal(t, "100% complete", Translate(Welsh, "100% complete"))
+}
+
+func TestHasTranslation(t *testing.T) {
+	assert.True(t, HasTranslation(English, "Not in the catalogue"))
+	assert.True(t, HasTranslation(Welsh, "Factual"))
+	assert.False(t, HasTranslation(Welsh, "Not in the catalogue"))
+	assert.False(t, HasTranslation(language.French, "Factual"))
+}
+
+var templateKeyPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\bt "([^"]+)"`),
+	regexp.MustCompile(`template "information-warning" "([^"]+)"`),
+}
+
+func TestTemplateKeysAreTranslated(t *testing.T) {
+	files, err := filepath.Glob("../../web/template/*.gohtml")
+	assert.Nil(t, err)
+
+	layouts, err := filepath.Glob("../../web/template/layout/*.gohtml")
+	assert.Nil(t, err)
+
+	keys := 0
+	for _, file := range append(files, layouts...) {
+		contents, err := os.ReadFile(file)
+		assert.Nil(t, err)
+
+		for _, pattern := range templateKeyPatterns {
+			for _, match := range pattern.FindAllStringSubmatch(string(contents), -1) {
+				keys++
+				assert.True(t, HasTranslation(Welsh, match[1]), "untranslated key %q in %s", match[1], filepath.Base(file))
+			}
+		}
+	}
+
+	assert.NotZero(t, keys)
+}
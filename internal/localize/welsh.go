@@ -0,0 +1,98 @@
+package localize
+
+// welsh maps English messages to their Welsh translation; messages containing
+// verbs such as %s are formatted with the arguments passed to t
+var welsh = map[string]string{
+	// page layout and header
+	"Skip to main content":                  "Neidio i'r prif gynnwys",
+	"Powers of attorney - Sirius":           "Atwrneiaeth - Sirius",
+	"Account navigation":                    "Llywio cyfrif",
+	"Supervision":                           "Goruchwylio",
+	"Admin":                                 "Gweinyddu",
+	"Sign out":                              "Allgofnodi",
+	"Search for a case":                     "Chwilio am achos",
+	"Search":                                "Chwilio",
+	"Not specified":                         "Heb ei nodi",
+	"For review":                            "I'w adolygu",
+	"Objection - %s":                        "Gwrthwynebiad - %s",
+	"objection type NOT RECOGNISED: %s":     "math o wrthwynebiad HEB EI ADNABOD: %s",
+	"resolution outcome NOT RECOGNISED: %s": "canlyniad y penderfyniad HEB EI ADNABOD: %s",
+	"indicator NOT RECOGNISED: %s":          "dangosydd HEB EI ADNABOD: %s",
+	"status NOT RECOGNISED: %s":             "statws HEB EI ADNABOD: %s",
+
+	// objectionType
+	"Factual":     "Ffeithiol",
+	"Prescribed":  "Rhagnodedig",
+	"Third Party": "Trydydd Parti",
+
+	// resolutionOutcome
+	"upheld":     "cadarnhawyd",
+	"not upheld": "ni chadarnhawyd",
+
+	// progressIndicatorContext
+	"Fees":                        "Ffioedd",
+	"Donor section":               "Adran y rhoddwr",
+	"Donor identity confirmation": "Cadarnhau hunaniaeth y rhoddwr",
+	"Certificate provider identity confirmation": "Cadarnhau hunaniaeth y darparwr tystysgrif",
+	"Certificate provider certificate":           "Tystysgrif y darparwr tystysgrif",
+	"Attorney signatures":                        "Llofnodion yr atwrneiod",
+	"Pre-registration notices":                   "Hysbysiadau cyn cofrestru",
+	"Registration notices":                       "Hysbysiadau cofrestru",
+	"Restrictions and conditions":                "Cyfyngiadau ac amodau",
+
+	// progressIndicatorStatus
+	"In progress": "Ar y gweill",
+	"Complete":    "Wedi'i gwblhau",
+	"Not started": "Heb ddechrau",
+
+	// shared.CaseStatus
+	"Draft":                    "Drafft",
+	"Statutory waiting period": "Cyfnod aros statudol",
+	"Do not register":          "Peidio â chofrestru",
+	"Expired":                  "Wedi dod i ben",
+	"Registered":               "Wedi'i gofrestru",
+	"Cannot register":          "Methu cofrestru",
+	"Cancelled":                "Wedi'i ganslo",
+	"De-registered":            "Wedi'i ddadgofrestru",
+	"Suspended":                "Wedi'i atal",
+	"Perfect":                  "Perffaith",
+	"Pending":                  "Yn yr arfaeth",
+	"Payment Pending":          "Taliad yn yr arfaeth",
+	"Reduced Fees Pending":     "Ffioedd gostyngol yn yr arfaeth",
+	"Rejected":                 "Wedi'i wrthod",
+	"Withdrawn":                "Wedi'i dynnu'n ôl",
+	"Return - unpaid":          "Dychwelyd - heb ei dalu",
+	"Deleted":                  "Wedi'i ddileu",
+	"Revoked":                  "Wedi'i ddirymu",
+	"Imperfect":                "Amherffaith",
+	"Invalid":                  "Annilys",
+	"With COP":                 "Gyda'r Llys Gwarchod",
+	"Processing":               "Wrthi'n prosesu",
+
+	// anomaly hints
+	"Review attorney's first names":                        "Adolygu enwau cyntaf yr atwrnai",
+	"Review attorney's last name":                          "Adolygu cyfenw'r atwrnai",
+	"Review replacement attorney's first names":            "Adolygu enwau cyntaf yr atwrnai wrth gefn",
+	"Review replacement attorney's last name":              "Adolygu cyfenw'r atwrnai wrth gefn",
+	"Review replacement attorney's address":                "Adolygu cyfeiriad yr atwrnai wrth gefn",
+	"Review certificate provider's first names":            "Adolygu enwau cyntaf y darparwr tystysgrif",
+	"Review certificate provider's last name":              "Adolygu cyfenw'r darparwr tystysgrif",
+	"Review certificate provider's address":                "Adolygu cyfeiriad y darparwr tystysgrif",
+	"Review attorney's address":                            "Adolygu cyfeiriad yr atwrnai",
+	"Review address":                                       "Adolygu'r cyfeiriad",
+	"Review address as there is no country":                "Adolygu'r cyfeiriad gan nad oes gwlad",
+	"Review how attorneys can make decisions":              "Adolygu sut y gall atwrneiod wneud penderfyniadau",
+	"Review how replacement attorney's can make decisions": "Adolygu sut y gall atwrneiod wrth gefn wneud penderfyniadau",
+	"Review life sustaining treatment":                     "Adolygu triniaeth cynnal bywyd",
+	"Review when the LPA can be used":                      "Adolygu pryd y gellir defnyddio'r LPA",
+	"Review last name - this matches the donor and at least one of the attorneys. Check certificate provider's eligibility":                                    "Adolygu'r cyfenw - mae hwn yn cyfateb i'r rhoddwr ac o leiaf un o'r atwrneiod. Gwirio cymhwysedd y darparwr tystysgrif",
+	"Review last name - this matches at least one of the attorney's. Check certificate provider's eligibility":                                                 "Adolygu'r cyfenw - mae hwn yn cyfateb i o leiaf un o'r atwrneiod. Gwirio cymhwysedd y darparwr tystysgrif",
+	"Review last name - this matches the donor's. Check certificate provider's eligibility":                                                                    "Adolygu'r cyfenw - mae hwn yn cyfateb i gyfenw'r rhoddwr. Gwirio cymhwysedd y darparwr tystysgrif",
+	"Review signature date - check this is within 6 months either side of the donor’s ID check":                                                                "Adolygu dyddiad y llofnod - gwirio ei fod o fewn 6 mis y naill ochr i wiriad adnabod y rhoddwr",
+	"Review signature date - check this is within 6 months either side of the certificate provider’s ID check":                                                 "Adolygu dyddiad y llofnod - gwirio ei fod o fewn 6 mis y naill ochr i wiriad adnabod y darparwr tystysgrif",
+	"Review signature date - check this is within 2 years of the donor signing the LPA":                                                                        "Adolygu dyddiad y llofnod - gwirio ei fod o fewn 2 flynedd i'r rhoddwr lofnodi'r LPA",
+	"Review signature date - check this is within 6 months either side of the certificate provider’s ID check and within 2 years of the donor signing the LPA": "Adolygu dyddiad y llofnod - gwirio ei fod o fewn 6 mis y naill ochr i wiriad adnabod y darparwr tystysgrif ac o fewn 2 flynedd i'r rhoddwr lofnodi'r LPA",
+
+	// person network
+	"Other donors' LPAs could not be searched, so people on them are not highlighted.": "Nid oedd modd chwilio LPAau rhoddwyr eraill, felly nid yw'r bobl arnynt wedi'u hamlygu.",
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/ministryofjustice/opg-go-common/telemetry"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+type AcknowledgeWarningClient interface {
+	AcknowledgeWarning(ctx sirius.Context, warningID int, action string) error
+}
+
+// validateWarningsAcknowledged requires the "I have read this" checkbox to be
+// ticked when the case has high severity warnings
+func validateWarningsAcknowledged(r *http.Request, warnings []sirius.Warning, errorField sirius.FieldErrors) {
+	if len(warnings) > 0 && !postFormCheckboxChecked(r, "acknowledgeWarnings", "yes") {
+		errorField["acknowledgeWarnings"] = map[string]string{
+			"reason": "Confirm you have read the warnings on this case",
+		}
+	}
+}
+
+// acknowledgeWarnings records that the user read the warnings before taking
+// action. It is called once the action has succeeded, so a failure is logged
+// rather than shown as the change has already been made.
+func acknowledgeWarnings(ctx sirius.Context, client AcknowledgeWarningClient, warnings []sirius.Warning, action string) {
+	for _, warning := range warnings {
+		if err := client.AcknowledgeWarning(ctx, warning.ID, action); err != nil {
+			telemetry.LoggerFromContext(ctx.Context).Error("warning acknowledgement failed", "warning", warning.ID, "action", action, "error", err)
+		}
+	}
+}
@@ -62,6 +62,7 @@ func GetActionPanelButtons(selectedCases []sirius.Case, donorId int, caseUids st
 	miReportingUrl := fmt.Sprintf("/mi-reporting?donorId=%d%s", donorId, caseUids)
 	linkPersonUrl := fmt.Sprintf("/link-person?id=%d%s", donorId, caseUids)
 	unlinkPersonUrl := fmt.Sprintf("/unlink-person?id=%d%s", donorId, caseUids)
+	mergePersonUrl := fmt.Sprintf("/merge-person?id=%d%s", donorId, caseUids)
 	deleteRelationshipUrl := fmt.Sprintf("/delete-relationship?id=%d%s", donorId, caseUids)
 	createRelationshipUrl := fmt.Sprintf("/create-relationship?id=%d&entity=person%s", donorId, caseUids)
 	createEpaUrl := fmt.Sprintf("/create-epa?id=%d", donorId)
@@ -241,6 +242,13 @@ func GetActionPanelButtons(selectedCases []sirius.Case, donorId int, caseUids st
 			Disabled: donorId == 0 || !hasLinks,
 			Hidden:   !userPermissions.Includes("v1-person-links", "PATCH"),
 		},
+		{
+			Label:    "Merge records",
+			URL:      mergePersonUrl,
+			IconName: "aw-link",
+			Disabled: donorId == 0,
+			Hidden:   !userPermissions.Includes("v1-person-merges", "POST"),
+		},
 		{
 			Label:    "Delete relationship",
 			URL:      deleteRelationshipUrl,
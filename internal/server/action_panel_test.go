@@ -62,6 +62,7 @@ var actionPanelPermissions = sirius.Permissions{
 	"v1-notes":                 sirius.PermissionType{Permissions: []string{"POST"}},
 	"v1-payments":              sirius.PermissionType{Permissions: []string{"GET"}},
 	"v1-person-links":          sirius.PermissionType{Permissions: []string{"POST", "PATCH"}},
+	"v1-person-merges":         sirius.PermissionType{Permissions: []string{"POST"}},
 	"v1-person-references":     sirius.PermissionType{Permissions: []string{"DELETE"}},
 	"v1-persons":               sirius.PermissionType{Permissions: []string{"GET"}},
 	"v1-persons-cases":         sirius.PermissionType{Permissions: []string{"GET"}},
@@ -194,6 +195,12 @@ func TestGetActionPanel(t *testing.T) {
 					IconName: "aw-unlink",
 					Disabled: true,
 				},
+				{
+					Label:    "Merge records",
+					URL:      "/merge-person?id=123",
+					IconName: "aw-link",
+					Disabled: false,
+				},
 				{
 					Label:    "Delete relationship",
 					URL:      "/delete-relationship?id=123",
@@ -375,6 +382,12 @@ func TestGetActionPanelWithUIDFilter(t *testing.T) {
 					IconName: "aw-unlink",
 					Disabled: false,
 				},
+				{
+					Label:    "Merge records",
+					URL:      "/merge-person?id=123&uid[]=7000-0000-0001",
+					IconName: "aw-link",
+					Disabled: false,
+				},
 				{
 					Label:    "Delete relationship",
 					URL:      "/delete-relationship?id=123&uid[]=7000-0000-0001",
@@ -583,6 +596,12 @@ func TestGetActionPanelNoDonorID(t *testing.T) {
 					IconName: "aw-unlink",
 					Disabled: true,
 				},
+				{
+					Label:    "Merge records",
+					URL:      "/merge-person?id=0",
+					IconName: "aw-link",
+					Disabled: true,
+				},
 				{
 					Label:    "Delete relationship",
 					URL:      "/delete-relationship?id=0",
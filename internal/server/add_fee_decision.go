@@ -6,7 +6,9 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/ministryofjustice/opg-go-common/telemetry"
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/fees"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 )
 
@@ -14,6 +16,7 @@ type AddFeeDecisionClient interface {
 	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
 	AddFeeDecision(ctx sirius.Context, caseID int, decisionType string, decisionReason string, decisionDate sirius.DateString) error
 	Case(sirius.Context, int) (sirius.Case, error)
+	Payments(ctx sirius.Context, id int) ([]sirius.Payment, error)
 }
 
 type addFeeDecisionData struct {
@@ -24,6 +27,7 @@ type addFeeDecisionData struct {
 	DecisionType   string
 	DecisionReason string
 	DecisionDate   sirius.DateString
+	FeeSuggestion  *fees.Suggestion
 	ReturnUrl      string
 }
 
@@ -61,10 +65,36 @@ func AddFeeDecision(client AddFeeDecisionClient, tmpl template.Template) Handler
 			return nil
 		})
 
+		// the form does not need the fee suggestion, so its lookups are kept
+		// apart from the ones above
+		suggestionGroup, suggestionCtx := errgroup.WithContext(ctx.Context)
+
+		var payments []sirius.Payment
+		suggestionGroup.Go(func() error {
+			var err error
+			payments, err = client.Payments(ctx.With(suggestionCtx), caseID)
+			return err
+		})
+
+		var reductionTypes []sirius.RefDataItem
+		suggestionGroup.Go(func() error {
+			var err error
+			reductionTypes, err = client.RefDataByCategory(ctx.With(suggestionCtx), sirius.FeeReductionTypeCategory)
+			return err
+		})
+
+		suggestionErr := suggestionGroup.Wait()
+
 		if err := group.Wait(); err != nil {
 			return err
 		}
 
+		if suggestionErr != nil {
+			telemetry.LoggerFromContext(ctx.Context).Warn("fee suggestion lookup failed", "error", suggestionErr)
+		} else if suggestion, err := fees.Suggest(data.Case, payments, reductionTypes); err == nil {
+			data.FeeSuggestion = &suggestion
+		}
+
 		if data.Case.CaseType == "DIGITAL_LPA" {
 			data.ReturnUrl = fmt.Sprintf("/lpa/%s/payments", data.Case.UID)
 		} else {
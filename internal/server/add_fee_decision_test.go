@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/fees"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -25,6 +26,11 @@ func (m *mockAddFeeDecisionClient) Case(ctx sirius.Context, id int) (sirius.Case
 	return args.Get(0).(sirius.Case), args.Error(1)
 }
 
+func (m *mockAddFeeDecisionClient) Payments(ctx sirius.Context, id int) ([]sirius.Payment, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]sirius.Payment), args.Error(1)
+}
+
 func (m *mockAddFeeDecisionClient) RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error) {
 	args := m.Called(ctx, category)
 	if args.Get(0) != nil {
@@ -40,6 +46,13 @@ var feeDecisionTypes = []sirius.RefDataItem{
 	},
 }
 
+var feeReductionTypes = []sirius.RefDataItem{
+	{
+		Handle: "REMISSION",
+		Label:  "Remission",
+	},
+}
+
 func TestGetAddFeeDecision(t *testing.T) {
 	caseItem := sirius.Case{
 		UID: "7000-0000-0021",
@@ -49,6 +62,12 @@ func TestGetAddFeeDecision(t *testing.T) {
 	client.
 		On("Case", mock.Anything, 4).
 		Return(caseItem, nil)
+	client.
+		On("Payments", mock.Anything, 4).
+		Return([]sirius.Payment{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
+		Return(feeReductionTypes, nil)
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.FeeDecisionTypeCategory).
 		Return(feeDecisionTypes, nil)
@@ -73,6 +92,82 @@ func TestGetAddFeeDecision(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, client, template)
 }
 
+func TestGetAddFeeDecisionWithFeeSuggestion(t *testing.T) {
+	caseItem := sirius.Case{
+		UID:         "7000-0000-0021",
+		SubType:     "pfa",
+		ReceiptDate: "2022-05-03",
+	}
+	payments := []sirius.Payment{{ID: 2, Amount: 4100, Source: "PHONE"}}
+
+	client := &mockAddFeeDecisionClient{}
+	client.
+		On("Case", mock.Anything, 4).
+		Return(caseItem, nil)
+	client.
+		On("Payments", mock.Anything, 4).
+		Return(payments, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeDecisionTypeCategory).
+		Return(feeDecisionTypes, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
+		Return(feeReductionTypes, nil)
+
+	suggestion, _ := fees.Suggest(caseItem, payments, feeReductionTypes)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, addFeeDecisionData{
+			Case:          caseItem,
+			DecisionTypes: feeDecisionTypes,
+			FeeSuggestion: &suggestion,
+			ReturnUrl:     "/payments/4",
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=4", nil)
+	w := httptest.NewRecorder()
+
+	err := AddFeeDecision(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "REMISSION", suggestion.Suggested.Handle)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestAddFeeDecisionWhenFailureOnGetPayments(t *testing.T) {
+	client := &mockAddFeeDecisionClient{}
+	client.
+		On("Case", mock.Anything, 4).
+		Return(sirius.Case{}, nil)
+	client.
+		On("Payments", mock.Anything, 4).
+		Return([]sirius.Payment{}, errExample)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeDecisionTypeCategory).
+		Return(feeDecisionTypes, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
+		Return(feeReductionTypes, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, addFeeDecisionData{
+			DecisionTypes: feeDecisionTypes,
+			ReturnUrl:     "/payments/4",
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=4", nil)
+	w := httptest.NewRecorder()
+
+	err := AddFeeDecision(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
 func TestAddFeeDecisionNoID(t *testing.T) {
 	testCases := map[string]string{
 		"no-id":  "/",
@@ -143,6 +238,12 @@ func TestAddFeeDecisionInvalidPostData(t *testing.T) {
 			client.
 				On("Case", mock.Anything, 22222).
 				Return(caseItem, nil)
+			client.
+				On("Payments", mock.Anything, 22222).
+				Return([]sirius.Payment{}, nil)
+			client.
+				On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
+				Return(feeReductionTypes, nil)
 			client.
 				On("RefDataByCategory", mock.Anything, sirius.FeeDecisionTypeCategory).
 				Return(feeDecisionTypes, nil)
@@ -183,6 +284,12 @@ func TestAddFeeDecisionWhenFailureOnGetCase(t *testing.T) {
 	client.
 		On("Case", mock.Anything, 75757).
 		Return(sirius.Case{}, errExample)
+	client.
+		On("Payments", mock.Anything, 75757).
+		Return([]sirius.Payment{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
+		Return(feeReductionTypes, nil)
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.FeeDecisionTypeCategory).
 		Return(feeDecisionTypes, nil)
@@ -206,6 +313,12 @@ func TestAddFeeDecisionWhenTemplateErrors(t *testing.T) {
 	client.
 		On("Case", mock.Anything, 111).
 		Return(caseItem, nil)
+	client.
+		On("Payments", mock.Anything, 111).
+		Return([]sirius.Payment{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
+		Return(feeReductionTypes, nil)
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.FeeDecisionTypeCategory).
 		Return(feeDecisionTypes, nil)
@@ -238,6 +351,12 @@ func TestAddFeeDecisionWhenFailureOnGetRefData(t *testing.T) {
 	client.
 		On("Case", mock.Anything, 232).
 		Return(caseItem, nil)
+	client.
+		On("Payments", mock.Anything, 232).
+		Return([]sirius.Payment{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
+		Return(feeReductionTypes, nil)
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.FeeDecisionTypeCategory).
 		Return([]sirius.RefDataItem{}, errExample)
@@ -258,6 +377,12 @@ func TestAddFeeDecisionNonValidationClientError(t *testing.T) {
 	client.
 		On("Case", mock.Anything, 765).
 		Return(caseItem, nil)
+	client.
+		On("Payments", mock.Anything, 765).
+		Return([]sirius.Payment{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
+		Return(feeReductionTypes, nil)
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.FeeDecisionTypeCategory).
 		Return(feeDecisionTypes, nil)
@@ -301,6 +426,12 @@ func TestAddFeeDecisionToDigitalLpaPostSuccess(t *testing.T) {
 	client.
 		On("Case", mock.Anything, 454).
 		Return(caseItem, nil)
+	client.
+		On("Payments", mock.Anything, 454).
+		Return([]sirius.Payment{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
+		Return(feeReductionTypes, nil)
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.FeeDecisionTypeCategory).
 		Return(feeDecisionTypes, nil)
@@ -9,6 +9,7 @@ import (
 )
 
 type AddObjectionClient interface {
+	FollowUpTaskClient
 	CaseSummary(sirius.Context, string) (sirius.CaseSummary, error)
 	AddObjection(sirius.Context, sirius.ObjectionRequest) error
 }
@@ -83,6 +84,10 @@ func AddObjection(client AddObjectionClient, tmpl template.Template) Handler {
 			} else {
 				data.Success = true
 
+				if url := followUpTaskURL(ctx, client, cs.DigitalLpa.SiriusData.ID, sirius.FollowUpObjectionAdded); url != "" {
+					return RedirectError(url)
+				}
+
 				return RedirectError(fmt.Sprintf("/lpa/%s", caseUID))
 			}
 		}
@@ -22,6 +22,11 @@ func (m *mockAddObjectionClient) AddObjection(ctx sirius.Context, objection siri
 	return args.Error(0)
 }
 
+func (m *mockAddObjectionClient) TaskTemplates(ctx sirius.Context) ([]sirius.TaskTemplate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]sirius.TaskTemplate), args.Error(1)
+}
+
 func (m *mockAddObjectionClient) CaseSummary(ctx sirius.Context, uid string) (sirius.CaseSummary, error) {
 	args := m.Called(ctx, uid)
 	return args.Get(0).(sirius.CaseSummary), args.Error(1)
@@ -171,6 +176,10 @@ func TestPostAddObjection(t *testing.T) {
 					Notes:         "Test",
 				}).
 				Return(tc.apiError)
+			client.
+				On("TaskTemplates", mock.Anything).
+				Return([]sirius.TaskTemplate{}, nil).
+				Maybe()
 
 			template := &mockTemplate{}
 
@@ -194,6 +203,66 @@ func TestPostAddObjection(t *testing.T) {
 	}
 }
 
+func TestPostAddObjectionOffersFollowUpTask(t *testing.T) {
+	client := &mockAddObjectionClient{}
+	client.
+		On("CaseSummary", mock.Anything, "M-9898-9898-9898").
+		Return(testAddObjectionsCaseSummary, nil)
+	client.
+		On("AddObjection", mock.Anything, mock.Anything).
+		Return(nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate{
+			{ID: 3, Name: "Chase evidence"},
+			{ID: 4, Name: "Review objection", Trigger: sirius.FollowUpObjectionAdded},
+		}, nil)
+
+	form := url.Values{
+		"lpaUids":            {"M-9898-9898-9898"},
+		"receivedDate.day":   {"1"},
+		"receivedDate.month": {"1"},
+		"receivedDate.year":  {"2025"},
+		"objectionType":      {"factual"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/add-objection?uid=M-9898-9898-9898", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := AddObjection(client, nil)(w, r)
+	assert.Equal(t, RedirectError("/create-task?id=676&template=4&followUp=objection-added"), err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostAddObjectionWhenFollowUpTaskLookupFails(t *testing.T) {
+	client := &mockAddObjectionClient{}
+	client.
+		On("CaseSummary", mock.Anything, "M-9898-9898-9898").
+		Return(testAddObjectionsCaseSummary, nil)
+	client.
+		On("AddObjection", mock.Anything, mock.Anything).
+		Return(nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate{}, errExample)
+
+	form := url.Values{
+		"lpaUids":            {"M-9898-9898-9898"},
+		"receivedDate.day":   {"1"},
+		"receivedDate.month": {"1"},
+		"receivedDate.year":  {"2025"},
+		"objectionType":      {"factual"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/add-objection?uid=M-9898-9898-9898", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := AddObjection(client, nil)(w, r)
+	assert.Equal(t, RedirectError("/lpa/M-9898-9898-9898"), err)
+}
+
 func TestPostAddObjectionWhenValidationError(t *testing.T) {
 	client := &mockAddObjectionClient{}
 	client.
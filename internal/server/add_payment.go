@@ -3,17 +3,17 @@ package server
 import (
 	"fmt"
 	"net/http"
-	"strconv"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 )
 
 type AddPaymentClient interface {
 	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
-	AddPayment(ctx sirius.Context, caseID int, amount int, source string, paymentDate sirius.DateString) error
+	AddPayment(ctx sirius.Context, caseID int, amount shared.Money, source string, paymentDate sirius.DateString) error
 	Case(sirius.Context, int) (sirius.Case, error)
 }
 
@@ -77,11 +77,12 @@ func AddPayment(client AddPaymentClient, tmpl template.Template) Handler {
 		}
 
 		if r.Method == http.MethodPost {
-			if !sirius.IsAmountValid(data.Amount) {
+			amount, err := shared.ParseMoney(data.Amount)
+			if err != nil {
 				w.WriteHeader(http.StatusBadRequest)
 				data.Error = sirius.ValidationError{
 					Field: sirius.FieldErrors{
-						"amount": {"reason": "Please enter the amount to 2 decimal places"},
+						"amount": {"reason": "Enter the amount in pounds and pence, for example 82.00"},
 					},
 				}
 				if data.Source == "" {
@@ -98,14 +99,7 @@ func AddPayment(client AddPaymentClient, tmpl template.Template) Handler {
 				return tmpl(w, data)
 			}
 
-			amountFloat, err := strconv.ParseFloat(data.Amount, 64)
-			if err != nil {
-				return err
-			}
-
-			amountInPence := sirius.PoundsToPence(amountFloat)
-
-			err = client.AddPayment(ctx, caseID, amountInPence, data.Source, data.PaymentDate)
+			err = client.AddPayment(ctx, caseID, amount, data.Source, data.PaymentDate)
 			if ve, ok := err.(sirius.ValidationError); ok {
 				w.WriteHeader(http.StatusBadRequest)
 				data.Error = ve
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -16,7 +17,7 @@ type mockAddPaymentClient struct {
 	mock.Mock
 }
 
-func (m *mockAddPaymentClient) AddPayment(ctx sirius.Context, caseID int, amount int, source string, paymentDate sirius.DateString) error {
+func (m *mockAddPaymentClient) AddPayment(ctx sirius.Context, caseID int, amount shared.Money, source string, paymentDate sirius.DateString) error {
 	return m.Called(ctx, caseID, amount, source, paymentDate).Error(0)
 }
 
@@ -195,7 +196,7 @@ func TestPostAddPayment(t *testing.T) {
 
 	client := &mockAddPaymentClient{}
 	client.
-		On("AddPayment", mock.Anything, 123, 4100, "MAKE", sirius.DateString("2022-01-23")).
+		On("AddPayment", mock.Anything, 123, shared.Money(4100), "MAKE", sirius.DateString("2022-01-23")).
 		Return(nil)
 	client.
 		On("Case", mock.Anything, 123).
@@ -224,6 +225,34 @@ func TestPostAddPayment(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, client, template)
 }
 
+func TestPostAddPaymentWithFormattedAmount(t *testing.T) {
+	client := &mockAddPaymentClient{}
+	client.
+		On("AddPayment", mock.Anything, 123, shared.Money(123450), "MAKE", sirius.DateString("2022-01-23")).
+		Return(nil)
+	client.
+		On("Case", mock.Anything, 123).
+		Return(sirius.Case{CaseType: "lpa", UID: "700700"}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return([]sirius.RefDataItem{}, nil)
+
+	form := url.Values{
+		"amount":      {"£1,234.50"},
+		"source":      {"MAKE"},
+		"paymentDate": {"2022-01-23"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := AddPayment(client, nil)(w, r)
+
+	assert.Equal(t, RedirectError("/payments/123"), err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
 func TestPostAddPaymentHTMX(t *testing.T) {
 	caseitem := sirius.Case{CaseType: "lpa", UID: "700700"}
 
@@ -237,7 +266,7 @@ func TestPostAddPaymentHTMX(t *testing.T) {
 
 	client := &mockAddPaymentClient{}
 	client.
-		On("AddPayment", mock.Anything, 123, 4100, "MAKE", sirius.DateString("2022-01-23")).
+		On("AddPayment", mock.Anything, 123, shared.Money(4100), "MAKE", sirius.DateString("2022-01-23")).
 		Return(nil)
 	client.
 		On("Case", mock.Anything, 123).
@@ -280,7 +309,7 @@ func TestPostAddPaymentHTMX(t *testing.T) {
 }
 
 func TestPostAddPaymentAmountIncorrectFormat(t *testing.T) {
-	for _, amount := range []string{"41", "41.5", "41.555", ".45"} {
+	for _, amount := range []string{"41.555", "abc", "-41.00", "4,10.00"} {
 		t.Run(amount, func(t *testing.T) {
 			caseitem := sirius.Case{CaseType: "lpa", UID: "700700"}
 
@@ -302,7 +331,7 @@ func TestPostAddPaymentAmountIncorrectFormat(t *testing.T) {
 
 			validationError := sirius.ValidationError{
 				Field: sirius.FieldErrors{
-					"amount": {"reason": "Please enter the amount to 2 decimal places"},
+					"amount": {"reason": "Enter the amount in pounds and pence, for example 82.00"},
 				},
 			}
 
@@ -353,7 +382,7 @@ func TestPostAddPaymentToDigitalLpa(t *testing.T) {
 
 	client := &mockAddPaymentClient{}
 	client.
-		On("AddPayment", mock.Anything, 444, 5200, "PHONE", sirius.DateString("2023-08-31")).
+		On("AddPayment", mock.Anything, 444, shared.Money(5200), "PHONE", sirius.DateString("2023-08-31")).
 		Return(nil)
 	client.
 		On("Case", mock.Anything, 444).
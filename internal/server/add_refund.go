@@ -0,0 +1,142 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+type AddRefundClient interface {
+	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
+	AddRefund(ctx sirius.Context, caseID int, refund sirius.Refund) error
+	Payments(ctx sirius.Context, id int) ([]sirius.Payment, error)
+	Case(sirius.Context, int) (sirius.Case, error)
+}
+
+type addRefundData struct {
+	XSRFToken string
+	Error     sirius.ValidationError
+
+	Case             sirius.Case
+	Amount           string
+	Source           string
+	RefundDate       sirius.DateString
+	Reason           string
+	RefundableAmount shared.Money
+	IsPartial        bool
+	PaymentSources   []sirius.RefDataItem
+	ReturnUrl        string
+	HtmxRedirect     string
+}
+
+func AddRefund(client AddRefundClient, tmpl template.Template) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		caseID, err := strToIntOrStatusError(r.FormValue("id"))
+		if err != nil {
+			return err
+		}
+
+		ctx := getContext(r)
+		group, groupCtx := errgroup.WithContext(ctx.Context)
+		data := addRefundData{
+			XSRFToken:  ctx.XSRFToken,
+			Amount:     postFormString(r, "amount"),
+			Source:     postFormString(r, "source"),
+			RefundDate: postFormDateString(r, "refundDate"),
+			Reason:     postFormString(r, "reason"),
+			IsPartial:  r.Header.Get("HX-Request") == "true",
+		}
+
+		group.Go(func() error {
+			data.Case, err = client.Case(ctx.With(groupCtx), caseID)
+			if err != nil {
+				return err
+			}
+
+			payments, err := client.Payments(ctx.With(groupCtx), caseID)
+			if err != nil {
+				return err
+			}
+
+			data.RefundableAmount = sirius.NewPaymentLedger(data.Case.ExpectedPaymentTotal, payments).RefundableAmount()
+			return nil
+		})
+
+		group.Go(func() error {
+			data.PaymentSources, err = client.RefDataByCategory(ctx.With(groupCtx), sirius.PaymentSourceCategory)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+
+		if err := group.Wait(); err != nil {
+			return err
+		}
+
+		if data.Case.CaseType == "DIGITAL_LPA" {
+			data.ReturnUrl = fmt.Sprintf("/lpa/%s/payments", data.Case.UID)
+		} else {
+			data.ReturnUrl = fmt.Sprintf("/payments/%d", caseID)
+		}
+
+		if r.Method == http.MethodPost {
+			amount, err := shared.ParseMoney(data.Amount)
+			if err != nil || amount == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = sirius.ValidationError{
+					Field: sirius.FieldErrors{
+						"amount": {"reason": "Enter the amount in pounds and pence, for example 82.00"},
+					},
+				}
+
+				return tmpl(w, data)
+			}
+
+			if amount > data.RefundableAmount {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = sirius.ValidationError{
+					Field: sirius.FieldErrors{
+						"amount": {"reason": fmt.Sprintf("Refund cannot be more than the overpaid amount of %s", data.RefundableAmount)},
+					},
+				}
+
+				return tmpl(w, data)
+			}
+
+			err = client.AddRefund(ctx, caseID, sirius.Refund{
+				Amount:     amount,
+				Source:     data.Source,
+				RefundDate: data.RefundDate,
+				Reason:     data.Reason,
+			})
+			if ve, ok := err.(sirius.ValidationError); ok {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = ve
+
+				return tmpl(w, data)
+			} else if err != nil {
+				return err
+			}
+
+			SetFlash(w, FlashNotification{
+				Title: "Refund requested",
+			})
+
+			if data.IsPartial {
+				data.HtmxRedirect = data.ReturnUrl
+				return tmpl(w, data)
+			}
+
+			return RedirectError(data.ReturnUrl)
+		}
+
+		return tmpl(w, data)
+	}
+}
@@ -0,0 +1,338 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAddRefundClient struct {
+	mock.Mock
+}
+
+func (m *mockAddRefundClient) AddRefund(ctx sirius.Context, caseID int, refund sirius.Refund) error {
+	return m.Called(ctx, caseID, refund).Error(0)
+}
+
+func (m *mockAddRefundClient) Payments(ctx sirius.Context, id int) ([]sirius.Payment, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]sirius.Payment), args.Error(1)
+}
+
+func (m *mockAddRefundClient) Case(ctx sirius.Context, id int) (sirius.Case, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(sirius.Case), args.Error(1)
+}
+
+func (m *mockAddRefundClient) RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error) {
+	args := m.Called(ctx, category)
+	if args.Get(0) != nil {
+		return args.Get(0).([]sirius.RefDataItem), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func TestGetAddRefund(t *testing.T) {
+	caseitem := sirius.Case{ID: 4, UID: "7000-0000-0021", SubType: "pfa", ExpectedPaymentTotal: 8200}
+	payments := []sirius.Payment{
+		{ID: 1, Amount: 8200, Source: "PHONE"},
+		{ID: 2, Amount: 4100, Source: "PHONE"},
+		{ID: 3, Amount: -1000, Source: "PHONE", RefundStatus: shared.RefundStatusRequested},
+	}
+	paymentSources := []sirius.RefDataItem{{Handle: "PHONE", Label: "Paid over the phone", UserSelectable: true}}
+
+	client := &mockAddRefundClient{}
+	client.
+		On("Case", mock.Anything, 4).
+		Return(caseitem, nil)
+	client.
+		On("Payments", mock.Anything, 4).
+		Return(payments, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return(paymentSources, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, addRefundData{
+			Case:             caseitem,
+			PaymentSources:   paymentSources,
+			RefundableAmount: 3100,
+			ReturnUrl:        "/payments/4",
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=4", nil)
+	w := httptest.NewRecorder()
+
+	err := AddRefund(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestAddRefundNoID(t *testing.T) {
+	testCases := map[string]string{
+		"no-id":  "/",
+		"bad-id": "/?id=test",
+	}
+
+	for name, testUrl := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodGet, testUrl, nil)
+			w := httptest.NewRecorder()
+
+			err := AddRefund(nil, nil)(w, r)
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestAddRefundWhenFailureOnGetPayments(t *testing.T) {
+	caseitem := sirius.Case{ID: 4, UID: "7000-0000-0021", SubType: "pfa", ExpectedPaymentTotal: 8200}
+	paymentSources := []sirius.RefDataItem{{Handle: "PHONE", Label: "Paid over the phone", UserSelectable: true}}
+
+	client := &mockAddRefundClient{}
+	client.
+		On("Case", mock.Anything, 4).
+		Return(caseitem, nil)
+	client.
+		On("Payments", mock.Anything, 4).
+		Return([]sirius.Payment{}, errExample)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return(paymentSources, nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=4", nil)
+	w := httptest.NewRecorder()
+
+	err := AddRefund(client, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostAddRefund(t *testing.T) {
+	caseitem := sirius.Case{ID: 4, UID: "7000-0000-0021", SubType: "pfa", ExpectedPaymentTotal: 8200}
+	payments := []sirius.Payment{
+		{ID: 1, Amount: 8200, Source: "PHONE"},
+		{ID: 2, Amount: 4100, Source: "PHONE"},
+		{ID: 3, Amount: -1000, Source: "PHONE", RefundStatus: shared.RefundStatusRequested},
+	}
+	paymentSources := []sirius.RefDataItem{{Handle: "PHONE", Label: "Paid over the phone", UserSelectable: true}}
+
+	client := &mockAddRefundClient{}
+	client.
+		On("Case", mock.Anything, 4).
+		Return(caseitem, nil)
+	client.
+		On("Payments", mock.Anything, 4).
+		Return(payments, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return(paymentSources, nil)
+	client.
+		On("AddRefund", mock.Anything, 4, sirius.Refund{
+			Amount:     3100,
+			Source:     "PHONE",
+			RefundDate: sirius.DateString("2022-01-23"),
+			Reason:     "Paid twice",
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"amount":     {"31.00"},
+		"source":     {"PHONE"},
+		"refundDate": {"2022-01-23"},
+		"reason":     {"Paid twice"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=4", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := AddRefund(client, nil)(w, r)
+
+	assert.Equal(t, RedirectError("/payments/4"), err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostAddRefundWhenAmountInvalid(t *testing.T) {
+	caseitem := sirius.Case{ID: 4, UID: "7000-0000-0021", SubType: "pfa", ExpectedPaymentTotal: 8200}
+	payments := []sirius.Payment{
+		{ID: 1, Amount: 8200, Source: "PHONE"},
+		{ID: 2, Amount: 4100, Source: "PHONE"},
+		{ID: 3, Amount: -1000, Source: "PHONE", RefundStatus: shared.RefundStatusRequested},
+	}
+	paymentSources := []sirius.RefDataItem{{Handle: "PHONE", Label: "Paid over the phone", UserSelectable: true}}
+
+	testCases := map[string]struct {
+		amount string
+		reason string
+	}{
+		"not a number": {
+			amount: "abc",
+			reason: "Enter the amount in pounds and pence, for example 82.00",
+		},
+		"zero": {
+			amount: "0.00",
+			reason: "Enter the amount in pounds and pence, for example 82.00",
+		},
+		"more than overpaid": {
+			amount: "31.01",
+			reason: "Refund cannot be more than the overpaid amount of £31.00",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockAddRefundClient{}
+			client.
+				On("Case", mock.Anything, 4).
+				Return(caseitem, nil)
+			client.
+				On("Payments", mock.Anything, 4).
+				Return(payments, nil)
+			client.
+				On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+				Return(paymentSources, nil)
+
+			template := &mockTemplate{}
+			template.
+				On("Func", mock.Anything, addRefundData{
+					Case:             caseitem,
+					Amount:           tc.amount,
+					Source:           "PHONE",
+					PaymentSources:   paymentSources,
+					RefundableAmount: 3100,
+					ReturnUrl:        "/payments/4",
+					Error: sirius.ValidationError{
+						Field: sirius.FieldErrors{
+							"amount": {"reason": tc.reason},
+						},
+					},
+				}).
+				Return(nil)
+
+			form := url.Values{
+				"amount": {tc.amount},
+				"source": {"PHONE"},
+			}
+
+			r, _ := http.NewRequest(http.MethodPost, "/?id=4", strings.NewReader(form.Encode()))
+			r.Header.Add("Content-Type", formUrlEncoded)
+			w := httptest.NewRecorder()
+
+			err := AddRefund(client, template.Func)(w, r)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			mock.AssertExpectationsForObjects(t, client, template)
+		})
+	}
+}
+
+func TestPostAddRefundWhenValidationError(t *testing.T) {
+	caseitem := sirius.Case{ID: 4, UID: "7000-0000-0021", SubType: "pfa", ExpectedPaymentTotal: 8200}
+	payments := []sirius.Payment{
+		{ID: 1, Amount: 8200, Source: "PHONE"},
+		{ID: 2, Amount: 4100, Source: "PHONE"},
+		{ID: 3, Amount: -1000, Source: "PHONE", RefundStatus: shared.RefundStatusRequested},
+	}
+	paymentSources := []sirius.RefDataItem{{Handle: "PHONE", Label: "Paid over the phone", UserSelectable: true}}
+
+	expectedError := sirius.ValidationError{
+		Field: sirius.FieldErrors{
+			"reason": {"isEmpty": "Value is required and can't be empty"},
+		},
+	}
+
+	client := &mockAddRefundClient{}
+	client.
+		On("Case", mock.Anything, 4).
+		Return(caseitem, nil)
+	client.
+		On("Payments", mock.Anything, 4).
+		Return(payments, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return(paymentSources, nil)
+	client.
+		On("AddRefund", mock.Anything, 4, sirius.Refund{Amount: 1000}).
+		Return(expectedError)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, addRefundData{
+			Case:             caseitem,
+			Amount:           "10",
+			PaymentSources:   paymentSources,
+			RefundableAmount: 3100,
+			ReturnUrl:        "/payments/4",
+			Error:            expectedError,
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"amount": {"10"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=4", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := AddRefund(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostAddRefundWhenOtherError(t *testing.T) {
+	caseitem := sirius.Case{ID: 4, UID: "7000-0000-0021", SubType: "pfa", ExpectedPaymentTotal: 8200}
+	payments := []sirius.Payment{
+		{ID: 1, Amount: 8200, Source: "PHONE"},
+		{ID: 2, Amount: 4100, Source: "PHONE"},
+		{ID: 3, Amount: -1000, Source: "PHONE", RefundStatus: shared.RefundStatusRequested},
+	}
+	paymentSources := []sirius.RefDataItem{{Handle: "PHONE", Label: "Paid over the phone", UserSelectable: true}}
+
+	client := &mockAddRefundClient{}
+	client.
+		On("Case", mock.Anything, 4).
+		Return(caseitem, nil)
+	client.
+		On("Payments", mock.Anything, 4).
+		Return(payments, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return(paymentSources, nil)
+	client.
+		On("AddRefund", mock.Anything, 4, sirius.Refund{Amount: 1000}).
+		Return(errExample)
+
+	form := url.Values{
+		"amount": {"10"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=4", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := AddRefund(client, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+	mock.AssertExpectationsForObjects(t, client)
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/address"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+// normaliseAddress tidies an address entered on a form, returning the reason
+// the postcode is not valid if it cannot be used. The postcode is only checked
+// when the address differs from the one stored, so that a record held with a
+// bad postcode can still have its other details changed.
+func normaliseAddress(stored, a sirius.Address) (sirius.Address, string) {
+	a = address.Normalise(a)
+
+	if !addressChanged(stored, a) {
+		return a, ""
+	}
+
+	switch address.Validate(a) {
+	case address.ErrInvalidPostcode:
+		return a, "Enter a real postcode"
+	case address.ErrInvalidBFPO:
+		return a, "Enter a BFPO number in the format BFPO 123"
+	}
+
+	return a, ""
+}
+
+// addressMismatch reports whether a UK address is missing from the results of
+// a postcode lookup, suggesting it was typed in wrongly. Addresses that cannot
+// be looked up are not reported.
+func addressMismatch(ctx sirius.Context, client PostcodeLookupClient, a sirius.Address) bool {
+	if a.Postcode == "" || address.KindOf(a) != address.KindUK {
+		return false
+	}
+
+	results, err := client.PostcodeLookup(ctx, a.Postcode)
+	if err != nil {
+		return false
+	}
+
+	return !address.MatchesLookup(a, results)
+}
+
+// addressChanged reports whether a normalised address from a form differs from
+// the address already held
+func addressChanged(stored, submitted sirius.Address) bool {
+	return address.Normalise(stored) != submitted
+}
+
+// personAddress returns the address fields of a person, which are held flat
+// rather than as a sirius.Address
+func personAddress(p sirius.Person) sirius.Address {
+	return sirius.Address{
+		Line1:    p.AddressLine1,
+		Line2:    p.AddressLine2,
+		Line3:    p.AddressLine3,
+		Town:     p.Town,
+		Postcode: p.Postcode,
+		Country:  p.Country,
+	}
+}
+
+func setPersonAddress(p *sirius.Person, a sirius.Address) {
+	p.AddressLine1 = a.Line1
+	p.AddressLine2 = a.Line2
+	p.AddressLine3 = a.Line3
+	p.Town = a.Town
+	p.Postcode = a.Postcode
+	p.Country = a.Country
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNormaliseAddress(t *testing.T) {
+	testCases := map[string]struct {
+		stored   sirius.Address
+		address  sirius.Address
+		expected sirius.Address
+		reason   string
+	}{
+		"uk": {
+			address:  sirius.Address{Line1: " 1 Road ", Postcode: "sw1a1aa", Country: "gb"},
+			expected: sirius.Address{Line1: "1 Road", Postcode: "SW1A 1AA", Country: "GB"},
+		},
+		"invalid postcode": {
+			address:  sirius.Address{Line1: "1 Road", Postcode: "SW1A", Country: "GB"},
+			expected: sirius.Address{Line1: "1 Road", Postcode: "SW1A", Country: "GB"},
+			reason:   "Enter a real postcode",
+		},
+		"invalid bfpo": {
+			address:  sirius.Address{Line1: "Unit 1", Postcode: "BFPO X1"},
+			expected: sirius.Address{Line1: "Unit 1", Postcode: "BFPO X1"},
+			reason:   "Enter a BFPO number in the format BFPO 123",
+		},
+		"overseas": {
+			address:  sirius.Address{Line1: "Rue 1", Postcode: "75001", Country: "France"},
+			expected: sirius.Address{Line1: "Rue 1", Postcode: "75001", Country: "France"},
+		},
+		"unchanged invalid postcode": {
+			stored:   sirius.Address{Line1: "1 Road", Postcode: "sw1a"},
+			address:  sirius.Address{Line1: "1 Road ", Postcode: "SW1A"},
+			expected: sirius.Address{Line1: "1 Road", Postcode: "SW1A"},
+		},
+		"unchanged overseas without country": {
+			stored:   sirius.Address{Line1: "Rue 1", Postcode: "75001"},
+			address:  sirius.Address{Line1: "Rue 1", Postcode: "75001"},
+			expected: sirius.Address{Line1: "Rue 1", Postcode: "75001"},
+		},
+		"changed from invalid postcode": {
+			stored:   sirius.Address{Line1: "1 Road", Postcode: "SW1A"},
+			address:  sirius.Address{Line1: "2 Road", Postcode: "SW1A"},
+			expected: sirius.Address{Line1: "2 Road", Postcode: "SW1A"},
+			reason:   "Enter a real postcode",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			address, reason := normaliseAddress(tc.stored, tc.address)
+
+			assert.Equal(t, tc.expected, address)
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func TestAddressMismatch(t *testing.T) {
+	ukAddress := sirius.Address{Line1: "1 Road", Postcode: "SW1A 1AA", Country: "GB"}
+
+	testCases := map[string]struct {
+		address  sirius.Address
+		results  []sirius.PostcodeLookupAddress
+		err      error
+		lookup   bool
+		expected bool
+	}{
+		"matches": {
+			address: ukAddress,
+			results: []sirius.PostcodeLookupAddress{{Line1: "1 ROAD", Postcode: "SW1A 1AA"}},
+			lookup:  true,
+		},
+		"does not match": {
+			address:  ukAddress,
+			results:  []sirius.PostcodeLookupAddress{{Line1: "2 Road", Postcode: "SW1A 1AA"}},
+			lookup:   true,
+			expected: true,
+		},
+		"lookup fails": {
+			address: ukAddress,
+			results: []sirius.PostcodeLookupAddress{},
+			err:     errExample,
+			lookup:  true,
+		},
+		"overseas": {
+			address: sirius.Address{Line1: "Rue 1", Postcode: "75001", Country: "FR"},
+		},
+		"bfpo": {
+			address: sirius.Address{Line1: "Unit 1", Postcode: "BFPO 123"},
+		},
+		"no postcode": {
+			address: sirius.Address{Line1: "1 Road", Country: "GB"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockPostcodeLookupClient{}
+			if tc.lookup {
+				client.
+					On("PostcodeLookup", mock.Anything, tc.address.Postcode).
+					Return(tc.results, tc.err)
+			}
+
+			assert.Equal(t, tc.expected, addressMismatch(sirius.Context{}, client, tc.address))
+			mock.AssertExpectationsForObjects(t, client)
+		})
+	}
+}
+
+func TestAddressChanged(t *testing.T) {
+	stored := sirius.Address{Line1: "1 Road", Postcode: "sw1a 1aa", Country: "GB"}
+
+	assert.False(t, addressChanged(stored, sirius.Address{Line1: "1 Road", Postcode: "SW1A 1AA", Country: "GB"}))
+	assert.True(t, addressChanged(stored, sirius.Address{Line1: "2 Road", Postcode: "SW1A 1AA", Country: "GB"}))
+}
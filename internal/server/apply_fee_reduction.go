@@ -11,6 +11,7 @@ import (
 )
 
 type ApplyFeeReductionClient interface {
+	FollowUpTaskClient
 	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
 	ApplyFeeReduction(ctx sirius.Context, caseID int, feeReductionType string, paymentEvidence string, paymentDate sirius.DateString) error
 	Case(sirius.Context, int) (sirius.Case, error)
@@ -88,12 +89,17 @@ func ApplyFeeReduction(client ApplyFeeReductionClient, tmpl template.Template) H
 					Title: fmt.Sprintf("%s approved", translateRefData(data.FeeReductionTypes, data.FeeReductionType)),
 				})
 
+				redirect := data.ReturnUrl
+				if url := followUpTaskURL(ctx, client, caseID, sirius.FollowUpFeeReductionApplied); url != "" {
+					redirect = url
+				}
+
 				if data.IsPartial {
-					data.HtmxRedirect = data.ReturnUrl
+					data.HtmxRedirect = redirect
 					return tmpl(w, data)
 				}
 
-				return RedirectError(data.ReturnUrl)
+				return RedirectError(redirect)
 			}
 		}
 		return tmpl(w, data)
@@ -17,6 +17,11 @@ type mockApplyFeeReductionClient struct {
 	mock.Mock
 }
 
+func (m *mockApplyFeeReductionClient) TaskTemplates(ctx sirius.Context) ([]sirius.TaskTemplate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]sirius.TaskTemplate), args.Error(1)
+}
+
 func (m *mockApplyFeeReductionClient) ApplyFeeReduction(ctx sirius.Context, caseID int, feeReductionType string, paymentEvidence string, paymentDate sirius.DateString) error {
 	return m.Called(ctx, caseID, feeReductionType, paymentEvidence, paymentDate).Error(0)
 }
@@ -185,6 +190,7 @@ func TestPostFeeReduction(t *testing.T) {
 		id               int
 		caseItem         sirius.Case
 		paymentDate      string
+		templates        []sirius.TaskTemplate
 		expectedRedirect string
 	}{
 		{
@@ -201,6 +207,14 @@ func TestPostFeeReduction(t *testing.T) {
 			paymentDate:      "2023-09-01",
 			expectedRedirect: "/lpa/M-AAA-BBB-CCC/payments",
 		},
+		{
+			name:             "Follow-up task",
+			id:               9456,
+			caseItem:         sirius.Case{CaseType: "DIGITAL_LPA", UID: "M-AAA-BBB-CCC"},
+			paymentDate:      "2023-09-01",
+			templates:        []sirius.TaskTemplate{{ID: 7, Trigger: sirius.FollowUpFeeReductionApplied}},
+			expectedRedirect: "/create-task?id=9456&template=7&followUp=fee-reduction-applied",
+		},
 	}
 
 	feeReductionTypes := []sirius.RefDataItem{
@@ -221,6 +235,9 @@ func TestPostFeeReduction(t *testing.T) {
 		client.
 			On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
 			Return(feeReductionTypes, nil)
+		client.
+			On("TaskTemplates", mock.Anything).
+			Return(tc.templates, nil)
 
 		template := &mockTemplate{}
 
@@ -263,6 +280,9 @@ func TestPostFeeReductionHtmx(t *testing.T) {
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.FeeReductionTypeCategory).
 		Return(feeReductionTypes, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate{}, nil)
 
 	template := &mockTemplate{}
 	template.
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+const (
+	attorneyNodeWidth    = 260
+	attorneyNodeHeight   = 72
+	attorneyNodeGap      = 12
+	attorneyColumnGap    = 80
+	attorneyHeaderHeight = 64
+)
+
+// attorneyNode is one attorney or trust corporation positioned in the
+// attorney structure diagram
+type attorneyNode struct {
+	Name                     string
+	Kind                     string
+	Status                   string
+	StatusLabel              string
+	CannotMakeJointDecisions bool
+	X                        int
+	Y                        int
+	Width                    int
+	Height                   int
+}
+
+// attorneyColumn is a column of the diagram, either the attorneys appointed
+// originally or their replacements
+type attorneyColumn struct {
+	Heading   string
+	Decisions string
+	X         int
+	Nodes     []attorneyNode
+}
+
+// attorneyStructure holds the layout used to draw the attorney appointment
+// structure as an SVG on the LPA details page
+type attorneyStructure struct {
+	Width         int
+	Height        int
+	NodeWidth     int
+	Primary       attorneyColumn
+	Replacement   *attorneyColumn
+	StepIn        string
+	StepInDetails string
+}
+
+func (s attorneyStructure) ConnectorY() int {
+	return attorneyHeaderHeight + attorneyNodeHeight/2
+}
+
+func (s attorneyStructure) Description() string {
+	var parts []string
+
+	parts = append(parts, describeAttorneyColumn(s.Primary))
+	if s.Replacement != nil {
+		parts = append(parts, describeAttorneyColumn(*s.Replacement))
+		parts = append(parts, "Replacement attorneys step in: "+strings.ToLower(s.StepIn))
+	}
+
+	return strings.Join(parts, ". ")
+}
+
+func describeAttorneyColumn(column attorneyColumn) string {
+	var names []string
+	for _, node := range column.Nodes {
+		names = append(names, node.Name+" ("+strings.ToLower(node.StatusLabel)+")")
+	}
+
+	if len(names) == 0 {
+		return column.Heading + ": none"
+	}
+
+	return column.Heading + ", decisions " + strings.ToLower(column.Decisions) + ": " + strings.Join(names, ", ")
+}
+
+func newAttorneyStructure(lpa sirius.LpaStoreData, replacementDecisions shared.HowAttorneysMakeDecisions) attorneyStructure {
+	s := attorneyStructure{
+		NodeWidth: attorneyNodeWidth,
+		Primary:   attorneyColumn{Heading: "Attorneys"},
+	}
+
+	var primary, replacement []attorneyNode
+	activePrimary, replacementCount := 0, 0
+
+	add := func(attorney sirius.LpaStoreAttorney, name, kind string) {
+		node := attorneyNode{
+			Name:                     name,
+			Kind:                     kind,
+			Status:                   attorney.Status,
+			StatusLabel:              attorneyStatusLabel(attorney),
+			CannotMakeJointDecisions: attorney.Decisions,
+		}
+
+		if attorney.AppointmentType == shared.ReplacementAppointmentType.String() {
+			replacement = append(replacement, node)
+			if attorney.Status != shared.RemovedAttorneyStatus.String() {
+				replacementCount++
+			}
+		} else {
+			primary = append(primary, node)
+			if attorney.Status == shared.ActiveAttorneyStatus.String() {
+				activePrimary++
+			}
+		}
+	}
+
+	for _, attorney := range lpa.Attorneys {
+		add(attorney, strings.TrimSpace(attorney.FirstNames+" "+attorney.LastName), "Attorney")
+	}
+
+	for _, trustCorporation := range lpa.TrustCorporations {
+		add(trustCorporation.LpaStoreAttorney, trustCorporation.Name, "Trust corporation")
+	}
+
+	s.Primary.Decisions = lpa.HowAttorneysMakeDecisions.Translation(activePrimary == 1)
+	s.Primary.Nodes = positionAttorneyNodes(primary, 0)
+
+	rows := len(primary)
+	s.Width = attorneyNodeWidth
+
+	if len(replacement) > 0 {
+		x := attorneyNodeWidth + attorneyColumnGap
+		s.Replacement = &attorneyColumn{
+			Heading:   "Replacement attorneys",
+			Decisions: replacementDecisions.Translation(replacementCount == 1),
+			X:         x,
+			Nodes:     positionAttorneyNodes(replacement, x),
+		}
+		s.StepIn = lpa.HowReplacementAttorneysStepIn.Translation()
+		if lpa.HowReplacementAttorneysStepIn == shared.HowReplacementAttorneysStepInAnotherWay {
+			s.StepInDetails = lpa.HowReplacementAttorneysStepInDetails
+		}
+
+		s.Width = x + attorneyNodeWidth
+		rows = max(rows, len(replacement))
+	}
+
+	s.Height = attorneyHeaderHeight + max(rows, 1)*(attorneyNodeHeight+attorneyNodeGap)
+
+	return s
+}
+
+func positionAttorneyNodes(nodes []attorneyNode, x int) []attorneyNode {
+	for i := range nodes {
+		nodes[i].X = x
+		nodes[i].Y = attorneyHeaderHeight + i*(attorneyNodeHeight+attorneyNodeGap)
+		nodes[i].Width = attorneyNodeWidth
+		nodes[i].Height = attorneyNodeHeight
+	}
+
+	return nodes
+}
+
+func attorneyStatusLabel(attorney sirius.LpaStoreAttorney) string {
+	isReplacement := attorney.AppointmentType == shared.ReplacementAppointmentType.String()
+
+	switch attorney.Status {
+	case shared.ActiveAttorneyStatus.String():
+		if isReplacement {
+			return "Stepped in"
+		}
+		return "Active"
+	case shared.InactiveAttorneyStatus.String():
+		if isReplacement {
+			return "Not yet acting"
+		}
+		return "Inactive"
+	case shared.RemovedAttorneyStatus.String():
+		return "Removed"
+	}
+
+	return "Status not known"
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAttorneyStructure(t *testing.T) {
+	lpa := sirius.LpaStoreData{
+		Attorneys: []sirius.LpaStoreAttorney{
+			{
+				LpaStorePerson:  sirius.LpaStorePerson{FirstNames: "Amy", LastName: "Active"},
+				Status:          shared.ActiveAttorneyStatus.String(),
+				AppointmentType: shared.OriginalAppointmentType.String(),
+			},
+			{
+				LpaStorePerson:  sirius.LpaStorePerson{FirstNames: "Rory", LastName: "Removed"},
+				Status:          shared.RemovedAttorneyStatus.String(),
+				AppointmentType: shared.OriginalAppointmentType.String(),
+			},
+			{
+				LpaStorePerson:  sirius.LpaStorePerson{FirstNames: "Sam", LastName: "Stepped"},
+				Status:          shared.ActiveAttorneyStatus.String(),
+				AppointmentType: shared.ReplacementAppointmentType.String(),
+				Decisions:       true,
+			},
+		},
+		TrustCorporations: []sirius.LpaStoreTrustCorporation{
+			{
+				LpaStoreAttorney: sirius.LpaStoreAttorney{
+					Status:          shared.InactiveAttorneyStatus.String(),
+					AppointmentType: shared.ReplacementAppointmentType.String(),
+				},
+				Name: "Trusty Ltd",
+			},
+		},
+		HowAttorneysMakeDecisions:            shared.HowAttorneysMakeDecisionsJointly,
+		HowReplacementAttorneysStepIn:        shared.HowReplacementAttorneysStepInAnotherWay,
+		HowReplacementAttorneysStepInDetails: "If Amy goes abroad",
+	}
+
+	structure := newAttorneyStructure(lpa, shared.HowAttorneysMakeDecisionsJointlyAndSeverally)
+
+	assert.Equal(t, attorneyStructure{
+		Width:     600,
+		Height:    232,
+		NodeWidth: 260,
+		Primary: attorneyColumn{
+			Heading:   "Attorneys",
+			Decisions: "There is only one attorney appointed",
+			Nodes: []attorneyNode{
+				{Name: "Amy Active", Kind: "Attorney", Status: "active", StatusLabel: "Active", X: 0, Y: 64, Width: 260, Height: 72},
+				{Name: "Rory Removed", Kind: "Attorney", Status: "removed", StatusLabel: "Removed", X: 0, Y: 148, Width: 260, Height: 72},
+			},
+		},
+		Replacement: &attorneyColumn{
+			Heading:   "Replacement attorneys",
+			Decisions: "Jointly & severally",
+			X:         340,
+			Nodes: []attorneyNode{
+				{Name: "Sam Stepped", Kind: "Attorney", Status: "active", StatusLabel: "Stepped in", CannotMakeJointDecisions: true, X: 340, Y: 64, Width: 260, Height: 72},
+				{Name: "Trusty Ltd", Kind: "Trust corporation", Status: "inactive", StatusLabel: "Not yet acting", X: 340, Y: 148, Width: 260, Height: 72},
+			},
+		},
+		StepIn:        "Another way",
+		StepInDetails: "If Amy goes abroad",
+	}, structure)
+
+	assert.Equal(t, "Attorneys, decisions there is only one attorney appointed: Amy Active (active), Rory Removed (removed). "+
+		"Replacement attorneys, decisions jointly & severally: Sam Stepped (stepped in), Trusty Ltd (not yet acting). "+
+		"Replacement attorneys step in: another way", structure.Description())
+}
+
+func TestNewAttorneyStructureWithoutReplacements(t *testing.T) {
+	lpa := sirius.LpaStoreData{
+		Attorneys: []sirius.LpaStoreAttorney{
+			{
+				LpaStorePerson:  sirius.LpaStorePerson{FirstNames: "Amy", LastName: "Active"},
+				Status:          shared.ActiveAttorneyStatus.String(),
+				AppointmentType: shared.OriginalAppointmentType.String(),
+			},
+			{
+				LpaStorePerson:  sirius.LpaStorePerson{FirstNames: "Bob", LastName: "Active"},
+				Status:          shared.ActiveAttorneyStatus.String(),
+				AppointmentType: shared.OriginalAppointmentType.String(),
+			},
+		},
+		HowAttorneysMakeDecisions:     shared.HowAttorneysMakeDecisionsJointlyAndSeverally,
+		HowReplacementAttorneysStepIn: shared.HowReplacementAttorneysStepInAllCanNoLongerAct,
+	}
+
+	structure := newAttorneyStructure(lpa, shared.HowAttorneysMakeDecisionsEmpty)
+
+	assert.Nil(t, structure.Replacement)
+	assert.Equal(t, "", structure.StepIn)
+	assert.Equal(t, 260, structure.Width)
+	assert.Equal(t, 232, structure.Height)
+	assert.Equal(t, "Jointly & severally", structure.Primary.Decisions)
+	assert.Len(t, structure.Primary.Nodes, 2)
+}
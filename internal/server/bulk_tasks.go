@@ -0,0 +1,367 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ministryofjustice/opg-go-common/telemetry"
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"golang.org/x/sync/errgroup"
+)
+
+type BulkTasksClient interface {
+	AssignTasks(ctx sirius.Context, assigneeID int, taskIDs []int) error
+	Case(ctx sirius.Context, id int) (sirius.Case, error)
+	ChangeTaskDueDate(ctx sirius.Context, taskID int, dueDate sirius.DateString) error
+	ClearTaskWithNote(ctx sirius.Context, taskID int, note string) error
+	GetUserDetails(ctx sirius.Context) (sirius.User, error)
+	Task(ctx sirius.Context, id int) (sirius.Task, error)
+	Teams(ctx sirius.Context) ([]sirius.Team, error)
+}
+
+const (
+	bulkTaskAssign  = "assign"
+	bulkTaskClear   = "clear"
+	bulkTaskDueDate = "due-date"
+
+	// bulkTasksConcurrency limits how many tasks are fetched or updated at
+	// once, so a large selection does not flood Sirius
+	bulkTasksConcurrency = 5
+)
+
+// bulkTaskResult is the outcome for one of the selected tasks. Done tasks
+// have been updated and are not submitted again, Missing tasks could not be
+// fetched so are not updated; Result explains what happened to the task.
+type bulkTaskResult struct {
+	Task    sirius.Task
+	Done    bool
+	Missing bool
+	Result  string
+}
+
+type bulkTasksData struct {
+	XSRFToken string
+	Action    string
+	ReturnURL string
+	Tasks     []bulkTaskResult
+	Error     sirius.ValidationError
+
+	Teams          []sirius.Team
+	AssignTo       string
+	CompletionNote string
+	DueDate        sirius.DateString
+}
+
+// Remaining is the number of selected tasks still to be updated
+func (d bulkTasksData) Remaining() int {
+	count := 0
+	for _, t := range d.Tasks {
+		if !t.Done {
+			count++
+		}
+	}
+
+	return count
+}
+
+func BulkTasks(client BulkTasksClient, tmpl template.Template) Handler {
+	return bulkTasksWithNow(client, tmpl, time.Now)
+}
+
+func bulkTasksWithNow(client BulkTasksClient, tmpl template.Template, now func() time.Time) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		if err := r.ParseForm(); err != nil {
+			return err
+		}
+
+		query := r.URL.Query()
+
+		action := query.Get("action")
+		if action != bulkTaskAssign && action != bulkTaskClear && action != bulkTaskDueDate {
+			return sirius.StatusError{Code: http.StatusBadRequest}
+		}
+
+		// the tasks still to be updated are posted back with the form, so only
+		// those that failed are tried again
+		ids := query["id"]
+		if r.Method == http.MethodPost {
+			ids = r.PostForm["id"]
+		}
+
+		var taskIDs []int
+		for _, id := range ids {
+			taskID, err := strToIntOrStatusError(id)
+			if err != nil {
+				return err
+			}
+			taskIDs = append(taskIDs, taskID)
+		}
+
+		ctx := getContext(r)
+		data := bulkTasksData{
+			XSRFToken: ctx.XSRFToken,
+			Action:    action,
+			ReturnURL: bulkTasksReturnURL(query),
+			Error:     sirius.ValidationError{Field: sirius.FieldErrors{}},
+		}
+
+		if len(taskIDs) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			data.Error.Field["id"] = map[string]string{"reason": "Select at least one task"}
+			return tmpl(w, data)
+		}
+
+		data.Tasks = make([]bulkTaskResult, len(taskIDs))
+		results := make([]error, len(taskIDs))
+
+		group, groupCtx := errgroup.WithContext(ctx.Context)
+		group.SetLimit(bulkTasksConcurrency)
+
+		if action == bulkTaskAssign {
+			group.Go(func() error {
+				teams, err := client.Teams(ctx.With(groupCtx))
+				if err != nil {
+					return err
+				}
+
+				data.Teams = teams
+				return nil
+			})
+		}
+
+		// a task may have been completed or deleted since it was selected, which
+		// should not stop the others from being updated
+		for i, taskID := range taskIDs {
+			group.Go(func() error {
+				task, err := client.Task(ctx.With(groupCtx), taskID)
+				if err != nil {
+					telemetry.LoggerFromContext(ctx.Context).Warn("bulk task lookup failed", "task", taskID, "error", err)
+					data.Tasks[i] = bulkTaskResult{
+						Task:    sirius.Task{ID: taskID},
+						Missing: true,
+						Result:  "The task could not be found, it may have been completed or deleted",
+					}
+					results[i] = err
+					return nil
+				}
+
+				data.Tasks[i].Task = task
+				return nil
+			})
+		}
+
+		if err := group.Wait(); err != nil {
+			return err
+		}
+
+		if r.Method == http.MethodPost {
+			var update func(sirius.Task) error
+			var done string
+
+			switch action {
+			case bulkTaskAssign:
+				data.AssignTo = postFormString(r, "assignTo")
+
+				assigneeID, err := bulkTasksAssignee(ctx, client, r, data.Error.Field)
+				if err != nil {
+					return err
+				}
+
+				update = func(task sirius.Task) error {
+					if data.AssignTo == "caseOwner" {
+						if len(task.CaseItems) == 0 {
+							return errors.New("task is not linked to a case")
+						}
+
+						caseitem, err := client.Case(ctx, task.CaseItems[0].ID)
+						if err != nil {
+							return err
+						}
+
+						return client.AssignTasks(ctx, caseitem.Assignee.ID, []int{task.ID})
+					}
+
+					return client.AssignTasks(ctx, assigneeID, []int{task.ID})
+				}
+				done = "Assigned"
+
+			case bulkTaskClear:
+				data.CompletionNote = postFormString(r, "completionNote")
+				if strings.TrimSpace(data.CompletionNote) == "" {
+					data.Error.Field["completionNote"] = map[string]string{"reason": "Enter a completion note"}
+				}
+
+				update = func(task sirius.Task) error {
+					return client.ClearTaskWithNote(ctx, task.ID, data.CompletionNote)
+				}
+				done = "Cleared"
+
+			case bulkTaskDueDate:
+				data.DueDate = postFormDateString(r, "dueDate")
+
+				if _, err := data.DueDate.Time(); err != nil {
+					data.Error.Field["dueDate"] = map[string]string{"reason": "Enter a valid due date"}
+				} else if string(data.DueDate) < now().Format("2006-01-02") {
+					data.Error.Field["dueDate"] = map[string]string{"reason": "Due date must be today or in the future"}
+				}
+
+				update = func(task sirius.Task) error {
+					return client.ChangeTaskDueDate(ctx, task.ID, data.DueDate)
+				}
+				done = "Due date changed"
+			}
+
+			if data.Error.Any() {
+				w.WriteHeader(http.StatusBadRequest)
+				return tmpl(w, data)
+			}
+
+			// each task is updated separately so that one failing does not stop
+			// the others from being updated
+			var updates errgroup.Group
+			updates.SetLimit(bulkTasksConcurrency)
+
+			for i := range data.Tasks {
+				if data.Tasks[i].Missing {
+					continue
+				}
+
+				updates.Go(func() error {
+					results[i] = update(data.Tasks[i].Task)
+					return nil
+				})
+			}
+
+			_ = updates.Wait()
+
+			var firstErr error
+			updated, failed, invalid := 0, 0, 0
+
+			for i, err := range results {
+				if data.Tasks[i].Missing {
+					if firstErr == nil {
+						firstErr = err
+					}
+					failed++
+				} else if ve, ok := err.(sirius.ValidationError); ok {
+					data.Tasks[i].Result = validationErrorReason(ve)
+					invalid++
+				} else if err != nil {
+					telemetry.LoggerFromContext(ctx.Context).Error("bulk task update failed", "task", data.Tasks[i].Task.ID, "error", err)
+					data.Tasks[i].Result = "The task could not be updated, try again"
+					if firstErr == nil {
+						firstErr = err
+					}
+					failed++
+				} else {
+					data.Tasks[i].Done = true
+					data.Tasks[i].Result = done
+					updated++
+				}
+			}
+
+			if failed+invalid > 0 {
+				if updated == 0 && invalid == 0 {
+					return firstErr
+				}
+
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error.Detail = fmt.Sprintf("%d of %d tasks could not be updated", failed+invalid, len(data.Tasks))
+				return tmpl(w, data)
+			}
+
+			SetFlash(w, FlashNotification{Title: bulkTasksFlashTitle(action, updated)})
+			return RedirectError(data.ReturnURL)
+		}
+
+		return tmpl(w, data)
+	}
+}
+
+// bulkTasksAssignee finds who the tasks are being assigned to, adding to
+// fieldErrors when it has not been chosen. Assigning to the case owner is
+// resolved for each task, so it returns 0.
+func bulkTasksAssignee(ctx sirius.Context, client BulkTasksClient, r *http.Request, fieldErrors sirius.FieldErrors) (int, error) {
+	var assigneeID int
+
+	switch postFormString(r, "assignTo") {
+	case "me":
+		user, err := client.GetUserDetails(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return user.ID, nil
+	case "user":
+		parts := strings.SplitN(postFormString(r, "assigneeUser"), ":", 2)
+		assigneeID, _ = strconv.Atoi(parts[0])
+		if assigneeID == 0 {
+			fieldErrors["assigneeUser"] = map[string]string{"reason": "Select a user"}
+		}
+	case "team":
+		assigneeID, _ = postFormInt(r, "assigneeTeam")
+		if assigneeID == 0 {
+			fieldErrors["assigneeTeam"] = map[string]string{"reason": "Select a team"}
+		}
+	case "caseOwner":
+	default:
+		fieldErrors["assignTo"] = map[string]string{"reason": "Select who to assign the tasks to"}
+	}
+
+	return assigneeID, nil
+}
+
+func bulkTasksReturnURL(query url.Values) string {
+	if uid := query.Get("uid"); uid != "" {
+		return fmt.Sprintf("/lpa/%s", uid)
+	}
+
+	if donorID, err := strconv.Atoi(query.Get("donorId")); err == nil {
+		return fmt.Sprintf("/donor/%d/details", donorID)
+	}
+
+	return "/"
+}
+
+func bulkTasksFlashTitle(action string, count int) string {
+	tasks := "tasks"
+	if count == 1 {
+		tasks = "task"
+	}
+
+	switch action {
+	case bulkTaskAssign:
+		return fmt.Sprintf("%d %s assigned", count, tasks)
+	case bulkTaskClear:
+		return fmt.Sprintf("%d %s cleared", count, tasks)
+	default:
+		return fmt.Sprintf("Due date changed on %d %s", count, tasks)
+	}
+}
+
+// validationErrorReason gives a single message to show against a task when
+// Sirius rejects the update
+func validationErrorReason(ve sirius.ValidationError) string {
+	var reasons []string
+	for _, field := range ve.Field {
+		for _, reason := range field {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	if len(reasons) == 0 {
+		if ve.Detail != "" {
+			return ve.Detail
+		}
+		return "The task could not be updated"
+	}
+
+	sort.Strings(reasons)
+	return strings.Join(reasons, ", ")
+}
@@ -0,0 +1,471 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-go-common/telemetry"
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockBulkTasksClient struct {
+	mock.Mock
+}
+
+func (m *mockBulkTasksClient) AssignTasks(ctx sirius.Context, assigneeID int, taskIDs []int) error {
+	args := m.Called(ctx, assigneeID, taskIDs)
+	return args.Error(0)
+}
+
+func (m *mockBulkTasksClient) Case(ctx sirius.Context, id int) (sirius.Case, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(sirius.Case), args.Error(1)
+}
+
+func (m *mockBulkTasksClient) ChangeTaskDueDate(ctx sirius.Context, taskID int, dueDate sirius.DateString) error {
+	args := m.Called(ctx, taskID, dueDate)
+	return args.Error(0)
+}
+
+func (m *mockBulkTasksClient) ClearTaskWithNote(ctx sirius.Context, taskID int, note string) error {
+	args := m.Called(ctx, taskID, note)
+	return args.Error(0)
+}
+
+func (m *mockBulkTasksClient) GetUserDetails(ctx sirius.Context) (sirius.User, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(sirius.User), args.Error(1)
+}
+
+func (m *mockBulkTasksClient) Task(ctx sirius.Context, id int) (sirius.Task, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(sirius.Task), args.Error(1)
+}
+
+func (m *mockBulkTasksClient) Teams(ctx sirius.Context) ([]sirius.Team, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]sirius.Team), args.Error(1)
+}
+
+func postBulkTasks(client BulkTasksClient, tmpl template.Template, query string, form url.Values) (*http.Response, error) {
+	r, _ := http.NewRequest(http.MethodPost, "/bulk-tasks?"+query, strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := bulkTasksWithNow(client, tmpl, func() time.Time { return time.Date(2024, time.April, 10, 9, 0, 0, 0, time.UTC) })(w, r)
+	return w.Result(), err
+}
+
+func TestGetBulkTasks(t *testing.T) {
+	taskOne := sirius.Task{ID: 1, Name: "Review fee", CaseItems: []sirius.Case{{ID: 4, UID: "M-1111-2222-3333", CaseType: "DIGITAL_LPA"}}}
+	taskTwo := sirius.Task{ID: 2, Name: "Check ID", CaseItems: []sirius.Case{{ID: 5, UID: "M-4444-5555-6666", CaseType: "DIGITAL_LPA"}}}
+
+	client := &mockBulkTasksClient{}
+	client.
+		On("Task", mock.Anything, 1).
+		Return(taskOne, nil)
+	client.
+		On("Task", mock.Anything, 2).
+		Return(taskTwo, nil)
+	client.
+		On("Teams", mock.Anything).
+		Return([]sirius.Team{{ID: 7, DisplayName: "A Team"}}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, bulkTasksData{
+			Action:    "assign",
+			ReturnURL: "/lpa/M-1111-2222-3333",
+			Tasks:     []bulkTaskResult{{Task: taskOne}, {Task: taskTwo}},
+			Error:     sirius.ValidationError{Field: sirius.FieldErrors{}},
+			Teams:     []sirius.Team{{ID: 7, DisplayName: "A Team"}},
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/bulk-tasks?action=assign&uid=M-1111-2222-3333&id=1&id=2", nil)
+	w := httptest.NewRecorder()
+
+	err := BulkTasks(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetBulkTasksWhenNoneSelected(t *testing.T) {
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, bulkTasksData{
+			Action:    "clear",
+			ReturnURL: "/donor/82/details",
+			Error:     sirius.ValidationError{Field: sirius.FieldErrors{"id": {"reason": "Select at least one task"}}},
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/bulk-tasks?action=clear&donorId=82", nil)
+	w := httptest.NewRecorder()
+
+	err := BulkTasks(nil, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, template)
+}
+
+func TestGetBulkTasksWhenActionUnknown(t *testing.T) {
+	r, _ := http.NewRequest(http.MethodGet, "/bulk-tasks?action=delete&id=1", nil)
+	w := httptest.NewRecorder()
+
+	err := BulkTasks(nil, nil)(w, r)
+
+	assert.Equal(t, sirius.StatusError{Code: http.StatusBadRequest}, err)
+}
+
+func TestGetBulkTasksWhenTaskErrors(t *testing.T) {
+	taskTwo := sirius.Task{ID: 2, Name: "Check ID", CaseItems: []sirius.Case{{ID: 5, UID: "M-4444-5555-6666", CaseType: "DIGITAL_LPA"}}}
+
+	client := &mockBulkTasksClient{}
+	client.
+		On("Task", mock.Anything, 1).
+		Return(sirius.Task{}, errExample)
+	client.
+		On("Task", mock.Anything, 2).
+		Return(taskTwo, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data bulkTasksData) bool {
+			return assert.Equal(t, []bulkTaskResult{
+				{Task: sirius.Task{ID: 1}, Missing: true, Result: "The task could not be found, it may have been completed or deleted"},
+				{Task: taskTwo},
+			}, data.Tasks)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/bulk-tasks?action=clear&id=1&id=2", nil)
+	w := httptest.NewRecorder()
+
+	err := BulkTasks(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostBulkTasksWhenTaskErrors(t *testing.T) {
+	taskTwo := sirius.Task{ID: 2, Name: "Check ID", CaseItems: []sirius.Case{{ID: 5, UID: "M-4444-5555-6666", CaseType: "DIGITAL_LPA"}}}
+
+	client := &mockBulkTasksClient{}
+	client.
+		On("Task", mock.Anything, 1).
+		Return(sirius.Task{}, sirius.StatusError{Code: http.StatusNotFound})
+	client.
+		On("Task", mock.Anything, 2).
+		Return(taskTwo, nil)
+	client.
+		On("ClearTaskWithNote", mock.Anything, 2, "Done").
+		Return(nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data bulkTasksData) bool {
+			return assert.Equal(t, "1 of 2 tasks could not be updated", data.Error.Detail) &&
+				assert.Equal(t, []bulkTaskResult{
+					{Task: sirius.Task{ID: 1}, Missing: true, Result: "The task could not be found, it may have been completed or deleted"},
+					{Task: taskTwo, Done: true, Result: "Cleared"},
+				}, data.Tasks) &&
+				assert.Equal(t, 1, data.Remaining())
+		})).
+		Return(nil)
+
+	resp, err := postBulkTasks(client, template.Func, "action=clear", url.Values{
+		"id":             {"1", "2"},
+		"completionNote": {"Done"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	client.AssertNotCalled(t, "ClearTaskWithNote", mock.Anything, 1, mock.Anything)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostBulkTasksClear(t *testing.T) {
+	taskOne := sirius.Task{ID: 1, Name: "Review fee", CaseItems: []sirius.Case{{ID: 4, UID: "M-1111-2222-3333", CaseType: "DIGITAL_LPA"}}}
+	taskTwo := sirius.Task{ID: 2, Name: "Check ID", CaseItems: []sirius.Case{{ID: 5, UID: "M-4444-5555-6666", CaseType: "DIGITAL_LPA"}}}
+
+	client := &mockBulkTasksClient{}
+	client.
+		On("Task", mock.Anything, 1).
+		Return(taskOne, nil)
+	client.
+		On("Task", mock.Anything, 2).
+		Return(taskTwo, nil)
+	client.
+		On("ClearTaskWithNote", mock.Anything, 1, "Dealt with on the phone").
+		Return(nil)
+	client.
+		On("ClearTaskWithNote", mock.Anything, 2, "Dealt with on the phone").
+		Return(nil)
+
+	resp, err := postBulkTasks(client, nil, "action=clear&uid=M-1111-2222-3333", url.Values{
+		"id":             {"1", "2"},
+		"completionNote": {"Dealt with on the phone"},
+	})
+
+	assert.Equal(t, RedirectError("/lpa/M-1111-2222-3333"), err)
+	assert.Contains(t, resp.Header.Get("Set-Cookie"), flashCookieName)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostBulkTasksChangeDueDate(t *testing.T) {
+	taskOne := sirius.Task{ID: 1, Name: "Review fee", CaseItems: []sirius.Case{{ID: 4, UID: "M-1111-2222-3333", CaseType: "DIGITAL_LPA"}}}
+
+	client := &mockBulkTasksClient{}
+	client.
+		On("Task", mock.Anything, 1).
+		Return(taskOne, nil)
+	client.
+		On("ChangeTaskDueDate", mock.Anything, 1, sirius.DateString("2024-04-10")).
+		Return(nil)
+
+	_, err := postBulkTasks(client, nil, "action=due-date&donorId=82", url.Values{
+		"id":      {"1"},
+		"dueDate": {"2024-04-10"},
+	})
+
+	assert.Equal(t, RedirectError("/donor/82/details"), err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostBulkTasksAssignToTeam(t *testing.T) {
+	taskOne := sirius.Task{ID: 1, Name: "Review fee", CaseItems: []sirius.Case{{ID: 4, UID: "M-1111-2222-3333", CaseType: "DIGITAL_LPA"}}}
+	taskTwo := sirius.Task{ID: 2, Name: "Check ID", CaseItems: []sirius.Case{{ID: 5, UID: "M-4444-5555-6666", CaseType: "DIGITAL_LPA"}}}
+
+	client := &mockBulkTasksClient{}
+	client.
+		On("Task", mock.Anything, 1).
+		Return(taskOne, nil)
+	client.
+		On("Task", mock.Anything, 2).
+		Return(taskTwo, nil)
+	client.
+		On("Teams", mock.Anything).
+		Return([]sirius.Team{{ID: 7, DisplayName: "A Team"}}, nil)
+	client.
+		On("AssignTasks", mock.Anything, 7, []int{1}).
+		Return(nil)
+	client.
+		On("AssignTasks", mock.Anything, 7, []int{2}).
+		Return(nil)
+
+	_, err := postBulkTasks(client, nil, "action=assign&uid=M-1111-2222-3333", url.Values{
+		"id":           {"1", "2"},
+		"assignTo":     {"team"},
+		"assigneeTeam": {"7"},
+	})
+
+	assert.Equal(t, RedirectError("/lpa/M-1111-2222-3333"), err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostBulkTasksAssignToCaseOwner(t *testing.T) {
+	taskOne := sirius.Task{ID: 1, Name: "Review fee", CaseItems: []sirius.Case{{ID: 4, UID: "M-1111-2222-3333", CaseType: "DIGITAL_LPA"}}}
+	taskTwo := sirius.Task{ID: 2, Name: "Check ID", CaseItems: []sirius.Case{{ID: 5, UID: "M-4444-5555-6666", CaseType: "DIGITAL_LPA"}}}
+
+	client := &mockBulkTasksClient{}
+	client.
+		On("Task", mock.Anything, 1).
+		Return(taskOne, nil)
+	client.
+		On("Task", mock.Anything, 2).
+		Return(taskTwo, nil)
+	client.
+		On("Teams", mock.Anything).
+		Return([]sirius.Team{}, nil)
+	client.
+		On("Case", mock.Anything, 4).
+		Return(sirius.Case{Assignee: &sirius.Person{ID: 31}}, nil)
+	client.
+		On("Case", mock.Anything, 5).
+		Return(sirius.Case{Assignee: &sirius.Person{ID: 32}}, nil)
+	client.
+		On("AssignTasks", mock.Anything, 31, []int{1}).
+		Return(nil)
+	client.
+		On("AssignTasks", mock.Anything, 32, []int{2}).
+		Return(nil)
+
+	_, err := postBulkTasks(client, nil, "action=assign&uid=M-1111-2222-3333", url.Values{
+		"id":       {"1", "2"},
+		"assignTo": {"caseOwner"},
+	})
+
+	assert.Equal(t, RedirectError("/lpa/M-1111-2222-3333"), err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostBulkTasksWhenInvalid(t *testing.T) {
+	taskOne := sirius.Task{ID: 1, Name: "Review fee", CaseItems: []sirius.Case{{ID: 4, UID: "M-1111-2222-3333", CaseType: "DIGITAL_LPA"}}}
+	taskTwo := sirius.Task{ID: 2, Name: "Check ID", CaseItems: []sirius.Case{{ID: 5, UID: "M-4444-5555-6666", CaseType: "DIGITAL_LPA"}}}
+
+	testCases := map[string]struct {
+		query    string
+		form     url.Values
+		expected sirius.FieldErrors
+	}{
+		"assignee not chosen": {
+			query:    "action=assign",
+			form:     url.Values{"id": {"1"}},
+			expected: sirius.FieldErrors{"assignTo": {"reason": "Select who to assign the tasks to"}},
+		},
+		"team not chosen": {
+			query:    "action=assign",
+			form:     url.Values{"id": {"1"}, "assignTo": {"team"}},
+			expected: sirius.FieldErrors{"assigneeTeam": {"reason": "Select a team"}},
+		},
+		"missing note": {
+			query:    "action=clear",
+			form:     url.Values{"id": {"1"}, "completionNote": {" "}},
+			expected: sirius.FieldErrors{"completionNote": {"reason": "Enter a completion note"}},
+		},
+		"missing due date": {
+			query:    "action=due-date",
+			form:     url.Values{"id": {"1"}},
+			expected: sirius.FieldErrors{"dueDate": {"reason": "Enter a valid due date"}},
+		},
+		"due date in the past": {
+			query:    "action=due-date",
+			form:     url.Values{"id": {"1"}, "dueDate": {"2024-04-09"}},
+			expected: sirius.FieldErrors{"dueDate": {"reason": "Due date must be today or in the future"}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockBulkTasksClient{}
+			client.
+				On("Task", mock.Anything, 1).
+				Return(taskOne, nil)
+			client.
+				On("Task", mock.Anything, 2).
+				Return(taskTwo, nil)
+			client.
+				On("Teams", mock.Anything).
+				Return([]sirius.Team{}, nil).
+				Maybe()
+
+			template := &mockTemplate{}
+			template.
+				On("Func", mock.Anything, mock.MatchedBy(func(data bulkTasksData) bool {
+					return assert.Equal(t, tc.expected, data.Error.Field)
+				})).
+				Return(nil)
+
+			resp, err := postBulkTasks(client, template.Func, tc.query, tc.form)
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			mock.AssertExpectationsForObjects(t, template)
+		})
+	}
+}
+
+func TestPostBulkTasksReportsResultsPerTask(t *testing.T) {
+	today := time.Date(2024, time.April, 10, 9, 0, 0, 0, time.UTC)
+	taskOne := sirius.Task{ID: 1, Name: "Review fee", CaseItems: []sirius.Case{{ID: 4, UID: "M-1111-2222-3333", CaseType: "DIGITAL_LPA"}}}
+	taskTwo := sirius.Task{ID: 2, Name: "Check ID", CaseItems: []sirius.Case{{ID: 5, UID: "M-4444-5555-6666", CaseType: "DIGITAL_LPA"}}}
+
+	client := &mockBulkTasksClient{}
+	client.
+		On("Task", mock.Anything, 1).
+		Return(taskOne, nil)
+	client.
+		On("Task", mock.Anything, 2).
+		Return(taskTwo, nil)
+	client.
+		On("Task", mock.Anything, 3).
+		Return(sirius.Task{ID: 3, Name: "Chase"}, nil)
+	client.
+		On("ClearTaskWithNote", mock.Anything, 1, "Done").
+		Return(nil)
+	client.
+		On("ClearTaskWithNote", mock.Anything, 2, "Done").
+		Return(sirius.ValidationError{Field: sirius.FieldErrors{"status": {"reason": "Task is already completed"}}})
+	client.
+		On("ClearTaskWithNote", mock.Anything, 3, "Done").
+		Return(errExample)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data bulkTasksData) bool {
+			return assert.Equal(t, []bulkTaskResult{
+				{Task: taskOne, Done: true, Result: "Cleared"},
+				{Task: taskTwo, Result: "Task is already completed"},
+				{Task: sirius.Task{ID: 3, Name: "Chase"}, Result: "The task could not be updated, try again"},
+			}, data.Tasks) &&
+				assert.Equal(t, "2 of 3 tasks could not be updated", data.Error.Detail) &&
+				assert.Equal(t, 2, data.Remaining())
+		})).
+		Return(nil)
+
+	var buf bytes.Buffer
+	ctx := telemetry.ContextWithLogger(context.Background(), slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	form := url.Values{
+		"id":             {"1", "2", "3"},
+		"completionNote": {"Done"},
+	}
+
+	r, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/bulk-tasks?action=clear&uid=M-1111-2222-3333", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := bulkTasksWithNow(client, template.Func, func() time.Time { return today })(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, buf.String(), `"msg":"bulk task update failed","task":3,"error":"error"`)
+	assert.NotContains(t, buf.String(), `"task":2`)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostBulkTasksWhenAllFail(t *testing.T) {
+	taskOne := sirius.Task{ID: 1, Name: "Review fee", CaseItems: []sirius.Case{{ID: 4, UID: "M-1111-2222-3333", CaseType: "DIGITAL_LPA"}}}
+	taskTwo := sirius.Task{ID: 2, Name: "Check ID", CaseItems: []sirius.Case{{ID: 5, UID: "M-4444-5555-6666", CaseType: "DIGITAL_LPA"}}}
+
+	client := &mockBulkTasksClient{}
+	client.
+		On("Task", mock.Anything, 1).
+		Return(taskOne, nil)
+	client.
+		On("Task", mock.Anything, 2).
+		Return(taskTwo, nil)
+	client.
+		On("ChangeTaskDueDate", mock.Anything, mock.Anything, sirius.DateString("2024-05-01")).
+		Return(errExample)
+
+	_, err := postBulkTasks(client, nil, "action=due-date", url.Values{
+		"id":      {"1", "2"},
+		"dueDate": {"2024-05-01"},
+	})
+
+	assert.Equal(t, errExample, err)
+}
+
+func TestBulkTasksFlashTitle(t *testing.T) {
+	assert.Equal(t, "1 task assigned", bulkTasksFlashTitle(bulkTaskAssign, 1))
+	assert.Equal(t, "3 tasks cleared", bulkTasksFlashTitle(bulkTaskClear, 3))
+	assert.Equal(t, "Due date changed on 2 tasks", bulkTasksFlashTitle(bulkTaskDueDate, 2))
+}
@@ -13,6 +13,7 @@ type ChangeAttorneyDetailsClient interface {
 	CaseSummary(sirius.Context, string) (sirius.CaseSummary, error)
 	ChangeAttorneyDetails(sirius.Context, string, string, sirius.ChangeAttorneyDetails) error
 	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
+	PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error)
 }
 
 type changeAttorneyDetailsData struct {
@@ -24,6 +25,7 @@ type changeAttorneyDetailsData struct {
 	Form                    formAttorneyDetails
 	AttorneyStatus          string
 	AttorneyAppointmentType string
+	AddressMismatch         bool
 }
 
 type formAttorneyDetails struct {
@@ -107,11 +109,33 @@ func ChangeAttorneyDetails(client ChangeAttorneyDetailsClient, tmpl template.Tem
 		}
 
 		if r.Method == http.MethodPost {
+			storedAddress := data.Form.Address
+
 			err := decoder.Decode(&data.Form, r.PostForm)
 			if err != nil {
 				return err
 			}
 
+			var addressReason string
+			data.Form.Address, addressReason = normaliseAddress(storedAddress, data.Form.Address)
+
+			if addressReason != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = sirius.ValidationError{
+					Field: sirius.FieldErrors{
+						"address/postcode": {"reason": addressReason},
+					},
+				}
+				return tmpl(w, data)
+			}
+
+			if addressChanged(storedAddress, data.Form.Address) && postFormString(r, "confirmAddress") != "true" {
+				data.AddressMismatch = addressMismatch(ctx, client, data.Form.Address)
+				if data.AddressMismatch {
+					return tmpl(w, data)
+				}
+			}
+
 			attorneyDetailsData := sirius.ChangeAttorneyDetails{
 				FirstNames:  data.Form.FirstNames,
 				LastName:    data.Form.LastName,
@@ -34,6 +34,11 @@ func (m *mockChangeAttorneyDetailsClient) ChangeAttorneyDetails(ctx sirius.Conte
 	return m.Called(ctx, caseUID, attorneyUID, attorneyDetailsData).Error(0)
 }
 
+func (m *mockChangeAttorneyDetailsClient) PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error) {
+	args := m.Called(ctx, postcode)
+	return args.Get(0).([]sirius.PostcodeLookupAddress), args.Error(1)
+}
+
 var testChangeAttorneyDetailsCaseSummary = sirius.CaseSummary{
 	DigitalLpa: sirius.DigitalLpa{
 		UID: "M-DDDD-DDDD-DDDD",
@@ -297,6 +302,9 @@ func TestPostChangeAttorneyDetails(t *testing.T) {
 			client.
 				On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
 				Return([]sirius.RefDataItem{{Handle: "GB", Label: "Great Britain"}}, nil)
+			client.
+				On("PostcodeLookup", mock.Anything, "NR16 2GB").
+				Return([]sirius.PostcodeLookupAddress{{Line1: "9 Mount", Postcode: "NR16 2GB"}}, nil)
 			client.
 				On("ChangeAttorneyDetails", mock.Anything, "M-DDDD-DDDD-DDDD", "302b05c7-896c-4290-904e-2005e4f1e81e", sirius.ChangeAttorneyDetails{
 					FirstNames:  "Samuel",
@@ -399,6 +407,83 @@ func TestPostChangeAttorneyDetailsWhenValidationError(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, client, template)
 }
 
+func TestPostChangeAttorneyDetailsWhenAddressMismatch(t *testing.T) {
+	tests := map[string]struct {
+		confirmAddress string
+		expectedError  error
+	}{
+		"not confirmed": {
+			expectedError: nil,
+		},
+		"confirmed": {
+			confirmAddress: "true",
+			expectedError:  RedirectError("/lpa/M-DDDD-DDDD-DDDD/lpa-details"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockChangeAttorneyDetailsClient{}
+			client.
+				On("CaseSummary", mock.Anything, "M-DDDD-DDDD-DDDD").
+				Return(testChangeAttorneyDetailsCaseSummary, nil)
+			client.
+				On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
+				Return([]sirius.RefDataItem{{Handle: "GB", Label: "Great Britain"}}, nil)
+
+			template := &mockTemplate{}
+
+			if tc.confirmAddress == "" {
+				client.
+					On("PostcodeLookup", mock.Anything, "NR16 2GB").
+					Return([]sirius.PostcodeLookupAddress{{Line1: "1 Mount Pleasant Drive", Postcode: "NR16 2GB"}}, nil)
+				template.
+					On("Func", mock.Anything, mock.MatchedBy(func(data changeAttorneyDetailsData) bool {
+						return data.AddressMismatch && data.Form.Address.Postcode == "NR16 2GB"
+					})).
+					Return(nil)
+			} else {
+				client.
+					On("ChangeAttorneyDetails", mock.Anything, "M-DDDD-DDDD-DDDD", "302b05c7-896c-4290-904e-2005e4f1e81e", sirius.ChangeAttorneyDetails{
+						FirstNames:  "Jack",
+						LastName:    "Black",
+						DateOfBirth: "1990-02-22",
+						Address: sirius.Address{
+							Line1:    "99 Mount Pleasant Drive",
+							Town:     "East Harling",
+							Postcode: "NR16 2GB",
+							Country:  "GB",
+						},
+						Phone:    "077577575757",
+						Email:    "a@example.com",
+						SignedAt: "2024-01-12",
+					}).
+					Return(nil)
+			}
+
+			server := newMockServer("/lpa/{uid}/attorney/{attorneyUID}/change-details", ChangeAttorneyDetails(client, template.Func))
+
+			form := url.Values{
+				"firstNames":       {"Jack"},
+				"lastName":         {"Black"},
+				"address.Line1":    {" 99 Mount Pleasant Drive "},
+				"address.Town":     {"East Harling"},
+				"address.Postcode": {"nr162gb"},
+				"address.Country":  {"GB"},
+				"confirmAddress":   {tc.confirmAddress},
+			}
+
+			r, _ := http.NewRequest(http.MethodPost, "/lpa/M-DDDD-DDDD-DDDD/attorney/302b05c7-896c-4290-904e-2005e4f1e81e/change-details", strings.NewReader(form.Encode()))
+			r.Header.Add("Content-Type", formUrlEncoded)
+			resp, err := server.serve(r)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, http.StatusOK, resp.Code)
+			mock.AssertExpectationsForObjects(t, client, template)
+		})
+	}
+}
+
 func TestDOBToDateString(t *testing.T) {
 	tests := []struct {
 		name     string
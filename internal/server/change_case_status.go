@@ -12,6 +12,7 @@ import (
 )
 
 type ChangeCaseStatusClient interface {
+	AcknowledgeWarningClient
 	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
 	CaseSummary(sirius.Context, string) (sirius.CaseSummary, error)
 	EditDigitalLPAStatus(sirius.Context, string, sirius.CaseStatusData) error
@@ -34,6 +35,7 @@ type changeCaseStatusData struct {
 	OldStatus               shared.CaseStatus
 	NewStatus               shared.CaseStatus
 	StatusChangeReason      string
+	WarningsToAcknowledge   []sirius.Warning
 }
 
 func ChangeCaseStatus(client ChangeCaseStatusClient, tmpl template.Template) Handler {
@@ -84,6 +86,7 @@ func ChangeCaseStatus(client ChangeCaseStatusClient, tmpl template.Template) Han
 			NewStatus:               shared.ParseCaseStatusType(postFormString(r, "status")),
 			StatusChangeReason:      postFormString(r, "statusReason"),
 			CaseStatusChangeReasons: caseStatusChangeReasons,
+			WarningsToAcknowledge:   cs.WarningsToAcknowledge(),
 		}
 
 		data.StatusItems = []statusItem{
@@ -102,13 +105,16 @@ func ChangeCaseStatus(client ChangeCaseStatusClient, tmpl template.Template) Han
 		if r.Method == http.MethodPost {
 			if (data.NewStatus.StringForApi() == "cannot-register" || data.NewStatus.StringForApi() == "cancelled") && data.StatusChangeReason == "" {
 				data.OldStatus = shared.ParseCaseStatusType(data.NewStatus.ReadableString())
-				w.WriteHeader(http.StatusBadRequest)
 				data.Error.Field["changeReason"] = map[string]string{
 					"reason": "Please select a reason",
 				}
 			}
 
-			if !data.Error.Any() {
+			validateWarningsAcknowledged(r, data.WarningsToAcknowledge, data.Error.Field)
+
+			if data.Error.Any() {
+				w.WriteHeader(http.StatusBadRequest)
+			} else {
 				caseStatusData := sirius.CaseStatusData{
 					Status:           data.NewStatus.StringForApi(),
 					CaseChangeReason: data.StatusChangeReason,
@@ -122,6 +128,8 @@ func ChangeCaseStatus(client ChangeCaseStatusClient, tmpl template.Template) Han
 				} else if err != nil {
 					return err
 				} else {
+					acknowledgeWarnings(ctx, client, data.WarningsToAcknowledge, "change-case-status")
+
 					data.Success = true
 					data.OldStatus = shared.ParseCaseStatusType(data.NewStatus.StringForApi())
 
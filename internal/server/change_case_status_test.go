@@ -17,6 +17,11 @@ type mockChangeCaseStatusClient struct {
 	mock.Mock
 }
 
+func (m *mockChangeCaseStatusClient) AcknowledgeWarning(ctx sirius.Context, warningID int, action string) error {
+	args := m.Called(ctx, warningID, action)
+	return args.Error(0)
+}
+
 func (m *mockChangeCaseStatusClient) CaseSummary(ctx sirius.Context, uid string) (sirius.CaseSummary, error) {
 	args := m.Called(ctx, uid)
 	return args.Get(0).(sirius.CaseSummary), args.Error(1)
@@ -246,3 +251,140 @@ func TestPostChangeCaseStatusWithReason(t *testing.T) {
 	assert.Equal(t, RedirectError("/lpa/M-9876-9876-9876"), err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestPostChangeCaseStatusWithWarningsToAcknowledge(t *testing.T) {
+	caseSummary := sirius.CaseSummary{
+		DigitalLpa: sirius.DigitalLpa{
+			UID: "M-9876-9876-9876",
+			SiriusData: sirius.SiriusData{
+				ID:      676,
+				Subtype: "personal-welfare",
+			},
+			LpaStoreData: sirius.LpaStoreData{
+				Status: shared.CaseStatusTypeDraft,
+			},
+		},
+		WarningList: []sirius.Warning{
+			{ID: 12, WarningType: "Safeguarding", Severity: sirius.WarningSeverityHigh},
+			{ID: 13, WarningType: "Welsh Language"},
+		},
+	}
+
+	t.Run("not acknowledged", func(t *testing.T) {
+		client := &mockChangeCaseStatusClient{}
+		client.
+			On("CaseSummary", mock.Anything, "M-9876-9876-9876").
+			Return(caseSummary, nil)
+		client.
+			On("RefDataByCategory", mock.Anything, sirius.CaseStatusChangeReason).
+			Return([]sirius.RefDataItem{}, nil)
+
+		template := &mockTemplate{}
+		template.
+			On("Func", mock.Anything, mock.MatchedBy(func(data changeCaseStatusData) bool {
+				return assert.Equal(t, sirius.FieldErrors{"acknowledgeWarnings": {"reason": "Confirm you have read the warnings on this case"}}, data.Error.Field) &&
+					assert.Equal(t, []sirius.Warning{caseSummary.WarningList[0]}, data.WarningsToAcknowledge)
+			})).
+			Return(nil)
+
+		form := url.Values{"status": {"expired"}}
+
+		r, _ := http.NewRequest(http.MethodPost, "/change-case-status?uid=M-9876-9876-9876", strings.NewReader(form.Encode()))
+		r.Header.Add("Content-Type", formUrlEncoded)
+		w := httptest.NewRecorder()
+
+		err := ChangeCaseStatus(client, template.Func)(w, r)
+		resp := w.Result()
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, client, template)
+		client.AssertNotCalled(t, "EditDigitalLPAStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("acknowledged", func(t *testing.T) {
+		client := &mockChangeCaseStatusClient{}
+		client.
+			On("CaseSummary", mock.Anything, "M-9876-9876-9876").
+			Return(caseSummary, nil)
+		client.
+			On("RefDataByCategory", mock.Anything, sirius.CaseStatusChangeReason).
+			Return([]sirius.RefDataItem{}, nil)
+		client.
+			On("AcknowledgeWarning", mock.Anything, 12, "change-case-status").
+			Return(nil)
+		client.
+			On("EditDigitalLPAStatus", mock.Anything, "M-9876-9876-9876", sirius.CaseStatusData{Status: "expired"}).
+			Return(nil)
+
+		form := url.Values{"status": {"expired"}, "acknowledgeWarnings": {"yes"}}
+
+		r, _ := http.NewRequest(http.MethodPost, "/change-case-status?uid=M-9876-9876-9876", strings.NewReader(form.Encode()))
+		r.Header.Add("Content-Type", formUrlEncoded)
+		w := httptest.NewRecorder()
+
+		err := ChangeCaseStatus(client, nil)(w, r)
+
+		assert.Equal(t, RedirectError("/lpa/M-9876-9876-9876"), err)
+		mock.AssertExpectationsForObjects(t, client)
+	})
+
+	t.Run("acknowledgement fails", func(t *testing.T) {
+		client := &mockChangeCaseStatusClient{}
+		client.
+			On("CaseSummary", mock.Anything, "M-9876-9876-9876").
+			Return(caseSummary, nil)
+		client.
+			On("RefDataByCategory", mock.Anything, sirius.CaseStatusChangeReason).
+			Return([]sirius.RefDataItem{}, nil)
+		client.
+			On("EditDigitalLPAStatus", mock.Anything, "M-9876-9876-9876", sirius.CaseStatusData{Status: "expired"}).
+			Return(nil)
+		client.
+			On("AcknowledgeWarning", mock.Anything, 12, "change-case-status").
+			Return(errExample)
+
+		form := url.Values{"status": {"expired"}, "acknowledgeWarnings": {"yes"}}
+
+		r, _ := http.NewRequest(http.MethodPost, "/change-case-status?uid=M-9876-9876-9876", strings.NewReader(form.Encode()))
+		r.Header.Add("Content-Type", formUrlEncoded)
+		w := httptest.NewRecorder()
+
+		err := ChangeCaseStatus(client, nil)(w, r)
+
+		assert.Equal(t, RedirectError("/lpa/M-9876-9876-9876"), err)
+		mock.AssertExpectationsForObjects(t, client)
+	})
+
+	t.Run("status change fails", func(t *testing.T) {
+		client := &mockChangeCaseStatusClient{}
+		client.
+			On("CaseSummary", mock.Anything, "M-9876-9876-9876").
+			Return(caseSummary, nil)
+		client.
+			On("RefDataByCategory", mock.Anything, sirius.CaseStatusChangeReason).
+			Return([]sirius.RefDataItem{}, nil)
+		client.
+			On("EditDigitalLPAStatus", mock.Anything, "M-9876-9876-9876", sirius.CaseStatusData{Status: "expired"}).
+			Return(sirius.ValidationError{Detail: "Status cannot be changed"})
+
+		template := &mockTemplate{}
+		template.
+			On("Func", mock.Anything, mock.Anything).
+			Return(nil)
+
+		form := url.Values{"status": {"expired"}, "acknowledgeWarnings": {"yes"}}
+
+		r, _ := http.NewRequest(http.MethodPost, "/change-case-status?uid=M-9876-9876-9876", strings.NewReader(form.Encode()))
+		r.Header.Add("Content-Type", formUrlEncoded)
+		w := httptest.NewRecorder()
+
+		err := ChangeCaseStatus(client, template.Func)(w, r)
+		resp := w.Result()
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, client, template)
+		client.AssertNotCalled(t, "AcknowledgeWarning", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
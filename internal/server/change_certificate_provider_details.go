@@ -13,14 +13,16 @@ type ChangeCertificateProviderDetailsClient interface {
 	CaseSummary(sirius.Context, string) (sirius.CaseSummary, error)
 	ChangeCertificateProviderDetails(sirius.Context, string, sirius.ChangeCertificateProviderDetails) error
 	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
+	PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error)
 }
 
 type changeCertificateProviderDetailsData struct {
-	XSRFToken string
-	CaseUid   string
-	Countries []sirius.RefDataItem
-	Error     sirius.ValidationError
-	Form      formCertificateProviderDetails
+	XSRFToken       string
+	CaseUid         string
+	Countries       []sirius.RefDataItem
+	Error           sirius.ValidationError
+	Form            formCertificateProviderDetails
+	AddressMismatch bool
 }
 
 type formCertificateProviderDetails struct {
@@ -90,11 +92,33 @@ func ChangeCertificateProviderDetails(client ChangeCertificateProviderDetailsCli
 		}
 
 		if r.Method == http.MethodPost {
+			storedAddress := data.Form.Address
+
 			err := decoder.Decode(&data.Form, r.PostForm)
 			if err != nil {
 				return err
 			}
 
+			var addressReason string
+			data.Form.Address, addressReason = normaliseAddress(storedAddress, data.Form.Address)
+
+			if addressReason != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = sirius.ValidationError{
+					Field: sirius.FieldErrors{
+						"address/postcode": {"reason": addressReason},
+					},
+				}
+				return tmpl(w, data)
+			}
+
+			if addressChanged(storedAddress, data.Form.Address) && postFormString(r, "confirmAddress") != "true" {
+				data.AddressMismatch = addressMismatch(ctx, client, data.Form.Address)
+				if data.AddressMismatch {
+					return tmpl(w, data)
+				}
+			}
+
 			certificateProviderDetailsData := sirius.ChangeCertificateProviderDetails{
 				FirstNames: data.Form.FirstNames,
 				LastName:   data.Form.LastName,
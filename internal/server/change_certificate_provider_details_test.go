@@ -34,6 +34,11 @@ func (m *mockChangeCertificateProviderDetailsClient) RefDataByCategory(ctx siriu
 	return nil, args.Error(1)
 }
 
+func (m *mockChangeCertificateProviderDetailsClient) PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error) {
+	args := m.Called(ctx, postcode)
+	return args.Get(0).([]sirius.PostcodeLookupAddress), args.Error(1)
+}
+
 var testChangeCertificateProviderCaseSummary = sirius.CaseSummary{
 	DigitalLpa: sirius.DigitalLpa{
 		LpaStoreData: sirius.LpaStoreData{
@@ -276,6 +281,9 @@ func TestPostChangeCertificateProviderDetailsRedirectReturned(t *testing.T) {
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
 		Return([]sirius.RefDataItem{}, nil)
+	client.
+		On("PostcodeLookup", mock.Anything, "HR6 9YN").
+		Return([]sirius.PostcodeLookupAddress{{Line1: "4 Edyth Place", Postcode: "HR6 9YN"}}, nil)
 
 	template := &mockTemplate{}
 
@@ -15,6 +15,7 @@ type ChangeDonorDetailsClient interface {
 	ChangeDonorDetails(sirius.Context, string, sirius.ChangeDonorDetails) error
 	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
 	ProgressIndicatorsForDigitalLpa(siriusCtx sirius.Context, uid string) ([]sirius.ProgressIndicator, error)
+	PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error)
 }
 
 type changeDonorDetailsData struct {
@@ -27,6 +28,7 @@ type changeDonorDetailsData struct {
 	DonorIdentityCheckComplete bool
 	DonorDobString             string
 	SignedByWitnessTwoLabel    string
+	AddressMismatch            bool
 }
 
 type formDonorDetails struct {
@@ -217,11 +219,39 @@ func ChangeDonorDetails(client ChangeDonorDetailsClient, tmpl template.Template)
 		}
 
 		if r.Method == http.MethodPost {
+			storedAddress := data.Form.Address
+			storedWitnessAddress := data.Form.IndependentWitnessAddress
+
 			err := decoder.Decode(&data.Form, r.PostForm)
 			if err != nil {
 				return err
 			}
 
+			var addressReason, witnessAddressReason string
+			data.Form.Address, addressReason = normaliseAddress(storedAddress, data.Form.Address)
+			data.Form.IndependentWitnessAddress, witnessAddressReason = normaliseAddress(storedWitnessAddress, data.Form.IndependentWitnessAddress)
+
+			if addressReason != "" || witnessAddressReason != "" {
+				fieldErrors := sirius.FieldErrors{}
+				if addressReason != "" {
+					fieldErrors["address/postcode"] = map[string]string{"reason": addressReason}
+				}
+				if witnessAddressReason != "" {
+					fieldErrors["independentWitnessAddress/postcode"] = map[string]string{"reason": witnessAddressReason}
+				}
+
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = sirius.ValidationError{Field: fieldErrors}
+				return tmpl(w, data)
+			}
+
+			if addressChanged(storedAddress, data.Form.Address) && postFormString(r, "confirmAddress") != "true" {
+				data.AddressMismatch = addressMismatch(ctx, client, data.Form.Address)
+				if data.AddressMismatch {
+					return tmpl(w, data)
+				}
+			}
+
 			donorDetailsData := sirius.ChangeDonorDetails{
 				FirstNames:        data.Form.FirstNames,
 				LastName:          data.Form.LastName,
@@ -39,6 +39,11 @@ func (m *mockChangeDonorDetailsClient) RefDataByCategory(ctx sirius.Context, cat
 	return nil, args.Error(1)
 }
 
+func (m *mockChangeDonorDetailsClient) PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error) {
+	args := m.Called(ctx, postcode)
+	return args.Get(0).([]sirius.PostcodeLookupAddress), args.Error(1)
+}
+
 var testCaseSummary = sirius.CaseSummary{
 	DigitalLpa: sirius.DigitalLpa{
 		UID: "M-AAAA-1111-BBBB",
@@ -311,6 +316,9 @@ func TestPostChangeDonorDetails(t *testing.T) {
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
 		Return([]sirius.RefDataItem{{Handle: "GB", Label: "Great Britain"}}, nil)
+	client.
+		On("PostcodeLookup", mock.Anything, "NR16 2GB").
+		Return([]sirius.PostcodeLookupAddress{{Line1: "9 Mount", Postcode: "NR16 2GB"}}, nil)
 	client.
 		On("ChangeDonorDetails", mock.Anything, "M-AAAA-1111-BBBB", sirius.ChangeDonorDetails{
 			FirstNames:        "Samuel",
@@ -401,6 +409,9 @@ func TestPostChangeDonorDetailsWhenAPIFails(t *testing.T) {
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
 		Return([]sirius.RefDataItem{{Handle: "GB", Label: "Great Britain"}}, nil)
+	client.
+		On("PostcodeLookup", mock.Anything, "NR16 2GB").
+		Return([]sirius.PostcodeLookupAddress{{Line1: "9 Mount", Postcode: "NR16 2GB"}}, nil)
 	client.
 		On("ChangeDonorDetails", mock.Anything, "M-AAAA-1111-BBBB", sirius.ChangeDonorDetails{
 			FirstNames:        "Samuel",
@@ -488,6 +499,9 @@ func TestPostChangeDonorDetailsWhenValidationError(t *testing.T) {
 	client.
 		On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
 		Return([]sirius.RefDataItem{{Handle: "GB", Label: "Great Britain"}}, nil)
+	client.
+		On("PostcodeLookup", mock.Anything, "NR16 2GB").
+		Return([]sirius.PostcodeLookupAddress{{Line1: "9 Mount", Postcode: "NR16 2GB"}}, nil)
 	client.
 		On("ChangeDonorDetails", mock.Anything, "M-AAAA-1111-BBBB", sirius.ChangeDonorDetails{
 			LastName:    "Smith",
@@ -536,6 +550,97 @@ func TestPostChangeDonorDetailsWhenValidationError(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, client, template)
 }
 
+func TestPostChangeDonorDetailsWhenPostcodeInvalid(t *testing.T) {
+	client := &mockChangeDonorDetailsClient{}
+	client.
+		On("CaseSummary", mock.Anything, "M-AAAA-1111-BBBB").
+		Return(testCaseSummary, nil)
+	client.
+		On("ProgressIndicatorsForDigitalLpa", mock.Anything, "M-AAAA-1111-BBBB").
+		Return([]sirius.ProgressIndicator{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
+		Return([]sirius.RefDataItem{{Handle: "GB", Label: "Great Britain"}}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything,
+			mock.MatchedBy(func(data changeDonorDetailsData) bool {
+				return data.Error.Field["address/postcode"]["reason"] == "Enter a real postcode" &&
+					data.Error.Field["independentWitnessAddress/postcode"] == nil &&
+					data.Form.Address.Postcode == "NR16 2GBX"
+			}),
+		).
+		Return(nil)
+
+	form := url.Values{
+		"firstNames":        {"Zackary"},
+		"lastName":          {"Lemmonds"},
+		"address.Line1":     {"9 Mount Pleasant Drive"},
+		"address.Town":      {"East Harling"},
+		"address.Postcode":  {"nr16 2gbx"},
+		"address.Country":   {"GB"},
+		"lpaSignedOn.day":   {"11"},
+		"lpaSignedOn.month": {"2"},
+		"lpaSignedOn.year":  {"2024"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/change-donor-details/?uid=M-AAAA-1111-BBBB", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := ChangeDonorDetails(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostChangeDonorDetailsWhenStoredPostcodeInvalid(t *testing.T) {
+	caseSummary := testCaseSummary
+	caseSummary.DigitalLpa.LpaStoreData.Donor.Address.Postcode = "NR16"
+
+	client := &mockChangeDonorDetailsClient{}
+	client.
+		On("CaseSummary", mock.Anything, "M-AAAA-1111-BBBB").
+		Return(caseSummary, nil)
+	client.
+		On("ProgressIndicatorsForDigitalLpa", mock.Anything, "M-AAAA-1111-BBBB").
+		Return([]sirius.ProgressIndicator{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
+		Return([]sirius.RefDataItem{{Handle: "GB", Label: "Great Britain"}}, nil)
+	client.
+		On("ChangeDonorDetails", mock.Anything, "M-AAAA-1111-BBBB", mock.MatchedBy(func(details sirius.ChangeDonorDetails) bool {
+			return details.FirstNames == "Zachary" && details.Address.Postcode == "NR16"
+		})).
+		Return(nil)
+
+	template := &mockTemplate{}
+
+	form := url.Values{
+		"firstNames":        {"Zachary"},
+		"lastName":          {"Lemmonds"},
+		"address.Line1":     {"9 Mount Pleasant Drive"},
+		"address.Town":      {"East Harling"},
+		"address.Postcode":  {"NR16"},
+		"address.Country":   {"UK"},
+		"lpaSignedOn.day":   {"11"},
+		"lpaSignedOn.month": {"2"},
+		"lpaSignedOn.year":  {"2024"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/change-donor-details/?uid=M-AAAA-1111-BBBB", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := ChangeDonorDetails(client, template.Func)(w, r)
+
+	assert.Equal(t, RedirectError("/lpa/M-AAAA-1111-BBBB/lpa-details"), err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
 func TestParseDateTime(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -11,7 +11,7 @@ import (
 
 type ChangeStatusClient interface {
 	Case(sirius.Context, int) (sirius.Case, error)
-	CreateNote(sirius.Context, int, sirius.EntityType, string, string, string, *sirius.NoteFile) error
+	CreateNote(sirius.Context, int, sirius.EntityType, string, string, string, []sirius.NoteFile) error
 	EditCase(sirius.Context, int, sirius.CaseType, sirius.Case) error
 	AvailableStatuses(sirius.Context, int, sirius.CaseType) ([]string, error)
 }
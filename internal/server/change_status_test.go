@@ -17,8 +17,8 @@ type mockChangeStatusClient struct {
 	mock.Mock
 }
 
-func (m *mockChangeStatusClient) CreateNote(ctx sirius.Context, entityID int, entityType sirius.EntityType, noteType, name, description string, file *sirius.NoteFile) error {
-	return m.Called(ctx, entityID, entityType, noteType, name, description, file).Error(0)
+func (m *mockChangeStatusClient) CreateNote(ctx sirius.Context, entityID int, entityType sirius.EntityType, noteType, name, description string, files []sirius.NoteFile) error {
+	return m.Called(ctx, entityID, entityType, noteType, name, description, files).Error(0)
 }
 
 func (m *mockChangeStatusClient) Case(ctx sirius.Context, id int) (sirius.Case, error) {
@@ -229,7 +229,7 @@ func TestPostChangeStatusWithNotes(t *testing.T) {
 				Return(nil)
 
 			client.
-				On("CreateNote", mock.Anything, 123, noteEntityType, "Status change - Notes", "Status changed to Withdrawn", "Case note details", (*sirius.NoteFile)(nil)).
+				On("CreateNote", mock.Anything, 123, noteEntityType, "Status change - Notes", "Status changed to Withdrawn", "Case note details", []sirius.NoteFile(nil)).
 				Return(nil)
 
 			client.
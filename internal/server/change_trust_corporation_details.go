@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/companies"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"golang.org/x/sync/errgroup"
 )
@@ -24,6 +25,7 @@ type changeTrustCorporationDetailsData struct {
 	Status          string
 	AppointmentType string
 	Form            formTrustCorporationDetails
+	CompanyCheck    companyCheck
 }
 
 type formTrustCorporationDetails struct {
@@ -34,7 +36,7 @@ type formTrustCorporationDetails struct {
 	CompanyNumber string         `form:"companyNumber"`
 }
 
-func ChangeTrustCorporationDetails(client ChangeTrustCorporationDetailsClient, tmpl template.Template) Handler {
+func ChangeTrustCorporationDetails(client ChangeTrustCorporationDetailsClient, registry companies.CompanyRegistry, tmpl template.Template) Handler {
 
 	return func(w http.ResponseWriter, r *http.Request) error {
 		caseUID := r.PathValue("uid")
@@ -99,6 +101,7 @@ func ChangeTrustCorporationDetails(client ChangeTrustCorporationDetailsClient, t
 				PhoneNumber:   trustCorporation.Mobile,
 				CompanyNumber: trustCorporation.CompanyNumber,
 			},
+			CompanyCheck: companyCheck{LookupEnabled: registry != nil},
 		}
 
 		if r.Method == http.MethodPost {
@@ -107,6 +110,49 @@ func ChangeTrustCorporationDetails(client ChangeTrustCorporationDetailsClient, t
 				return err
 			}
 
+			// existing numbers are only checked when changed, so that other
+			// details can still be updated on older records
+			companyNumber, reason := data.Form.CompanyNumber, ""
+			if companyNumber != trustCorporation.CompanyNumber {
+				companyNumber, reason = validateCompanyNumber(companyNumber)
+			}
+
+			if reason != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = sirius.ValidationError{Field: sirius.FieldErrors{
+					"CompanyNumber": {"reason": reason},
+				}}
+
+				return tmpl(w, data)
+			}
+
+			data.Form.CompanyNumber = companyNumber
+			data.CompanyCheck = checkCompany(ctx, registry, companyNumber, data.Form.Name)
+
+			if postFormString(r, "lookupCompany") != "" {
+				if companyNumber == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					data.Error = sirius.ValidationError{Field: sirius.FieldErrors{
+						"CompanyNumber": {"reason": "Enter a company registration number to look up"},
+					}}
+				} else if company := data.CompanyCheck.Company; company != nil {
+					// only fill in blanks, so nothing already entered is lost
+					if data.Form.Name == "" {
+						data.Form.Name = company.Name
+					}
+
+					if data.Form.Address.Line1 == "" {
+						data.Form.Address = company.Address
+					}
+				}
+
+				return tmpl(w, data)
+			}
+
+			if data.CompanyCheck.NameMismatch && postFormString(r, "confirmCompanyName") != "true" {
+				return tmpl(w, data)
+			}
+
 			trustCorpData := sirius.ChangeTrustCorporationDetails{
 				Name:          data.Form.Name,
 				Address:       data.Form.Address,
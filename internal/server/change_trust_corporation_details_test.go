@@ -198,7 +198,7 @@ func TestGetChangeTrustCorpDetails(t *testing.T) {
 					}).
 				Return(tc.errorReturned)
 
-			server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, template.Func))
+			server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, nil, template.Func))
 
 			r, _ := http.NewRequest(http.MethodGet, "/lpa/"+caseUID+"/trust-corporation/"+tc.trustCorpUID+"/change-details", nil)
 			_, err := server.serve(r)
@@ -240,7 +240,7 @@ func TestGetChangeTrustCorporationDetailsWhenRefDataByCategoryErrors(t *testing.
 }
 
 func assertChangeTrustCorporationDetailsErrors(t *testing.T, client *mockChangeTrustCorporationDetailsClient) {
-	server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, nil))
+	server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, nil, nil))
 
 	r, _ := http.NewRequest(http.MethodGet, "/lpa/"+caseUID+"/trust-corporation/123a01b1-456d-5391-813d-2010d3e2d72d/change-details", nil)
 	_, err := server.serve(r)
@@ -294,7 +294,7 @@ func TestPostChangeTrustCorporationDetails(t *testing.T) {
 
 			template := &mockTemplate{}
 
-			server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, template.Func))
+			server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, nil, template.Func))
 
 			form := url.Values{
 				"name":             {"Trust Ltd."},
@@ -353,7 +353,7 @@ func TestPostChangeTrustCorporationDetailsWhenValidationError(t *testing.T) {
 		).
 		Return(nil)
 
-	server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, template.Func))
+	server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, nil, template.Func))
 
 	form := url.Values{
 		"name": {""},
@@ -366,3 +366,81 @@ func TestPostChangeTrustCorporationDetailsWhenValidationError(t *testing.T) {
 	assert.Nil(t, err)
 	mock.AssertExpectationsForObjects(t, client, template)
 }
+
+func TestPostChangeTrustCorporationDetailsWhenCompanyNumberInvalid(t *testing.T) {
+	client := &mockChangeTrustCorporationDetailsClient{}
+	client.
+		On("CaseSummary", mock.Anything, caseUID).
+		Return(testChangeTrustCorpDetailsCaseSummary, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
+		Return([]sirius.RefDataItem{{Handle: "GB", Label: "Great Britain"}}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything,
+			mock.MatchedBy(func(data changeTrustCorporationDetailsData) bool {
+				return data.Error.Field["CompanyNumber"]["reason"] == "Company registration number must be 8 characters"
+			}),
+		).
+		Return(nil)
+
+	server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, nil, template.Func))
+
+	form := url.Values{
+		"name":          {"Trust Me Once Ltd."},
+		"companyNumber": {"12345678910"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/lpa/M-TCTC-TCTC-TCTC/trust-corporation/302b05c7-896c-4290-904e-2005e4f1e81e/change-details", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	resp, err := server.serve(r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostChangeTrustCorporationDetailsLookupCompany(t *testing.T) {
+	client := &mockChangeTrustCorporationDetailsClient{}
+	client.
+		On("CaseSummary", mock.Anything, caseUID).
+		Return(testChangeTrustCorpDetailsCaseSummary, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
+		Return([]sirius.RefDataItem{{Handle: "GB", Label: "Great Britain"}}, nil)
+
+	registry := &mockCompanyRegistry{}
+	registry.
+		On("Company", mock.Anything, "SC123456").
+		Return(testRegisteredCompany, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything,
+			mock.MatchedBy(func(data changeTrustCorporationDetailsData) bool {
+				return data.Form.Name == "Highland Trustees PLC" &&
+					data.Form.Address == testRegisteredCompany.Address &&
+					data.Form.CompanyNumber == "SC123456" &&
+					!data.CompanyCheck.NameMismatch
+			}),
+		).
+		Return(nil)
+
+	server := newMockServer("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", ChangeTrustCorporationDetails(client, registry, template.Func))
+
+	form := url.Values{
+		"name":          {""},
+		"address.Line1": {""},
+		"companyNumber": {"SC123456"},
+		"lookupCompany": {"true"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/lpa/M-TCTC-TCTC-TCTC/trust-corporation/302b05c7-896c-4290-904e-2005e4f1e81e/change-details", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	resp, err := server.serve(r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mock.AssertExpectationsForObjects(t, client, registry, template)
+}
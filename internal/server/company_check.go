@@ -0,0 +1,55 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/companies"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+// companyCheck is the result of looking up a trust corporation's company number
+// in the company registry, when one is configured
+type companyCheck struct {
+	LookupEnabled bool
+	Company       *companies.Company
+	NotFound      bool
+	NameMismatch  bool
+}
+
+// validateCompanyNumber returns number in its canonical form, or a reason it
+// is not a valid company number. An empty number is allowed.
+func validateCompanyNumber(number string) (string, string) {
+	if number == "" {
+		return "", ""
+	}
+
+	normalised, err := companies.NormaliseNumber(number)
+	switch err {
+	case nil:
+		return normalised, ""
+	case companies.ErrNumberLength:
+		return number, "Company registration number must be 8 characters"
+	default:
+		return number, "Company registration number must be 8 digits, or start with a prefix such as SC, NI or OC followed by 6 digits"
+	}
+}
+
+// checkCompany looks up number in registry and compares the registered name
+// with the name entered. Lookup failures are not shown as errors as the
+// registry is only used to help fill in the form.
+func checkCompany(ctx sirius.Context, registry companies.CompanyRegistry, number, name string) companyCheck {
+	check := companyCheck{LookupEnabled: registry != nil}
+	if registry == nil || number == "" {
+		return check
+	}
+
+	company, err := registry.Company(ctx.Context, number)
+	if errors.Is(err, companies.ErrNotFound) {
+		check.NotFound = true
+	} else if err == nil {
+		check.Company = &company
+		check.NameMismatch = name != "" && !companies.NamesMatch(name, company.Name)
+	}
+
+	return check
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/companies"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockCompanyRegistry struct {
+	mock.Mock
+}
+
+func (m *mockCompanyRegistry) Company(ctx context.Context, number string) (companies.Company, error) {
+	args := m.Called(ctx, number)
+	return args.Get(0).(companies.Company), args.Error(1)
+}
+
+var testRegisteredCompany = companies.Company{
+	Number: "SC123456",
+	Name:   "Highland Trustees PLC",
+	Address: sirius.Address{
+		Line1:    "2 Castle Wynd",
+		Town:     "Edinburgh",
+		Postcode: "EH1 2NG",
+		Country:  "GB",
+	},
+}
+
+func TestValidateCompanyNumber(t *testing.T) {
+	number, reason := validateCompanyNumber("sc123456")
+	assert.Equal(t, "SC123456", number)
+	assert.Equal(t, "", reason)
+
+	number, reason = validateCompanyNumber("")
+	assert.Equal(t, "", number)
+	assert.Equal(t, "", reason)
+
+	_, reason = validateCompanyNumber("123456789")
+	assert.Equal(t, "Company registration number must be 8 characters", reason)
+
+	_, reason = validateCompanyNumber("XX123456")
+	assert.Equal(t, "Company registration number must be 8 digits, or start with a prefix such as SC, NI or OC followed by 6 digits", reason)
+}
+
+func TestCheckCompany(t *testing.T) {
+	ctx := sirius.Context{Context: context.Background()}
+
+	registry := &mockCompanyRegistry{}
+	registry.On("Company", mock.Anything, "SC123456").Return(testRegisteredCompany, nil)
+	registry.On("Company", mock.Anything, "SC999999").Return(companies.Company{}, companies.ErrNotFound)
+	registry.On("Company", mock.Anything, "SC000000").Return(companies.Company{}, errors.New("registry unavailable"))
+
+	assert.Equal(t, companyCheck{}, checkCompany(ctx, nil, "SC123456", "Highland Trustees"))
+	assert.Equal(t, companyCheck{LookupEnabled: true}, checkCompany(ctx, registry, "", "Highland Trustees"))
+	assert.Equal(t, companyCheck{LookupEnabled: true, Company: &testRegisteredCompany}, checkCompany(ctx, registry, "SC123456", "highland trustees public limited company"))
+	assert.Equal(t, companyCheck{LookupEnabled: true, Company: &testRegisteredCompany, NameMismatch: true}, checkCompany(ctx, registry, "SC123456", "Highland Trustees"))
+	assert.Equal(t, companyCheck{LookupEnabled: true, NotFound: true}, checkCompany(ctx, registry, "SC999999", "Highland Trustees"))
+	assert.Equal(t, companyCheck{LookupEnabled: true}, checkCompany(ctx, registry, "SC000000", "Highland Trustees"))
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+type CompensationReportClient interface {
+	CompensatedComplaints(ctx sirius.Context, from, to sirius.DateString) ([]sirius.Complaint, error)
+	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
+}
+
+type compensationReportData struct {
+	Error   sirius.ValidationError
+	From    sirius.DateString
+	To      sirius.DateString
+	Periods []sirius.CompensationPeriod
+	Total   sirius.CompensationSummary
+	Types   []sirius.RefDataItem
+}
+
+func CompensationReport(client CompensationReportClient, tmpl template.Template) Handler {
+	return compensationReportWithNow(client, tmpl, time.Now)
+}
+
+func compensationReportWithNow(client CompensationReportClient, tmpl template.Template, now func() time.Time) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ctx := getContext(r)
+		today := now()
+
+		data := compensationReportData{
+			From: sirius.DateString(r.FormValue("from")),
+			To:   sirius.DateString(r.FormValue("to")),
+		}
+
+		if data.From == "" && data.To == "" {
+			data.From = sirius.DateString(time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly))
+			data.To = sirius.DateString(today.Format(time.DateOnly))
+		}
+
+		if fieldErrors := validateCompensationReportDates(data.From, data.To); len(fieldErrors) > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			data.Error = sirius.ValidationError{Field: fieldErrors}
+
+			return tmpl(w, data)
+		}
+
+		var complaints []sirius.Complaint
+
+		group, groupCtx := errgroup.WithContext(ctx.Context)
+
+		group.Go(func() error {
+			var err error
+			complaints, err = client.CompensatedComplaints(ctx.With(groupCtx), data.From, data.To)
+			return err
+		})
+
+		group.Go(func() error {
+			var err error
+			data.Types, err = client.RefDataByCategory(ctx.With(groupCtx), sirius.CompensationType)
+			return err
+		})
+
+		if err := group.Wait(); err != nil {
+			return err
+		}
+
+		data.Periods = sirius.NewCompensationReport(complaints)
+		data.Total = sirius.NewCompensationSummary(complaints)
+
+		if r.FormValue("format") == "csv" {
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="compensation-%s-to-%s.csv"`, data.From, data.To))
+
+			return writeCompensationReport(w, data)
+		}
+
+		return tmpl(w, data)
+	}
+}
+
+func validateCompensationReportDates(from, to sirius.DateString) sirius.FieldErrors {
+	fieldErrors := sirius.FieldErrors{}
+
+	fromDate, fromErr := from.Time()
+	if fromErr != nil {
+		fieldErrors["from"] = map[string]string{"reason": "Enter a valid start date"}
+	}
+
+	toDate, toErr := to.Time()
+	if toErr != nil {
+		fieldErrors["to"] = map[string]string{"reason": "Enter a valid end date"}
+	}
+
+	if fromErr == nil && toErr == nil && toDate.Before(fromDate) {
+		fieldErrors["to"] = map[string]string{"reason": "End date must be the same as or after the start date"}
+	}
+
+	return fieldErrors
+}
+
+// writeCompensationReport writes a row for each type of compensation in each
+// month, followed by the totals for the whole range
+func writeCompensationReport(w http.ResponseWriter, data compensationReportData) error {
+	out := csv.NewWriter(w)
+
+	if err := out.Write([]string{"Month", "Compensation type", "Complaints", "Amount"}); err != nil {
+		return err
+	}
+
+	writeTotals := func(label string, summary sirius.CompensationSummary) error {
+		for _, total := range summary.Types {
+			if err := out.Write([]string{label, translateRefData(data.Types, total.Type), strconv.Itoa(total.Count), total.Total.Decimal()}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for _, period := range data.Periods {
+		label := period.Period
+		if month, err := time.Parse("2006-01", period.Period); err == nil {
+			label = month.Format("January 2006")
+		}
+
+		if err := writeTotals(label, period.Summary); err != nil {
+			return err
+		}
+	}
+
+	if err := writeTotals("All", data.Total); err != nil {
+		return err
+	}
+
+	if err := out.Write([]string{"All", "All", strconv.Itoa(data.Total.Count), data.Total.Total.Decimal()}); err != nil {
+		return err
+	}
+
+	out.Flush()
+	return out.Error()
+}
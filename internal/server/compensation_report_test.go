@@ -0,0 +1,182 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockCompensationReportClient struct {
+	mock.Mock
+}
+
+func (m *mockCompensationReportClient) CompensatedComplaints(ctx sirius.Context, from, to sirius.DateString) ([]sirius.Complaint, error) {
+	args := m.Called(ctx, from, to)
+	return args.Get(0).([]sirius.Complaint), args.Error(1)
+}
+
+func (m *mockCompensationReportClient) RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error) {
+	args := m.Called(ctx, category)
+	return args.Get(0).([]sirius.RefDataItem), args.Error(1)
+}
+
+var (
+	compensationReportToday = time.Date(2024, time.March, 14, 9, 0, 0, 0, time.UTC)
+
+	compensatedComplaints = []sirius.Complaint{
+		{ID: 1, CompensationType: "COMPENSATORY", CompensationAmount: "150.00", ResolutionDate: "2024-02-10"},
+		{ID: 2, CompensationType: "COMPENSATORY", CompensationAmount: "50.00", ResolutionDate: "2024-01-31"},
+		{ID: 3, CompensationType: "EX_GRATIA", CompensationAmount: "20.00", ResolutionDate: "2024-02-01"},
+	}
+)
+
+func TestGetCompensationReport(t *testing.T) {
+	client := &mockCompensationReportClient{}
+	client.
+		On("CompensatedComplaints", mock.Anything, sirius.DateString("2024-01-01"), sirius.DateString("2024-02-29")).
+		Return(compensatedComplaints, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, compensationReportData{
+			From:    "2024-01-01",
+			To:      "2024-02-29",
+			Periods: sirius.NewCompensationReport(compensatedComplaints),
+			Total:   sirius.NewCompensationSummary(compensatedComplaints),
+			Types:   demoCompensationTypes,
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/compensation-report?from=2024-01-01&to=2024-02-29", nil)
+	w := httptest.NewRecorder()
+
+	err := compensationReportWithNow(client, template.Func, func() time.Time { return compensationReportToday })(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetCompensationReportDefaultsToThisMonth(t *testing.T) {
+	client := &mockCompensationReportClient{}
+	client.
+		On("CompensatedComplaints", mock.Anything, sirius.DateString("2024-03-01"), sirius.DateString("2024-03-14")).
+		Return([]sirius.Complaint{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, compensationReportData{
+			From:  "2024-03-01",
+			To:    "2024-03-14",
+			Types: demoCompensationTypes,
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/compensation-report", nil)
+	w := httptest.NewRecorder()
+
+	err := compensationReportWithNow(client, template.Func, func() time.Time { return compensationReportToday })(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetCompensationReportAsCSV(t *testing.T) {
+	client := &mockCompensationReportClient{}
+	client.
+		On("CompensatedComplaints", mock.Anything, sirius.DateString("2024-01-01"), sirius.DateString("2024-02-29")).
+		Return(compensatedComplaints, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/compensation-report?from=2024-01-01&to=2024-02-29&format=csv", nil)
+	w := httptest.NewRecorder()
+
+	err := CompensationReport(client, nil)(w, r)
+	resp := w.Result()
+	body, _ := io.ReadAll(resp.Body)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="compensation-2024-01-01-to-2024-02-29.csv"`, resp.Header.Get("Content-Disposition"))
+	assert.Equal(t, "Month,Compensation type,Complaints,Amount\n"+
+		"January 2024,Compensatory,1,50.00\n"+
+		"February 2024,Compensatory,1,150.00\n"+
+		"February 2024,EX_GRATIA,1,20.00\n"+
+		"All,Compensatory,2,200.00\n"+
+		"All,EX_GRATIA,1,20.00\n"+
+		"All,All,3,220.00\n", string(body))
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestGetCompensationReportWhenDatesInvalid(t *testing.T) {
+	testCases := map[string]struct {
+		query    string
+		expected sirius.FieldErrors
+	}{
+		"missing start": {
+			query:    "to=2024-02-29",
+			expected: sirius.FieldErrors{"from": {"reason": "Enter a valid start date"}},
+		},
+		"invalid end": {
+			query:    "from=2024-01-01&to=2024-02-30",
+			expected: sirius.FieldErrors{"to": {"reason": "Enter a valid end date"}},
+		},
+		"end before start": {
+			query:    "from=2024-02-01&to=2024-01-31",
+			expected: sirius.FieldErrors{"to": {"reason": "End date must be the same as or after the start date"}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			template := &mockTemplate{}
+			template.
+				On("Func", mock.Anything, mock.MatchedBy(func(data compensationReportData) bool {
+					return assert.Equal(t, tc.expected, data.Error.Field)
+				})).
+				Return(nil)
+
+			r, _ := http.NewRequest(http.MethodGet, "/compensation-report?"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			err := CompensationReport(nil, template.Func)(w, r)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			mock.AssertExpectationsForObjects(t, template)
+		})
+	}
+}
+
+func TestGetCompensationReportWhenComplaintsError(t *testing.T) {
+	client := &mockCompensationReportClient{}
+	client.
+		On("CompensatedComplaints", mock.Anything, sirius.DateString("2024-01-01"), sirius.DateString("2024-02-29")).
+		Return([]sirius.Complaint{}, errExample)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/compensation-report?from=2024-01-01&to=2024-02-29", nil)
+	w := httptest.NewRecorder()
+
+	err := CompensationReport(client, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+}
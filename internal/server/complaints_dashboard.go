@@ -0,0 +1,133 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+type ComplaintsDashboardClient interface {
+	OpenComplaints(ctx sirius.Context) ([]sirius.Complaint, error)
+	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
+	BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error)
+}
+
+// complaintsDashboardRow is an open complaint, with the case it was made on
+type complaintsDashboardRow struct {
+	Complaint sirius.Complaint
+	Case      sirius.Case
+	SLA       sirius.ComplaintSLA
+}
+
+type complaintsDashboardData struct {
+	Rows       []complaintsDashboardRow
+	Overdue    int
+	Categories []sirius.RefDataItem
+	Origins    []sirius.RefDataItem
+	Officers   []string
+
+	Category string
+	Origin   string
+	Officer  string
+
+	WithoutBankHolidays bool
+}
+
+func ComplaintsDashboard(client ComplaintsDashboardClient, tmpl template.Template) Handler {
+	return complaintsDashboardWithNow(client, tmpl, time.Now)
+}
+
+func complaintsDashboardWithNow(client ComplaintsDashboardClient, tmpl template.Template, now func() time.Time) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ctx := getContext(r)
+
+		data := complaintsDashboardData{
+			Category: r.FormValue("category"),
+			Origin:   r.FormValue("origin"),
+			Officer:  r.FormValue("officer"),
+		}
+
+		var complaints []sirius.Complaint
+
+		group, groupCtx := errgroup.WithContext(ctx.Context)
+
+		group.Go(func() error {
+			var err error
+			complaints, err = client.OpenComplaints(ctx.With(groupCtx))
+			return err
+		})
+
+		group.Go(func() error {
+			var err error
+			data.Categories, err = client.RefDataByCategory(ctx.With(groupCtx), sirius.ComplaintCategory)
+			return err
+		})
+
+		group.Go(func() error {
+			var err error
+			data.Origins, err = client.RefDataByCategory(ctx.With(groupCtx), sirius.ComplaintOrigin)
+			return err
+		})
+
+		if err := group.Wait(); err != nil {
+			return err
+		}
+
+		var bankHolidays sirius.BankHolidays
+		if len(complaints) > 0 {
+			bankHolidays, data.WithoutBankHolidays = bankHolidaysOrWarn(ctx, client)
+		}
+
+		today := now()
+
+		for _, complaint := range complaints {
+			if complaint.ResolutionDate != "" {
+				continue
+			}
+
+			if complaint.InvestigatingOfficer != "" && !slices.Contains(data.Officers, complaint.InvestigatingOfficer) {
+				data.Officers = append(data.Officers, complaint.InvestigatingOfficer)
+			}
+
+			if (data.Category != "" && complaint.Category != data.Category) ||
+				(data.Origin != "" && complaint.Origin != data.Origin) ||
+				(data.Officer != "" && complaint.InvestigatingOfficer != data.Officer) {
+				continue
+			}
+
+			row := complaintsDashboardRow{
+				Complaint: complaint,
+				SLA:       sirius.NewComplaintSLA(complaint, today, bankHolidays),
+			}
+
+			if len(complaint.CaseItems) > 0 {
+				row.Case = complaint.CaseItems[0]
+			}
+
+			if row.SLA.IsOverdue() {
+				data.Overdue++
+			}
+
+			data.Rows = append(data.Rows, row)
+		}
+
+		sort.Strings(data.Officers)
+
+		// most urgent first, with complaints that have no target at the end
+		sort.SliceStable(data.Rows, func(i, j int) bool {
+			a, b := data.Rows[i].SLA.DueDate, data.Rows[j].SLA.DueDate
+			if a == "" || b == "" {
+				return b == "" && a != ""
+			}
+			return a < b
+		})
+
+		return tmpl(w, data)
+	}
+}
@@ -0,0 +1,248 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockComplaintsDashboardClient struct {
+	mock.Mock
+}
+
+func (m *mockComplaintsDashboardClient) OpenComplaints(ctx sirius.Context) ([]sirius.Complaint, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]sirius.Complaint), args.Error(1)
+}
+
+func (m *mockComplaintsDashboardClient) RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error) {
+	args := m.Called(ctx, category)
+	return args.Get(0).([]sirius.RefDataItem), args.Error(1)
+}
+
+func (m *mockComplaintsDashboardClient) BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(sirius.BankHolidays), args.Error(1)
+}
+
+func TestGetComplaintsDashboard(t *testing.T) {
+	overdue := sirius.Complaint{
+		ID:                   1,
+		Category:             "02",
+		Origin:               "PHONE",
+		Severity:             shared.ComplaintSeverityMinor,
+		ReceivedDate:         "2024-05-01",
+		InvestigatingOfficer: "Zed Officer",
+		CaseItems: []sirius.Case{
+			{ID: 11, UID: "7000-1111-1111", Donor: &sirius.Person{ID: 21}},
+		},
+	}
+	due := sirius.Complaint{
+		ID:                   2,
+		Category:             "01",
+		Origin:               "LETTER",
+		Severity:             shared.ComplaintSeveritySecurityBreach,
+		ReceivedDate:         "2024-05-17",
+		InvestigatingOfficer: "Amy Officer",
+	}
+	unmeasured := sirius.Complaint{
+		ID:           3,
+		Category:     "02",
+		Origin:       "PHONE",
+		Severity:     shared.ComplaintSeverityNotRecognised,
+		ReceivedDate: "2024-05-10",
+	}
+	resolved := sirius.Complaint{
+		ID:                   4,
+		Category:             "02",
+		Severity:             shared.ComplaintSeverityMinor,
+		ReceivedDate:         "2024-05-01",
+		ResolutionDate:       "2024-05-03",
+		InvestigatingOfficer: "Resolved Officer",
+	}
+	categories := []sirius.RefDataItem{{Handle: "01", Label: "Correspondence"}, {Handle: "02", Label: "Delays"}}
+	origins := []sirius.RefDataItem{{Handle: "PHONE", Label: "Phone call"}, {Handle: "LETTER", Label: "Letter"}}
+
+	client := &mockComplaintsDashboardClient{}
+	client.
+		On("OpenComplaints", mock.Anything).
+		Return([]sirius.Complaint{unmeasured, due, resolved, overdue}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.ComplaintCategory).
+		Return(categories, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.ComplaintOrigin).
+		Return(origins, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{
+			"england-and-wales": {"Early May bank holiday": "2024-05-06T00:00:00+01:00"},
+		}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, complaintsDashboardData{
+			Rows: []complaintsDashboardRow{
+				{
+					Complaint: overdue,
+					Case:      overdue.CaseItems[0],
+					SLA:       sirius.ComplaintSLA{TargetWorkingDays: 10, DueDate: "2024-05-16", WorkingDaysOverdue: 2},
+				},
+				{
+					Complaint: due,
+					SLA:       sirius.ComplaintSLA{TargetWorkingDays: 5, DueDate: "2024-05-24", WorkingDaysRemaining: 4},
+				},
+				{
+					Complaint: unmeasured,
+				},
+			},
+			Overdue:    1,
+			Categories: categories,
+			Origins:    origins,
+			Officers:   []string{"Amy Officer", "Zed Officer"},
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/complaints", nil)
+	w := httptest.NewRecorder()
+
+	today := time.Date(2024, time.May, 20, 9, 0, 0, 0, time.UTC)
+	err := complaintsDashboardWithNow(client, template.Func, func() time.Time { return today })(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetComplaintsDashboardFiltered(t *testing.T) {
+	testCases := map[string]struct {
+		query    string
+		expected []int
+	}{
+		"category": {query: "category=01", expected: []int{2}},
+		"origin":   {query: "origin=PHONE", expected: []int{1, 3}},
+		"officer":  {query: "officer=Zed+Officer", expected: []int{1}},
+		"combined": {query: "category=02&origin=PHONE&officer=Amy+Officer"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockComplaintsDashboardClient{}
+			client.
+				On("OpenComplaints", mock.Anything).
+				Return([]sirius.Complaint{
+					{ID: 3, Category: "02", Origin: "PHONE", ReceivedDate: "2024-05-10"},
+					{ID: 2, Category: "01", Origin: "LETTER", Severity: shared.ComplaintSeveritySecurityBreach, ReceivedDate: "2024-05-17", InvestigatingOfficer: "Amy Officer"},
+					{ID: 4, Category: "02", Severity: shared.ComplaintSeverityMinor, ReceivedDate: "2024-05-01", ResolutionDate: "2024-05-03", InvestigatingOfficer: "Resolved Officer"},
+					{ID: 1, Category: "02", Origin: "PHONE", Severity: shared.ComplaintSeverityMinor, ReceivedDate: "2024-05-01", InvestigatingOfficer: "Zed Officer"},
+				}, nil)
+			client.
+				On("RefDataByCategory", mock.Anything, mock.Anything).
+				Return([]sirius.RefDataItem{}, nil)
+			client.
+				On("BankHolidays", mock.Anything).
+				Return(sirius.BankHolidays{}, nil)
+
+			template := &mockTemplate{}
+			template.
+				On("Func", mock.Anything, mock.MatchedBy(func(data complaintsDashboardData) bool {
+					var ids []int
+					for _, row := range data.Rows {
+						ids = append(ids, row.Complaint.ID)
+					}
+
+					return assert.Equal(t, tc.expected, ids) &&
+						assert.Equal(t, []string{"Amy Officer", "Zed Officer"}, data.Officers)
+				})).
+				Return(nil)
+
+			r, _ := http.NewRequest(http.MethodGet, "/complaints?"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			today := time.Date(2024, time.May, 20, 9, 0, 0, 0, time.UTC)
+			err := complaintsDashboardWithNow(client, template.Func, func() time.Time { return today })(w, r)
+
+			assert.Nil(t, err)
+			mock.AssertExpectationsForObjects(t, client, template)
+		})
+	}
+}
+
+func TestGetComplaintsDashboardWhenBankHolidaysErrors(t *testing.T) {
+	client := &mockComplaintsDashboardClient{}
+	client.
+		On("OpenComplaints", mock.Anything).
+		Return([]sirius.Complaint{{
+			ID:           1,
+			Severity:     shared.ComplaintSeverityMinor,
+			ReceivedDate: "2024-05-01",
+		}}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, mock.Anything).
+		Return([]sirius.RefDataItem{}, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{}, errExample)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data complaintsDashboardData) bool {
+			return assert.Len(t, data.Rows, 1) &&
+				assert.Equal(t, sirius.DateString("2024-05-15"), data.Rows[0].SLA.DueDate) &&
+				assert.True(t, data.WithoutBankHolidays)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/complaints", nil)
+	w := httptest.NewRecorder()
+
+	today := time.Date(2024, time.May, 20, 9, 0, 0, 0, time.UTC)
+	err := complaintsDashboardWithNow(client, template.Func, func() time.Time { return today })(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetComplaintsDashboardWhenComplaintsError(t *testing.T) {
+	client := &mockComplaintsDashboardClient{}
+	client.
+		On("OpenComplaints", mock.Anything).
+		Return([]sirius.Complaint{}, errExample)
+	client.
+		On("RefDataByCategory", mock.Anything, mock.Anything).
+		Return([]sirius.RefDataItem{}, nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/complaints", nil)
+	w := httptest.NewRecorder()
+
+	err := ComplaintsDashboard(client, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+}
+
+func TestGetComplaintsDashboardWhenRefDataErrors(t *testing.T) {
+	client := &mockComplaintsDashboardClient{}
+	client.
+		On("OpenComplaints", mock.Anything).
+		Return([]sirius.Complaint{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.ComplaintCategory).
+		Return([]sirius.RefDataItem{}, errExample)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.ComplaintOrigin).
+		Return([]sirius.RefDataItem{}, nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/complaints", nil)
+	w := httptest.NewRecorder()
+
+	err := ComplaintsDashboard(client, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+}
@@ -13,18 +13,20 @@ type CreateCorrespondentClient interface {
 	Lpa(ctx sirius.Context, id int) (sirius.Lpa, error)
 	CreateCorrespondent(ctx sirius.Context, caseId int, correspondent sirius.Correspondent) error
 	UpdateCorrespondent(ctx sirius.Context, correspondentId int, correspondent sirius.Correspondent) error
+	PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error)
 }
 
 type createCorrespondentData struct {
-	XSRFToken     string
-	IsPartial     bool
-	DonorId       int
-	CaseId        int
-	CaseType      string
-	Correspondent sirius.Correspondent
-	Error         sirius.ValidationError
-	IsEditing     bool
-	Title         string
+	XSRFToken       string
+	IsPartial       bool
+	DonorId         int
+	CaseId          int
+	CaseType        string
+	Correspondent   sirius.Correspondent
+	Error           sirius.ValidationError
+	IsEditing       bool
+	Title           string
+	AddressMismatch bool
 }
 
 func CreateCorrespondent(client CreateCorrespondentClient, tmpl template.Template) Handler {
@@ -96,8 +98,33 @@ func CreateCorrespondent(client CreateCorrespondentClient, tmpl template.Templat
 				},
 				CompanyNumber: postFormString(r, "companyNumber"),
 			}
+
+			var storedAddress sirius.Address
+			if correspondent != nil {
+				storedAddress = personAddress(correspondent.Person)
+			}
+
+			submittedAddress, addressReason := normaliseAddress(storedAddress, personAddress(updatedCorrespondent.Person))
+			setPersonAddress(&updatedCorrespondent.Person, submittedAddress)
 			data.Correspondent = updatedCorrespondent
 
+			if addressReason != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = sirius.ValidationError{
+					Field: sirius.FieldErrors{
+						"postcode": {"reason": addressReason},
+					},
+				}
+				return tmpl(w, data)
+			}
+
+			if addressChanged(storedAddress, submittedAddress) && postFormString(r, "confirmAddress") != "true" {
+				data.AddressMismatch = addressMismatch(ctx, client, submittedAddress)
+				if data.AddressMismatch {
+					return tmpl(w, data)
+				}
+			}
+
 			if data.IsEditing {
 				updatedCorrespondent.ID = correspondent.ID
 				data.Correspondent = updatedCorrespondent
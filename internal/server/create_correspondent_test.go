@@ -37,6 +37,11 @@ func (m *mockCreateCorrespondentClient) UpdateCorrespondent(ctx sirius.Context,
 	return args.Error(0)
 }
 
+func (m *mockCreateCorrespondentClient) PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error) {
+	args := m.Called(ctx, postcode)
+	return args.Get(0).([]sirius.PostcodeLookupAddress), args.Error(1)
+}
+
 func TestGetCreateCorrespondent(t *testing.T) {
 	client := &mockCreateCorrespondentClient{}
 	client.
@@ -390,6 +395,56 @@ func TestPostCreateCorrespondentWhenValidationError(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, client, template)
 }
 
+func TestPostCreateCorrespondentWhenPostcodeInvalid(t *testing.T) {
+	client := &mockCreateCorrespondentClient{}
+	client.
+		On("Epa", mock.Anything, 2).
+		Return(sirius.Epa{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, createCorrespondentData{
+			DonorId:  1,
+			CaseId:   2,
+			CaseType: "epa",
+			Error: sirius.ValidationError{
+				Field: sirius.FieldErrors{"postcode": {"reason": "Enter a real postcode"}},
+			},
+			Correspondent: sirius.Correspondent{
+				Person: sirius.Person{
+					Firstname:    "Rudolph",
+					Surname:      "Stotesbury",
+					AddressLine1: "1 High Street",
+					Town:         "Bristol",
+					Postcode:     "BS1",
+					Country:      "GB",
+				},
+			},
+			Title: "Add a correspondent",
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"firstname":    {"Rudolph"},
+		"surname":      {"Stotesbury"},
+		"addressLine1": {"1 High Street "},
+		"town":         {"Bristol"},
+		"postcode":     {"bs1"},
+		"country":      {"gb"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=1&caseId=2&caseType=epa", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := CreateCorrespondent(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
 func TestPostCreateCorrespondentCreationFails(t *testing.T) {
 	correspondent := sirius.Correspondent{
 		Person: sirius.Person{
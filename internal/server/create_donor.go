@@ -4,23 +4,26 @@ import (
 	"net/http"
 
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/donormatch"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 )
 
 type CreateDonorClient interface {
 	CreateDonor(ctx sirius.Context, donor sirius.Person) (sirius.Person, error)
+	SearchDonors(ctx sirius.Context, term string) ([]sirius.Person, error)
 }
 
 type donorData struct {
-	XSRFToken  string
-	Success    bool
-	Error      sirius.ValidationError
-	DonorId    int
-	Donor      sirius.Person
-	IsNew      bool
-	CaseUids   string
-	EntityType string
-	IsPartial  bool
+	XSRFToken      string
+	Success        bool
+	Error          sirius.ValidationError
+	DonorId        int
+	Donor          sirius.Person
+	IsNew          bool
+	CaseUids       string
+	EntityType     string
+	IsPartial      bool
+	PossibleDonors possibleDonors
 }
 
 func CreateDonor(client CreateDonorClient, tmpl template.Template) Handler {
@@ -70,6 +73,21 @@ func CreateDonor(client CreateDonorClient, tmpl template.Template) Handler {
 				ResearchOptOut:        postFormString(r, "researchOptOut") == "Yes",
 			}
 
+			if postFormString(r, "createNewDonor") != "true" {
+				matches := findPossibleDonors(ctx, client, donormatch.Candidate{
+					Firstname:   donor.Firstname,
+					Surname:     donor.Surname,
+					DateOfBirth: donor.DateOfBirth,
+					Postcode:    donor.Postcode,
+				})
+
+				if len(matches) > 0 {
+					data.Donor = donor
+					data.PossibleDonors = possibleDonors{Matches: matches}
+					return tmpl(w, data)
+				}
+			}
+
 			createdDonor, err := client.CreateDonor(ctx, donor)
 
 			if ve, ok := err.(sirius.ValidationError); ok {
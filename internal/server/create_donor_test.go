@@ -21,6 +21,11 @@ func (m *mockCreateDonorClient) CreateDonor(ctx sirius.Context, personData siriu
 	return args.Get(0).(sirius.Person), args.Error(1)
 }
 
+func (m *mockCreateDonorClient) SearchDonors(ctx sirius.Context, term string) ([]sirius.Person, error) {
+	args := m.Called(ctx, term)
+	return args.Get(0).([]sirius.Person), args.Error(1)
+}
+
 func TestGetCreateDonor(t *testing.T) {
 	client := &mockCreateDonorClient{}
 
@@ -70,6 +75,9 @@ func TestGetCreateDonorHtmxRequest(t *testing.T) {
 
 func TestPostCreateDonor(t *testing.T) {
 	client := &mockCreateDonorClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Rudolph Stotesbury").
+		Return([]sirius.Person{}, nil)
 	client.
 		On("CreateDonor", mock.Anything, sirius.Person{
 			Salutation:            "Rev",
@@ -146,6 +154,9 @@ func TestPostCreateDonor(t *testing.T) {
 
 func TestPostCreateDonorHtmxRequest(t *testing.T) {
 	client := &mockCreateDonorClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Rudolph").
+		Return([]sirius.Person{}, nil)
 	client.
 		On("CreateDonor", mock.Anything, sirius.Person{
 			Firstname:   "Rudolph",
@@ -189,6 +200,9 @@ func TestPostCreateDonorHtmxRequest(t *testing.T) {
 
 func TestPostCreateDonorWhenAPIFails(t *testing.T) {
 	client := &mockCreateDonorClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Rudolph Stotesbury").
+		Return([]sirius.Person{}, nil)
 	client.
 		On("CreateDonor", mock.Anything, sirius.Person{
 			Firstname: "Rudolph",
@@ -214,6 +228,9 @@ func TestPostCreateDonorWhenAPIFails(t *testing.T) {
 
 func TestPostCreateDonorWhenValidationError(t *testing.T) {
 	client := &mockCreateDonorClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Rudolph").
+		Return([]sirius.Person{}, nil)
 	client.
 		On("CreateDonor", mock.Anything, sirius.Person{
 			Firstname: "Rudolph",
@@ -249,3 +266,83 @@ func TestPostCreateDonorWhenValidationError(t *testing.T) {
 	assert.Nil(t, err)
 	mock.AssertExpectationsForObjects(t, client, template)
 }
+
+func TestPostCreateDonorWhenPossibleDonorsFound(t *testing.T) {
+	existing := sirius.Person{ID: 33, UID: "7000-0000-0033", Firstname: "Rudolf", Surname: "Stotesbury", DateOfBirth: "1981-10-03", Postcode: "SW1A 1AA"}
+
+	client := &mockCreateDonorClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Rudolph Stotesbury").
+		Return([]sirius.Person{existing}, nil)
+	client.
+		On("SearchDonors", mock.Anything, "SW1A 1AA").
+		Return([]sirius.Person{existing}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data donorData) bool {
+			return !data.Success &&
+				data.Donor.Firstname == "Rudolph" &&
+				!data.PossibleDonors.ForDraft &&
+				len(data.PossibleDonors.Matches) == 1 &&
+				data.PossibleDonors.Matches[0].Person.ID == 33
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"firstname": {"Rudolph"},
+		"surname":   {"Stotesbury"},
+		"dob":       {"1981-10-03"},
+		"postcode":  {"sw1a 1aa"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/create-donor", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := CreateDonor(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostCreateDonorWhenCreatingNewDonorAnyway(t *testing.T) {
+	client := &mockCreateDonorClient{}
+	client.
+		On("CreateDonor", mock.Anything, sirius.Person{
+			Firstname:   "Rudolph",
+			Surname:     "Stotesbury",
+			DateOfBirth: "1981-10-03",
+		}).
+		Return(sirius.Person{ID: 809, UID: "7123-4567-8901"}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, donorData{
+			IsNew: true,
+			Donor: sirius.Person{
+				ID:  809,
+				UID: "7123-4567-8901",
+			},
+			Success: true,
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"firstname":      {"Rudolph"},
+		"surname":        {"Stotesbury"},
+		"dob":            {"1981-10-03"},
+		"createNewDonor": {"true"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/create-donor", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := CreateDonor(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
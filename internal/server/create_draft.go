@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/donormatch"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"golang.org/x/sync/errgroup"
 )
@@ -63,6 +64,7 @@ type CreateDraftClient interface {
 	DigitalLpa(ctx sirius.Context, uid string, presignImages bool) (sirius.DigitalLpa, error)
 	GetUserDetails(ctx sirius.Context) (sirius.User, error)
 	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
+	SearchDonors(ctx sirius.Context, term string) ([]sirius.Person, error)
 }
 
 type createDraftResult struct {
@@ -71,13 +73,14 @@ type createDraftResult struct {
 }
 
 type createDraftData struct {
-	XSRFToken string
-	Countries []sirius.RefDataItem
-	Form      formDraft
-	Error     sirius.ValidationError
-	Success   bool
-	Uids      []createDraftResult
-	Donor     sirius.Donor
+	XSRFToken      string
+	Countries      []sirius.RefDataItem
+	Form           formDraft
+	Error          sirius.ValidationError
+	Success        bool
+	Uids           []createDraftResult
+	Donor          sirius.Donor
+	PossibleDonors possibleDonors
 }
 
 func CreateDraft(client CreateDraftClient, tmpl template.Template) Handler {
@@ -147,6 +150,20 @@ func CreateDraft(client CreateDraftClient, tmpl template.Template) Handler {
 				compiledDraft.CorrespondentLastName = data.Form.CorrespondentSurname
 			}
 
+			if postFormString(r, "createNewDonor") != "true" {
+				matches := findPossibleDonors(ctx, client, donormatch.Candidate{
+					Firstname:   compiledDraft.DonorFirstNames,
+					Surname:     compiledDraft.DonorLastName,
+					DateOfBirth: compiledDraft.DonorDob,
+					Postcode:    compiledDraft.DonorAddress.Postcode,
+				})
+
+				if len(matches) > 0 {
+					data.PossibleDonors = possibleDonors{Matches: matches, ForDraft: true}
+					return tmpl(w, data)
+				}
+			}
+
 			uids, err := client.CreateDraft(ctx, compiledDraft)
 
 			if ve, ok := err.(sirius.ValidationError); ok {
@@ -39,6 +39,11 @@ func (m *mockCreateDraftClient) DigitalLpa(ctx sirius.Context, uid string, presi
 	return args.Get(0).(sirius.DigitalLpa), args.Error(1)
 }
 
+func (m *mockCreateDraftClient) SearchDonors(ctx sirius.Context, term string) ([]sirius.Person, error) {
+	args := m.Called(ctx, term)
+	return args.Get(0).([]sirius.Person), args.Error(1)
+}
+
 func TestGetCreateDraft(t *testing.T) {
 	client := &mockCreateDraftClient{}
 	client.
@@ -89,6 +94,9 @@ func TestGetCreateDraftForbidden(t *testing.T) {
 
 func TestPostCreateDraft(t *testing.T) {
 	client := &mockCreateDraftClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Gerald Ryan Sandel").
+		Return([]sirius.Person{}, nil)
 	client.
 		On("GetUserDetails", mock.Anything).
 		Return(sirius.User{Roles: []string{"private-mlpa"}}, nil)
@@ -216,6 +224,9 @@ func TestPostCreateDraft(t *testing.T) {
 
 func TestPostCreateDraftWhenAPIFails(t *testing.T) {
 	client := &mockCreateDraftClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Gerald Ryan Sandel").
+		Return([]sirius.Person{}, nil)
 	client.
 		On("GetUserDetails", mock.Anything).
 		Return(sirius.User{Roles: []string{"private-mlpa"}}, nil)
@@ -249,6 +260,9 @@ func TestPostCreateDraftWhenAPIFails(t *testing.T) {
 
 func TestPostCreateDraftWhenValidationError(t *testing.T) {
 	client := &mockCreateDraftClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Gerald Ryan").
+		Return([]sirius.Person{}, nil)
 	client.
 		On("GetUserDetails", mock.Anything).
 		Return(sirius.User{Roles: []string{"private-mlpa"}}, nil)
@@ -292,3 +306,43 @@ func TestPostCreateDraftWhenValidationError(t *testing.T) {
 	assert.Nil(t, err)
 	mock.AssertExpectationsForObjects(t, client, template)
 }
+
+func TestPostCreateDraftWhenPossibleDonorsFound(t *testing.T) {
+	client := &mockCreateDraftClient{}
+	client.
+		On("GetUserDetails", mock.Anything).
+		Return(sirius.User{Roles: []string{"private-mlpa"}}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CountryCategory).
+		Return([]sirius.RefDataItem{}, nil)
+	client.
+		On("SearchDonors", mock.Anything, "Gerald Ryan Sandel").
+		Return([]sirius.Person{{ID: 12, Firstname: "Gerald", Middlenames: "Ryan", Surname: "Sandel", DateOfBirth: "1943-03-02"}}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data createDraftData) bool {
+			return data.PossibleDonors.ForDraft &&
+				len(data.PossibleDonors.Matches) == 1 &&
+				data.PossibleDonors.Matches[0].Person.ID == 12 &&
+				data.Form.DonorSurname == "Sandel"
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"donorFirstname": {"Gerald Ryan"},
+		"donorSurname":   {"Sandel"},
+		"dob.day":        {"2"},
+		"dob.month":      {"3"},
+		"dob.year":       {"1943"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/digital-lpa/create", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	_ = r.ParseMultipartForm(32 << 20)
+	w := httptest.NewRecorder()
+
+	err := CreateDraft(client, template.Func)(w, r)
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/companies"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 )
@@ -19,6 +20,7 @@ type CreateTrustCorporationClient interface {
 type createTrustCorporationData struct {
 	AppointedAs            string
 	CaseId                 int
+	CompanyCheck           companyCheck
 	DonorId                int
 	Error                  sirius.ValidationError
 	HtmxPost               string
@@ -30,7 +32,7 @@ type createTrustCorporationData struct {
 	XSRFToken              string
 }
 
-func CreateTrustCorporation(client CreateTrustCorporationClient, tmpl template.Template) Handler {
+func CreateTrustCorporation(client CreateTrustCorporationClient, registry companies.CompanyRegistry, tmpl template.Template) Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		ctx := getContext(r)
 
@@ -57,6 +59,7 @@ func CreateTrustCorporation(client CreateTrustCorporationClient, tmpl template.T
 				IsReplacementAttorney: isReplacementAttorney,
 				Attorney:              sirius.Attorney{SystemStatus: shared.BoolPtr(true)},
 			},
+			CompanyCheck: companyCheck{LookupEnabled: registry != nil},
 		}
 
 		if isReplacementAttorney {
@@ -129,6 +132,49 @@ func CreateTrustCorporation(client CreateTrustCorporationClient, tmpl template.T
 
 			data.TrustCorporation = trustCorporation
 
+			companyNumber, reason := validateCompanyNumber(trustCorporation.CompanyNumber)
+			if reason != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = sirius.ValidationError{Field: sirius.FieldErrors{
+					"companyNumber": {"reason": reason},
+				}}
+
+				return tmpl(w, data)
+			}
+
+			trustCorporation.CompanyNumber = companyNumber
+			data.TrustCorporation.CompanyNumber = companyNumber
+			data.CompanyCheck = checkCompany(ctx, registry, companyNumber, trustCorporation.CompanyName)
+
+			if postFormString(r, "lookupCompany") != "" {
+				if companyNumber == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					data.Error = sirius.ValidationError{Field: sirius.FieldErrors{
+						"companyNumber": {"reason": "Enter a company registration number to look up"},
+					}}
+				} else if company := data.CompanyCheck.Company; company != nil {
+					// only fill in blanks, so nothing already entered is lost
+					if data.TrustCorporation.CompanyName == "" {
+						data.TrustCorporation.CompanyName = company.Name
+					}
+
+					if data.TrustCorporation.AddressLine1 == "" {
+						data.TrustCorporation.AddressLine1 = company.Address.Line1
+						data.TrustCorporation.AddressLine2 = company.Address.Line2
+						data.TrustCorporation.AddressLine3 = company.Address.Line3
+						data.TrustCorporation.Town = company.Address.Town
+						data.TrustCorporation.Postcode = company.Address.Postcode
+						data.TrustCorporation.Country = company.Address.Country
+					}
+				}
+
+				return tmpl(w, data)
+			}
+
+			if data.CompanyCheck.NameMismatch && postFormString(r, "confirmCompanyName") != "true" {
+				return tmpl(w, data)
+			}
+
 			if isEditing {
 				err = client.UpdateTrustCorporation(ctx, trustCorporationId, trustCorporation)
 			} else {
@@ -60,7 +60,7 @@ func TestGetCreateTrustCorporation(t *testing.T) {
 			r, _ := http.NewRequest(http.MethodGet, "/?id=1&caseId=2&replacement="+isReplacementAttorney, nil)
 			w := httptest.NewRecorder()
 
-			err := CreateTrustCorporation(nil, template.Func)(w, r)
+			err := CreateTrustCorporation(nil, nil, template.Func)(w, r)
 			resp := w.Result()
 
 			assert.Nil(t, err)
@@ -107,7 +107,7 @@ func TestGetEditTrustCorporation(t *testing.T) {
 			r, _ := http.NewRequest(http.MethodGet, "/?id=1&caseId=2&trustCorporationId=3&replacement="+isReplacementAttorney, nil)
 			w := httptest.NewRecorder()
 
-			err := CreateTrustCorporation(client, template.Func)(w, r)
+			err := CreateTrustCorporation(client, nil, template.Func)(w, r)
 			resp := w.Result()
 
 			assert.Nil(t, err)
@@ -131,7 +131,7 @@ func TestGetCreateTrustCorporationBadQuery(t *testing.T) {
 			r, _ := http.NewRequest(http.MethodGet, query, nil)
 			w := httptest.NewRecorder()
 
-			err := CreateTrustCorporation(nil, nil)(w, r)
+			err := CreateTrustCorporation(nil, nil, nil)(w, r)
 
 			assert.NotNil(t, err)
 		})
@@ -147,7 +147,7 @@ func TestGetEditTrustCorporationWhenLpaFails(t *testing.T) {
 	r, _ := http.NewRequest(http.MethodGet, "/create-trust-corporation/?id=1&caseId=2&trustCorporationId=3", nil)
 	w := httptest.NewRecorder()
 
-	err := CreateTrustCorporation(client, nil)(w, r)
+	err := CreateTrustCorporation(client, nil, nil)(w, r)
 	assert.Equal(t, errExample, err)
 }
 
@@ -169,7 +169,7 @@ func TestPostCreateTrustCorporation(t *testing.T) {
 						Country:           "United Kingdom",
 						IsAirmailRequired: false,
 					},
-					CompanyNumber: "123",
+					CompanyNumber: "00000123",
 				},
 				IsReplacementAttorney: isReplacementAttorney == "true",
 			}
@@ -208,7 +208,7 @@ func TestPostCreateTrustCorporation(t *testing.T) {
 			r.Header.Add("Content-Type", formUrlEncoded)
 			w := httptest.NewRecorder()
 
-			err := CreateTrustCorporation(client, nil)(w, r)
+			err := CreateTrustCorporation(client, nil, nil)(w, r)
 
 			assert.Equal(t, RedirectError("/create-lpa?id=1&caseId=2#accordion-create-lpa-heading-1"), err)
 		})
@@ -236,7 +236,7 @@ func TestPostEditTrustCorporation(t *testing.T) {
 				IsAirmailRequired: false,
 			},
 			SystemStatus:  shared.BoolPtr(true),
-			CompanyNumber: "123",
+			CompanyNumber: "00000123",
 		},
 		IsReplacementAttorney:       false,
 		TrustCorporationAppointedAs: "Attorney",
@@ -271,7 +271,7 @@ func TestPostEditTrustCorporation(t *testing.T) {
 	r.Header.Add("Content-Type", formUrlEncoded)
 	w := httptest.NewRecorder()
 
-	err := CreateTrustCorporation(client, nil)(w, r)
+	err := CreateTrustCorporation(client, nil, nil)(w, r)
 
 	assert.Equal(t, RedirectError("/create-lpa?id=1&caseId=2#accordion-create-lpa-heading-1"), err)
 }
@@ -303,7 +303,7 @@ func TestPostCreateTrustCorporationWhenCreateFails(t *testing.T) {
 	r.Header.Add("Content-Type", formUrlEncoded)
 	w := httptest.NewRecorder()
 
-	err := CreateTrustCorporation(client, nil)(w, r)
+	err := CreateTrustCorporation(client, nil, nil)(w, r)
 
 	assert.Equal(t, errExample, err)
 }
@@ -342,7 +342,7 @@ func TestPostEditTrustCorporationWhenUpdateFails(t *testing.T) {
 	r.Header.Add("Content-Type", formUrlEncoded)
 	w := httptest.NewRecorder()
 
-	err := CreateTrustCorporation(client, nil)(w, r)
+	err := CreateTrustCorporation(client, nil, nil)(w, r)
 
 	assert.Equal(t, errExample, err)
 }
@@ -389,7 +389,7 @@ func TestPostCreateTrustCorporationWhenValidationError(t *testing.T) {
 	r.Header.Add("Content-Type", formUrlEncoded)
 	w := httptest.NewRecorder()
 
-	err := CreateTrustCorporation(client, template.Func)(w, r)
+	err := CreateTrustCorporation(client, nil, template.Func)(w, r)
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -397,6 +397,139 @@ func TestPostCreateTrustCorporationWhenValidationError(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, client, template)
 }
 
+func TestPostCreateTrustCorporationWhenCompanyNumberInvalid(t *testing.T) {
+	client := &mockCreateTrustCorporationClient{}
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data createTrustCorporationData) bool {
+			return data.Error.Field["companyNumber"]["reason"] == "Company registration number must be 8 digits, or start with a prefix such as SC, NI or OC followed by 6 digits" &&
+				data.TrustCorporation.CompanyNumber == "XX123456"
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"companyName":              {"ACME"},
+		"companyNumber":            {"XX123456"},
+		"isReplacementAttorney":    {"false"},
+		"isTrustCorporationActive": {"true"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "create-trust-corporation/?id=1&caseId=2&replacement=false", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := CreateTrustCorporation(client, nil, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostCreateTrustCorporationLookupCompany(t *testing.T) {
+	client := &mockCreateTrustCorporationClient{}
+
+	registry := &mockCompanyRegistry{}
+	registry.
+		On("Company", mock.Anything, "SC123456").
+		Return(testRegisteredCompany, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data createTrustCorporationData) bool {
+			return data.TrustCorporation.CompanyName == "Highland Trustees PLC" &&
+				data.TrustCorporation.CompanyNumber == "SC123456" &&
+				data.TrustCorporation.AddressLine1 == "2 Castle Wynd" &&
+				data.TrustCorporation.Town == "Edinburgh" &&
+				data.TrustCorporation.Postcode == "EH1 2NG" &&
+				data.CompanyCheck.Company != nil &&
+				*data.CompanyCheck.Company == testRegisteredCompany
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"companyNumber":            {"sc123456"},
+		"lookupCompany":            {"true"},
+		"isReplacementAttorney":    {"false"},
+		"isTrustCorporationActive": {"true"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "create-trust-corporation/?id=1&caseId=2&replacement=false", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := CreateTrustCorporation(client, registry, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, registry, template)
+}
+
+func TestPostCreateTrustCorporationWhenCompanyNameDoesNotMatch(t *testing.T) {
+	client := &mockCreateTrustCorporationClient{}
+
+	registry := &mockCompanyRegistry{}
+	registry.
+		On("Company", mock.Anything, "SC123456").
+		Return(testRegisteredCompany, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data createTrustCorporationData) bool {
+			return data.TrustCorporation.CompanyName == "Highland Trust" && data.CompanyCheck.NameMismatch
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"companyName":              {"Highland Trust"},
+		"companyNumber":            {"SC123456"},
+		"isReplacementAttorney":    {"false"},
+		"isTrustCorporationActive": {"true"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "create-trust-corporation/?id=1&caseId=2&replacement=false", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := CreateTrustCorporation(client, registry, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, registry, template)
+}
+
+func TestPostCreateTrustCorporationWhenCompanyNameMismatchConfirmed(t *testing.T) {
+	client := &mockCreateTrustCorporationClient{}
+	client.
+		On("CreateTrustCorporation", mock.Anything, 2, mock.MatchedBy(func(trustCorporation sirius.TrustCorporation) bool {
+			return trustCorporation.CompanyName == "Highland Trust" && trustCorporation.CompanyNumber == "SC123456"
+		})).
+		Return(nil)
+
+	registry := &mockCompanyRegistry{}
+	registry.
+		On("Company", mock.Anything, "SC123456").
+		Return(testRegisteredCompany, nil)
+
+	form := url.Values{
+		"companyName":              {"Highland Trust"},
+		"companyNumber":            {"SC123456"},
+		"confirmCompanyName":       {"true"},
+		"isReplacementAttorney":    {"false"},
+		"isTrustCorporationActive": {"true"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "create-trust-corporation/?id=1&caseId=2&replacement=false", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := CreateTrustCorporation(client, registry, nil)(w, r)
+
+	assert.Equal(t, RedirectError("/create-lpa?id=1&caseId=2#accordion-create-lpa-heading-1"), err)
+	mock.AssertExpectationsForObjects(t, client, registry)
+}
+
 func TestPostCreateTrustCorporationAddAnotherRedirects(t *testing.T) {
 	expectedTrustCorporation := sirius.TrustCorporation{
 		Attorney: sirius.Attorney{
@@ -425,7 +558,7 @@ func TestPostCreateTrustCorporationAddAnotherRedirects(t *testing.T) {
 	r.Header.Add("Content-Type", formUrlEncoded)
 	w := httptest.NewRecorder()
 
-	err := CreateTrustCorporation(client, nil)(w, r)
+	err := CreateTrustCorporation(client, nil, nil)(w, r)
 
 	assert.Equal(t, RedirectError("/create-trust-corporation?id=1&caseId=2&replacement=false"), err)
 }
@@ -471,7 +604,7 @@ func TestPostEditTrustCorporationEditNextRedirects(t *testing.T) {
 	r.Header.Add("Content-Type", formUrlEncoded)
 	w := httptest.NewRecorder()
 
-	err := CreateTrustCorporation(client, nil)(w, r)
+	err := CreateTrustCorporation(client, nil, nil)(w, r)
 
 	assert.Equal(t, RedirectError("/create-trust-corporation?id=1&caseId=2&trustCorporationId=4&replacement=false"), err)
 }
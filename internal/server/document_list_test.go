@@ -363,6 +363,13 @@ func TestGetDocumentList(t *testing.T) {
 					Disabled: true,
 					Hidden:   true,
 				},
+				{
+					Label:    "Merge records",
+					URL:      "/merge-person?id=82",
+					IconName: "aw-link",
+					Disabled: false,
+					Hidden:   true,
+				},
 				{
 					Label:    "Delete relationship",
 					URL:      "/delete-relationship?id=82",
@@ -530,6 +537,13 @@ func TestGetDocumentList(t *testing.T) {
 					Disabled: true,
 					Hidden:   true,
 				},
+				{
+					Label:    "Merge records",
+					URL:      "/merge-person?id=82",
+					IconName: "aw-link",
+					Disabled: false,
+					Hidden:   true,
+				},
 				{
 
 					Label:    "Delete relationship",
@@ -699,6 +713,13 @@ func TestGetDocumentList(t *testing.T) {
 					Disabled: true,
 					Hidden:   true,
 				},
+				{
+					Label:    "Merge records",
+					URL:      "/merge-person?id=82&uid[]=7000-1234-0000",
+					IconName: "aw-link",
+					Disabled: false,
+					Hidden:   true,
+				},
 				{
 					Label:    "Delete relationship",
 					URL:      "/delete-relationship?id=82&uid[]=7000-1234-0000",
@@ -867,6 +888,13 @@ func TestGetDocumentList(t *testing.T) {
 					Disabled: true,
 					Hidden:   true,
 				},
+				{
+					Label:    "Merge records",
+					URL:      "/merge-person?id=82&uid[]=7000-1234-0000&uid[]=7000-9876-0000",
+					IconName: "aw-link",
+					Disabled: false,
+					Hidden:   true,
+				},
 				{
 
 					Label:    "Delete relationship",
@@ -1248,6 +1276,13 @@ func TestDocumentListShowsValidationErrorWhenNoDocumentsSelected(t *testing.T) {
 						Disabled: true,
 						Hidden:   true,
 					},
+					{
+						Label:    "Merge records",
+						URL:      "/merge-person?id=82",
+						IconName: "aw-link",
+						Disabled: false,
+						Hidden:   true,
+					},
 					{
 						Label:    "Delete relationship",
 						URL:      "/delete-relationship?id=82",
@@ -1488,6 +1523,13 @@ func TestDocumentListDismissValidation(t *testing.T) {
 						Disabled: true,
 						Hidden:   true,
 					},
+					{
+						Label:    "Merge records",
+						URL:      "/merge-person?id=82&uid[]=7000-1234-0000&uid[]=7000-9876-0000",
+						IconName: "aw-link",
+						Disabled: false,
+						Hidden:   true,
+					},
 					{
 						Label:    "Delete relationship",
 						URL:      "/delete-relationship?id=82&uid[]=7000-1234-0000&uid[]=7000-9876-0000",
@@ -1903,6 +1945,13 @@ func TestGetDocumentListWhenTemplateErrors(t *testing.T) {
 						Disabled: true,
 						Hidden:   true,
 					},
+					{
+						Label:    "Merge records",
+						URL:      "/merge-person?id=82",
+						IconName: "aw-link",
+						Disabled: false,
+						Hidden:   true,
+					},
 					{
 						Label:    "Delete relationship",
 						URL:      "/delete-relationship?id=82",
@@ -2260,6 +2309,13 @@ func TestDocumentListDownloadMultipleInfectedError(t *testing.T) {
 						Disabled: true,
 						Hidden:   true,
 					},
+					{
+						Label:    "Merge records",
+						URL:      "/merge-person?id=82",
+						IconName: "aw-link",
+						Disabled: false,
+						Hidden:   true,
+					},
 					{
 						Label:    "Delete relationship",
 						URL:      "/delete-relationship?id=82",
@@ -2,18 +2,108 @@ package server
 
 import (
 	"net/http"
+	"sort"
 	"strconv"
 
+	"github.com/ministryofjustice/opg-go-common/telemetry"
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"golang.org/x/sync/errgroup"
 )
 
 type DonorDetailsClient interface {
 	Person(sirius.Context, int) (sirius.Person, error)
+	ComplaintsForDonor(sirius.Context, int) ([]sirius.Complaint, error)
+	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
+	TasksForCase(sirius.Context, int) ([]sirius.Task, error)
 }
 
 type DonorDetailsData struct {
-	Donor sirius.Person
+	Donor              sirius.Person
+	Compensation       sirius.CompensationSummary
+	CompensationTypes  []sirius.RefDataItem
+	CompensationFailed bool
+	Tasks              []sirius.Task
+	TasksFailed        bool
+}
+
+// donorCompensation totals the compensation awarded on the donor's complaints.
+// It is only a summary, so a failure is logged and reported rather than
+// stopping the page loading.
+func donorCompensation(ctx sirius.Context, client DonorDetailsClient, donorID int) (sirius.CompensationSummary, []sirius.RefDataItem, bool) {
+	var (
+		complaints []sirius.Complaint
+		types      []sirius.RefDataItem
+	)
+
+	group, groupCtx := errgroup.WithContext(ctx.Context)
+
+	group.Go(func() error {
+		var err error
+		complaints, err = client.ComplaintsForDonor(ctx.With(groupCtx), donorID)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		types, err = client.RefDataByCategory(ctx.With(groupCtx), sirius.CompensationType)
+		return err
+	})
+
+	if err := group.Wait(); err != nil {
+		telemetry.LoggerFromContext(ctx.Context).Warn("donor compensation lookup failed", "error", err)
+		return sirius.CompensationSummary{}, nil, false
+	}
+
+	return sirius.NewCompensationSummary(complaints), types, true
+}
+
+// donorTasks collects the open tasks across all of the donor's cases so they
+// can be worked on together.
+func donorTasks(ctx sirius.Context, client DonorDetailsClient, donor sirius.Person) ([]sirius.Task, bool) {
+	tasksByCase := make([][]sirius.Task, len(donor.Cases))
+
+	group, groupCtx := errgroup.WithContext(ctx.Context)
+
+	for i, c := range donor.Cases {
+		group.Go(func() error {
+			tasks, err := client.TasksForCase(ctx.With(groupCtx), c.ID)
+			if err != nil {
+				return err
+			}
+
+			for j := range tasks {
+				if len(tasks[j].CaseItems) == 0 {
+					tasks[j].CaseItems = []sirius.Case{*c}
+				}
+			}
+
+			tasksByCase[i] = tasks
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		telemetry.LoggerFromContext(ctx.Context).Warn("donor tasks lookup failed", "error", err)
+		return nil, false
+	}
+
+	var tasks []sirius.Task
+	seen := map[int]bool{}
+	for _, caseTasks := range tasksByCase {
+		for _, task := range caseTasks {
+			if !seen[task.ID] {
+				seen[task.ID] = true
+				tasks = append(tasks, task)
+			}
+		}
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].DueDate < tasks[j].DueDate
+	})
+
+	return tasks, true
 }
 
 func DonorDetails(client DonorDetailsClient, tmpl template.Template) Handler {
@@ -38,6 +128,13 @@ func DonorDetails(client DonorDetailsClient, tmpl template.Template) Handler {
 			Donor: donorDetails,
 		}
 
+		var ok bool
+		data.Compensation, data.CompensationTypes, ok = donorCompensation(ctx, client, donorID)
+		data.CompensationFailed = !ok
+
+		data.Tasks, ok = donorTasks(ctx, client, donorDetails)
+		data.TasksFailed = !ok
+
 		return tmpl(w, data)
 	}
 }
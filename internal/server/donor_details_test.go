@@ -19,6 +19,21 @@ func (m *mockDonorDetailsClient) Person(ctx sirius.Context, id int) (sirius.Pers
 	return args.Get(0).(sirius.Person), args.Error(1)
 }
 
+func (m *mockDonorDetailsClient) ComplaintsForDonor(ctx sirius.Context, id int) ([]sirius.Complaint, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]sirius.Complaint), args.Error(1)
+}
+
+func (m *mockDonorDetailsClient) RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error) {
+	args := m.Called(ctx, category)
+	return args.Get(0).([]sirius.RefDataItem), args.Error(1)
+}
+
+func (m *mockDonorDetailsClient) TasksForCase(ctx sirius.Context, id int) ([]sirius.Task, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]sirius.Task), args.Error(1)
+}
+
 func TestDonorDetailsFail(t *testing.T) {
 	expectedError := errors.New("network error")
 
@@ -64,11 +79,18 @@ func TestDonorDetailsSuccess(t *testing.T) {
 	client.
 		On("Person", mock.Anything, 123).
 		Return(expectedDonor, nil)
+	client.
+		On("ComplaintsForDonor", mock.Anything, 123).
+		Return([]sirius.Complaint{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil)
 
 	template := &mockTemplate{}
 	template.
 		On("Func", mock.Anything, DonorDetailsData{
-			Donor: expectedDonor,
+			Donor:             expectedDonor,
+			CompensationTypes: demoCompensationTypes,
 		}).
 		Return(nil)
 
@@ -94,3 +116,153 @@ func TestDonorDetailsInvalidDonorId(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestDonorDetailsCompensation(t *testing.T) {
+	complaints := []sirius.Complaint{
+		{ID: 1, CompensationType: "COMPENSATORY", CompensationAmount: "150.00"},
+		{ID: 2, CompensationType: "COMPENSATORY", CompensationAmount: "25.50"},
+		{ID: 3, CompensationType: "NOT_APPLICABLE"},
+	}
+
+	client := &mockDonorDetailsClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(sirius.Person{ID: 123}, nil)
+	client.
+		On("ComplaintsForDonor", mock.Anything, 123).
+		Return(complaints, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, DonorDetailsData{
+			Donor: sirius.Person{ID: 123},
+			Compensation: sirius.CompensationSummary{
+				Types: []sirius.CompensationTotal{{Type: "COMPENSATORY", Count: 2, Total: 17550}},
+				Count: 2,
+				Total: 17550,
+			},
+			CompensationTypes: demoCompensationTypes,
+		}).
+		Return(nil)
+
+	server := newMockServer("/donor/{donorId}/details", DonorDetails(client, template.Func))
+
+	req, _ := http.NewRequest(http.MethodGet, "/donor/123/details", nil)
+	_, err := server.serve(req)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestDonorDetailsWhenCompensationLookupFails(t *testing.T) {
+	client := &mockDonorDetailsClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(sirius.Person{ID: 123}, nil)
+	client.
+		On("ComplaintsForDonor", mock.Anything, 123).
+		Return([]sirius.Complaint{}, errExample)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, DonorDetailsData{
+			Donor:              sirius.Person{ID: 123},
+			CompensationFailed: true,
+		}).
+		Return(nil)
+
+	server := newMockServer("/donor/{donorId}/details", DonorDetails(client, template.Func))
+
+	req, _ := http.NewRequest(http.MethodGet, "/donor/123/details", nil)
+	_, err := server.serve(req)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestDonorDetailsTasks(t *testing.T) {
+	pfa := &sirius.Case{ID: 4, UID: "7000-0000-0004", CaseType: "LPA", SubType: "pfa"}
+	hw := &sirius.Case{ID: 5, UID: "7000-0000-0005", CaseType: "LPA", SubType: "hw"}
+	donor := sirius.Person{ID: 123, Cases: []*sirius.Case{pfa, hw}}
+
+	shared := sirius.Task{ID: 1, Name: "Review complaint", DueDate: "2024-05-01", CaseItems: []sirius.Case{*pfa, *hw}}
+
+	client := &mockDonorDetailsClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(donor, nil)
+	client.
+		On("ComplaintsForDonor", mock.Anything, 123).
+		Return([]sirius.Complaint{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil)
+	client.
+		On("TasksForCase", mock.Anything, 4).
+		Return([]sirius.Task{shared, {ID: 2, Name: "Check fee", DueDate: "2024-06-01"}}, nil)
+	client.
+		On("TasksForCase", mock.Anything, 5).
+		Return([]sirius.Task{shared, {ID: 3, Name: "Chase certificate", DueDate: "2024-04-01"}}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, DonorDetailsData{
+			Donor:             donor,
+			CompensationTypes: demoCompensationTypes,
+			Tasks: []sirius.Task{
+				{ID: 3, Name: "Chase certificate", DueDate: "2024-04-01", CaseItems: []sirius.Case{*hw}},
+				shared,
+				{ID: 2, Name: "Check fee", DueDate: "2024-06-01", CaseItems: []sirius.Case{*pfa}},
+			},
+		}).
+		Return(nil)
+
+	server := newMockServer("/donor/{donorId}/details", DonorDetails(client, template.Func))
+
+	req, _ := http.NewRequest(http.MethodGet, "/donor/123/details", nil)
+	_, err := server.serve(req)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestDonorDetailsWhenTasksLookupFails(t *testing.T) {
+	donor := sirius.Person{ID: 123, Cases: []*sirius.Case{{ID: 4}}}
+
+	client := &mockDonorDetailsClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(donor, nil)
+	client.
+		On("ComplaintsForDonor", mock.Anything, 123).
+		Return([]sirius.Complaint{}, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil)
+	client.
+		On("TasksForCase", mock.Anything, 4).
+		Return([]sirius.Task{}, errExample)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, DonorDetailsData{
+			Donor:             donor,
+			CompensationTypes: demoCompensationTypes,
+			TasksFailed:       true,
+		}).
+		Return(nil)
+
+	server := newMockServer("/donor/{donorId}/details", DonorDetails(client, template.Func))
+
+	req, _ := http.NewRequest(http.MethodGet, "/donor/123/details", nil)
+	_, err := server.serve(req)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
@@ -3,6 +3,8 @@ package server
 import (
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"golang.org/x/sync/errgroup"
@@ -12,6 +14,7 @@ import (
 )
 
 type EditComplaintClient interface {
+	BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error)
 	Case(ctx sirius.Context, id int) (sirius.Case, error)
 	Complaint(ctx sirius.Context, id int) (sirius.Complaint, error)
 	EditComplaint(ctx sirius.Context, id int, complaint sirius.Complaint) error
@@ -29,9 +32,16 @@ type editComplaintData struct {
 	CompensationTypes     []sirius.RefDataItem
 
 	Complaint sirius.Complaint
+	SLA       sirius.ComplaintSLA
+
+	WithoutBankHolidays bool
 }
 
 func EditComplaint(client EditComplaintClient, tmpl template.Template) Handler {
+	return editComplaintWithNow(client, tmpl, time.Now)
+}
+
+func editComplaintWithNow(client EditComplaintClient, tmpl template.Template, now func() time.Time) Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		id, err := strToIntOrStatusError(r.FormValue("id"))
 		if err != nil {
@@ -90,6 +100,13 @@ func EditComplaint(client EditComplaintClient, tmpl template.Template) Handler {
 			return err
 		}
 
+		var bankHolidays sirius.BankHolidays
+		if data.Complaint.ReceivedDate != "" {
+			bankHolidays, data.WithoutBankHolidays = bankHolidaysOrWarn(ctx, client)
+		}
+
+		data.SLA = sirius.NewComplaintSLA(data.Complaint, now(), bankHolidays)
+
 		if r.Method == http.MethodPost {
 			complaint := sirius.Complaint{
 				Category:             postFormString(r, "category"),
@@ -108,8 +125,23 @@ func EditComplaint(client EditComplaintClient, tmpl template.Template) Handler {
 				ResolutionDate:       postFormDateString(r, "resolutionDate"),
 			}
 
-			if complaint.CompensationType != "NOT_APPLICABLE" {
+			if complaint.CompensationType != "" && complaint.CompensationType != "NOT_APPLICABLE" {
 				complaint.CompensationAmount = postFormString(r, fmt.Sprintf("compensationAmount%s", complaint.CompensationType))
+
+				amount, reason := parseCompensationAmount(complaint.CompensationAmount)
+				if reason != "" {
+					w.WriteHeader(http.StatusBadRequest)
+					data.Error = sirius.ValidationError{
+						Field: sirius.FieldErrors{
+							"compensationAmount": {"reason": reason},
+						},
+					}
+					data.Complaint = complaint
+
+					return tmpl(w, data)
+				}
+
+				complaint.CompensationAmount = amount.Decimal()
 			}
 
 			err = client.EditComplaint(ctx, id, complaint)
@@ -128,3 +160,22 @@ func EditComplaint(client EditComplaintClient, tmpl template.Template) Handler {
 		return tmpl(w, data)
 	}
 }
+
+// parseCompensationAmount reads the amount of compensation awarded, giving the
+// reason it is not valid
+func parseCompensationAmount(s string) (shared.Money, string) {
+	if strings.TrimSpace(s) == "" {
+		return 0, "Enter the compensation amount"
+	}
+
+	amount, err := shared.ParseMoney(s)
+	if err != nil {
+		return 0, "Enter the compensation amount in pounds and pence, for example 150.00"
+	}
+
+	if amount <= 0 {
+		return 0, "Compensation amount must be more than £0.00"
+	}
+
+	return amount, ""
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
@@ -27,6 +28,11 @@ func (m *mockEditComplaintClient) Complaint(ctx sirius.Context, id int) (sirius.
 	return args.Get(0).(sirius.Complaint), args.Error(1)
 }
 
+func (m *mockEditComplaintClient) BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(sirius.BankHolidays), args.Error(1)
+}
+
 func (m *mockEditComplaintClient) Case(ctx sirius.Context, id int) (sirius.Case, error) {
 	args := m.Called(ctx, id)
 	return args.Get(0).(sirius.Case), args.Error(1)
@@ -222,6 +228,9 @@ func TestPostEditComplaint(t *testing.T) {
 	client.
 		On("Complaint", mock.Anything, 123).
 		Return(complaint, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{}, nil)
 	client.
 		On("EditComplaint", mock.Anything, 123, complaint).
 		Return(nil)
@@ -231,6 +240,7 @@ func TestPostEditComplaint(t *testing.T) {
 		On("Func", mock.Anything, editComplaintData{
 			Success:               true,
 			Complaint:             complaint,
+			SLA:                   sirius.NewComplaintSLA(complaint, time.Now(), sirius.BankHolidays{}),
 			Categories:            demoComplaintCategories,
 			ComplainantCategories: demoComplainantCategories,
 			Origins:               demoComplaintOrigins,
@@ -265,6 +275,64 @@ func TestPostEditComplaint(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, client, template)
 }
 
+func TestPostEditComplaintWhenCompensationAmountInvalid(t *testing.T) {
+	complaint := sirius.Complaint{
+		Description:        "This is a complaint",
+		Severity:           shared.ComplaintSeverityNotRecognised,
+		CompensationType:   "COMPENSATORY",
+		CompensationAmount: "15O.00",
+	}
+
+	client := &mockEditComplaintClient{}
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.ComplainantCategory).
+		Return(demoComplainantCategories, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.ComplaintOrigin).
+		Return(demoComplaintOrigins, nil)
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.CompensationType).
+		Return(demoCompensationTypes, nil).
+		On("RefDataByCategory", mock.Anything, sirius.ComplaintCategory).
+		Return(demoComplaintCategories, nil)
+	client.
+		On("Complaint", mock.Anything, 123).
+		Return(sirius.Complaint{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, editComplaintData{
+			Error: sirius.ValidationError{
+				Field: sirius.FieldErrors{
+					"compensationAmount": {"reason": "Enter the compensation amount in pounds and pence, for example 150.00"},
+				},
+			},
+			Complaint:             complaint,
+			Categories:            demoComplaintCategories,
+			ComplainantCategories: demoComplainantCategories,
+			Origins:               demoComplaintOrigins,
+			CompensationTypes:     demoCompensationTypes,
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"description":                    {"This is a complaint"},
+		"compensationType":               {"COMPENSATORY"},
+		"compensationAmountCOMPENSATORY": {"15O.00"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := EditComplaint(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
 func TestPostEditComplaintWhenEditComplaintValidationError(t *testing.T) {
 	expectedError := sirius.ValidationError{
 		Field: sirius.FieldErrors{"field": {"": "problem"}},
@@ -355,3 +423,136 @@ func TestPostEditComplaintWhenEditComplaintOtherError(t *testing.T) {
 	assert.Equal(t, errExample, err)
 	mock.AssertExpectationsForObjects(t, client)
 }
+
+func TestGetEditComplaintShowsResponseTarget(t *testing.T) {
+	complaint := sirius.Complaint{
+		Category:     "01",
+		Severity:     shared.ComplaintSeverityMinor,
+		ReceivedDate: sirius.DateString("2024-05-01"),
+	}
+
+	client := &mockEditComplaintClient{}
+	client.
+		On("RefDataByCategory", mock.Anything, mock.Anything).
+		Return([]sirius.RefDataItem{}, nil)
+	client.
+		On("Complaint", mock.Anything, 123).
+		Return(complaint, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{
+			"england-and-wales": {"Early May bank holiday": "2024-05-06T00:00:00+01:00"},
+		}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data editComplaintData) bool {
+			return assert.Equal(t, sirius.ComplaintSLA{
+				TargetWorkingDays:  10,
+				DueDate:            sirius.DateString("2024-05-16"),
+				WorkingDaysOverdue: 2,
+			}, data.SLA)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123", nil)
+	w := httptest.NewRecorder()
+
+	today := time.Date(2024, time.May, 20, 9, 0, 0, 0, time.UTC)
+	err := editComplaintWithNow(client, template.Func, func() time.Time { return today })(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetEditComplaintSLAWhenBankHolidaysError(t *testing.T) {
+	complaint := sirius.Complaint{
+		Severity:     shared.ComplaintSeverityMinor,
+		ReceivedDate: sirius.DateString("2024-05-01"),
+	}
+
+	client := &mockEditComplaintClient{}
+	client.
+		On("RefDataByCategory", mock.Anything, mock.Anything).
+		Return([]sirius.RefDataItem{}, nil)
+	client.
+		On("Complaint", mock.Anything, 123).
+		Return(complaint, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays(nil), errExample)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data editComplaintData) bool {
+			return assert.Equal(t, sirius.DateString("2024-05-15"), data.SLA.DueDate) &&
+				assert.True(t, data.WithoutBankHolidays)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123", nil)
+	w := httptest.NewRecorder()
+
+	today := time.Date(2024, time.May, 20, 9, 0, 0, 0, time.UTC)
+	err := editComplaintWithNow(client, template.Func, func() time.Time { return today })(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestParseCompensationAmount(t *testing.T) {
+	testCases := map[string]struct {
+		input  string
+		amount shared.Money
+		reason string
+	}{
+		"empty":    {input: " ", reason: "Enter the compensation amount"},
+		"invalid":  {input: "15O.00", reason: "Enter the compensation amount in pounds and pence, for example 150.00"},
+		"negative": {input: "-5.00", reason: "Enter the compensation amount in pounds and pence, for example 150.00"},
+		"zero":     {input: "0.00", reason: "Compensation amount must be more than £0.00"},
+		"valid":    {input: "£1,250.5", amount: 125050},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			amount, reason := parseCompensationAmount(tc.input)
+			assert.Equal(t, tc.amount, amount)
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func TestPostEditComplaintWhenCompensationAmountMissing(t *testing.T) {
+	client := &mockEditComplaintClient{}
+	client.
+		On("RefDataByCategory", mock.Anything, mock.Anything).
+		Return([]sirius.RefDataItem{}, nil)
+	client.
+		On("Complaint", mock.Anything, 123).
+		Return(sirius.Complaint{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data editComplaintData) bool {
+			return assert.Equal(t, sirius.FieldErrors{
+				"compensationAmount": {"reason": "Enter the compensation amount"},
+			}, data.Error.Field)
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"description":      {"This is a complaint"},
+		"compensationType": {"COMPENSATORY"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := EditComplaint(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
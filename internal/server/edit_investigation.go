@@ -2,6 +2,7 @@ package server
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
@@ -18,11 +19,16 @@ type editInvestigationData struct {
 	Error                sirius.ValidationError
 	Investigation        sirius.Investigation
 	ApprovalOutcomeTypes []string
+	Timeline             []sirius.InvestigationTimelineEvent
 }
 
 var approvalOutcomeTypes = []string{"Court Application", "Further Action", "No Further Action", "Instrument no longer Valid"}
 
 func EditInvestigation(client EditInvestigationClient, tmpl template.Template) Handler {
+	return editInvestigationWithNow(client, tmpl, time.Now)
+}
+
+func editInvestigationWithNow(client EditInvestigationClient, tmpl template.Template, now func() time.Time) Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		investigationID, err := strToIntOrStatusError(r.FormValue("id"))
 		if err != nil {
@@ -48,7 +54,11 @@ func EditInvestigation(client EditInvestigationClient, tmpl template.Template) H
 				InvestigationClosureDate: postFormDateString(r, "investigationClosureDate"),
 			}
 
-			err = client.EditInvestigation(ctx, investigationID, investigation)
+			if fieldErrors := investigation.ValidateStages(now()); len(fieldErrors) > 0 {
+				err = sirius.ValidationError{Field: fieldErrors}
+			} else {
+				err = client.EditInvestigation(ctx, investigationID, investigation)
+			}
 
 			if ve, ok := err.(sirius.ValidationError); ok {
 				w.WriteHeader(http.StatusBadRequest)
@@ -65,7 +75,12 @@ func EditInvestigation(client EditInvestigationClient, tmpl template.Template) H
 		if err != nil {
 			return err
 		}
-		data.Investigation = investigation
+		data.Timeline = investigation.Timeline()
+
+		// keep what was entered so it can be corrected
+		if !data.Error.Any() {
+			data.Investigation = investigation
+		}
 
 		return tmpl(w, data)
 	}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"github.com/stretchr/testify/assert"
@@ -127,6 +128,7 @@ func TestPostEditInvestigation(t *testing.T) {
 			Success:              true,
 			ApprovalOutcomeTypes: approvalOutcomeTypes,
 			Investigation:        investigation,
+			Timeline:             investigation.Timeline(),
 		}).
 		Return(nil)
 
@@ -215,3 +217,59 @@ func TestPostEditInvestigationWhenEditInvestigationOtherError(t *testing.T) {
 	assert.Equal(t, errExample, err)
 	mock.AssertExpectationsForObjects(t, client)
 }
+
+func TestPostEditInvestigationWhenStagesOutOfOrder(t *testing.T) {
+	saved := sirius.Investigation{
+		ID:           123,
+		Title:        "Test Investigation",
+		DateReceived: sirius.DateString("2024-02-01"),
+		HoldPeriods: []sirius.HoldPeriod{
+			{ID: 1, Reason: "Police Investigation", StartDate: sirius.DateString("2024-02-05")},
+		},
+	}
+
+	client := &mockEditInvestigationClient{}
+	client.
+		On("Investigation", mock.Anything, 123).
+		Return(saved, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, editInvestigationData{
+			Error: sirius.ValidationError{
+				Field: sirius.FieldErrors{
+					"riskAssessmentDate": {"dateOutOfOrder": "Date of risk assessment must be on or after the date received"},
+					"reportApprovalDate": {"dateInFuture": "Approval date must not be in the future"},
+				},
+			},
+			Investigation: sirius.Investigation{
+				Title:              "Test Investigation",
+				DateReceived:       sirius.DateString("2024-02-01"),
+				RiskAssessmentDate: sirius.DateString("2024-01-31"),
+				ApprovalDate:       sirius.DateString("2024-06-01"),
+			},
+			ApprovalOutcomeTypes: approvalOutcomeTypes,
+			Timeline:             saved.Timeline(),
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"title":              {"Test Investigation"},
+		"dateReceived":       {"2024-02-01"},
+		"riskAssessmentDate": {"2024-01-31"},
+		"approvalDate":       {"2024-06-01"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	today := time.Date(2024, time.May, 31, 9, 0, 0, 0, time.UTC)
+	err := editInvestigationWithNow(client, template.Func, func() time.Time { return today })(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+	client.AssertNotCalled(t, "EditInvestigation", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -3,11 +3,11 @@ package server
 import (
 	"fmt"
 	"net/http"
-	"strconv"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 )
 
@@ -52,7 +52,7 @@ func EditPayment(client EditPaymentClient, tmpl template.Template, partialTmpl t
 			}
 
 			data.PaymentID = paymentID
-			data.Amount = fmt.Sprintf("%.2f", sirius.PenceToPounds(p.Amount))
+			data.Amount = p.Amount.Decimal()
 			data.Source = p.Source
 			data.PaymentDate = p.PaymentDate
 
@@ -86,11 +86,12 @@ func EditPayment(client EditPaymentClient, tmpl template.Template, partialTmpl t
 			data.Source = postFormString(r, "source")
 			data.PaymentDate = postFormDateString(r, "paymentDate")
 
-			if !sirius.IsAmountValid(data.Amount) {
+			amount, err := shared.ParseMoney(data.Amount)
+			if err != nil {
 				w.WriteHeader(http.StatusBadRequest)
 				data.Error = sirius.ValidationError{
 					Field: sirius.FieldErrors{
-						"amount": {"reason": "Please enter the amount to 2 decimal places"},
+						"amount": {"reason": "Enter the amount in pounds and pence, for example 82.00"},
 					},
 				}
 				if data.Source == "" {
@@ -110,15 +111,8 @@ func EditPayment(client EditPaymentClient, tmpl template.Template, partialTmpl t
 				return tmpl(w, data)
 			}
 
-			amountFloat, err := strconv.ParseFloat(data.Amount, 64)
-			if err != nil {
-				return err
-			}
-
-			amountInPence := sirius.PoundsToPence(amountFloat)
-
 			paymentEdit := sirius.Payment{
-				Amount:      amountInPence,
+				Amount:      amount,
 				Source:      data.Source,
 				PaymentDate: data.PaymentDate,
 			}
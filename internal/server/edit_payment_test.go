@@ -244,7 +244,7 @@ func TestEditPaymentWhenTemplateErrors(t *testing.T) {
 }
 
 func TestPostEditPaymentAmountIncorrectFormat(t *testing.T) {
-	for _, amount := range []string{"41", "41.5", "41.555", ".45"} {
+	for _, amount := range []string{"41.555", "abc", "-41.00", "4,10.00"} {
 		t.Run(amount, func(t *testing.T) {
 			caseItem := sirius.Case{CaseType: "lpa", UID: "700700"}
 
@@ -277,7 +277,7 @@ func TestPostEditPaymentAmountIncorrectFormat(t *testing.T) {
 
 			validationError := sirius.ValidationError{
 				Field: sirius.FieldErrors{
-					"amount": {"reason": "Please enter the amount to 2 decimal places"},
+					"amount": {"reason": "Enter the amount in pounds and pence, for example 82.00"},
 				},
 			}
 
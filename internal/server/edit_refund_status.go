@@ -0,0 +1,105 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+type EditRefundStatusClient interface {
+	EditRefundStatus(ctx sirius.Context, paymentID int, status shared.RefundStatus) error
+	PaymentByID(ctx sirius.Context, id int) (sirius.Payment, error)
+	Case(sirius.Context, int) (sirius.Case, error)
+}
+
+type editRefundStatusData struct {
+	XSRFToken string
+	Error     sirius.ValidationError
+
+	Case         sirius.Case
+	Refund       sirius.Payment
+	NextStatus   shared.RefundStatus
+	IsPartial    bool
+	ReturnUrl    string
+	HtmxRedirect string
+}
+
+func EditRefundStatus(client EditRefundStatusClient, tmpl template.Template) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		paymentID, err := strToIntOrStatusError(r.FormValue("id"))
+		if err != nil {
+			return err
+		}
+
+		ctx := getContext(r)
+		data := editRefundStatusData{
+			XSRFToken: ctx.XSRFToken,
+			IsPartial: r.Header.Get("HX-Request") == "true",
+		}
+
+		data.Refund, err = client.PaymentByID(ctx, paymentID)
+		if err != nil {
+			return err
+		}
+
+		if data.Refund.Amount >= 0 || data.Refund.Case == nil {
+			return sirius.StatusError{Code: http.StatusNotFound}
+		}
+
+		data.Case, err = client.Case(ctx, data.Refund.Case.ID)
+		if err != nil {
+			return err
+		}
+
+		if data.Case.CaseType == "DIGITAL_LPA" {
+			data.ReturnUrl = fmt.Sprintf("/lpa/%s/payments", data.Case.UID)
+		} else {
+			data.ReturnUrl = fmt.Sprintf("/payments/%d", data.Case.ID)
+		}
+
+		next, ok := data.Refund.RefundStatus.Next()
+		if !ok {
+			return RedirectError(data.ReturnUrl)
+		}
+		data.NextStatus = next
+
+		if r.Method == http.MethodPost {
+			if shared.RefundStatus(postFormString(r, "status")) != next {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = sirius.ValidationError{
+					Field: sirius.FieldErrors{
+						"status": {"reason": fmt.Sprintf("This refund can only be marked as %s", next.Translation())},
+					},
+				}
+
+				return tmpl(w, data)
+			}
+
+			err = client.EditRefundStatus(ctx, paymentID, next)
+			if ve, ok := err.(sirius.ValidationError); ok {
+				w.WriteHeader(http.StatusBadRequest)
+				data.Error = ve
+
+				return tmpl(w, data)
+			} else if err != nil {
+				return err
+			}
+
+			SetFlash(w, FlashNotification{
+				Title: fmt.Sprintf("Refund marked as %s", next.Translation()),
+			})
+
+			if data.IsPartial {
+				data.HtmxRedirect = data.ReturnUrl
+				return tmpl(w, data)
+			}
+
+			return RedirectError(data.ReturnUrl)
+		}
+
+		return tmpl(w, data)
+	}
+}
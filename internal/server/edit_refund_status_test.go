@@ -0,0 +1,225 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockEditRefundStatusClient struct {
+	mock.Mock
+}
+
+func (m *mockEditRefundStatusClient) EditRefundStatus(ctx sirius.Context, paymentID int, status shared.RefundStatus) error {
+	return m.Called(ctx, paymentID, status).Error(0)
+}
+
+func (m *mockEditRefundStatusClient) PaymentByID(ctx sirius.Context, id int) (sirius.Payment, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(sirius.Payment), args.Error(1)
+}
+
+func (m *mockEditRefundStatusClient) Case(ctx sirius.Context, id int) (sirius.Case, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(sirius.Case), args.Error(1)
+}
+
+func TestGetEditRefundStatus(t *testing.T) {
+	refund := sirius.Payment{ID: 124, Amount: -1000, RefundStatus: shared.RefundStatusRequested, Case: &sirius.Case{ID: 4}}
+	caseItem := sirius.Case{ID: 4, UID: "M-1234-5678-9012", CaseType: "DIGITAL_LPA"}
+
+	client := &mockEditRefundStatusClient{}
+	client.
+		On("PaymentByID", mock.Anything, 124).
+		Return(refund, nil)
+	client.
+		On("Case", mock.Anything, 4).
+		Return(caseItem, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, editRefundStatusData{
+			Case:       caseItem,
+			Refund:     refund,
+			NextStatus: shared.RefundStatusApproved,
+			ReturnUrl:  "/lpa/M-1234-5678-9012/payments",
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=124", nil)
+	w := httptest.NewRecorder()
+
+	err := EditRefundStatus(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestEditRefundStatusNoID(t *testing.T) {
+	testCases := map[string]string{
+		"no-id":  "/",
+		"bad-id": "/?id=test",
+	}
+
+	for name, testUrl := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodGet, testUrl, nil)
+			w := httptest.NewRecorder()
+
+			err := EditRefundStatus(nil, nil)(w, r)
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestEditRefundStatusWhenNotARefund(t *testing.T) {
+	client := &mockEditRefundStatusClient{}
+	client.
+		On("PaymentByID", mock.Anything, 124).
+		Return(sirius.Payment{ID: 124, Amount: 4100, Case: &sirius.Case{ID: 4}}, nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=124", nil)
+	w := httptest.NewRecorder()
+
+	err := EditRefundStatus(client, nil)(w, r)
+
+	assert.Equal(t, sirius.StatusError{Code: http.StatusNotFound}, err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestEditRefundStatusWhenIssued(t *testing.T) {
+	client := &mockEditRefundStatusClient{}
+	client.
+		On("PaymentByID", mock.Anything, 124).
+		Return(sirius.Payment{ID: 124, Amount: -1000, RefundStatus: shared.RefundStatusIssued, Case: &sirius.Case{ID: 4}}, nil)
+	client.
+		On("Case", mock.Anything, 4).
+		Return(sirius.Case{ID: 4}, nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=124", nil)
+	w := httptest.NewRecorder()
+
+	err := EditRefundStatus(client, nil)(w, r)
+
+	assert.Equal(t, RedirectError("/payments/4"), err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestEditRefundStatusWhenPaymentErrors(t *testing.T) {
+	client := &mockEditRefundStatusClient{}
+	client.
+		On("PaymentByID", mock.Anything, 124).
+		Return(sirius.Payment{}, errExample)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=124", nil)
+	w := httptest.NewRecorder()
+
+	err := EditRefundStatus(client, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostEditRefundStatus(t *testing.T) {
+	client := &mockEditRefundStatusClient{}
+	client.
+		On("PaymentByID", mock.Anything, 124).
+		Return(sirius.Payment{ID: 124, Amount: -1000, RefundStatus: shared.RefundStatusApproved, Case: &sirius.Case{ID: 4}}, nil)
+	client.
+		On("Case", mock.Anything, 4).
+		Return(sirius.Case{ID: 4}, nil)
+	client.
+		On("EditRefundStatus", mock.Anything, 124, shared.RefundStatusIssued).
+		Return(nil)
+
+	form := url.Values{
+		"status": {"ISSUED"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=124", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := EditRefundStatus(client, nil)(w, r)
+
+	assert.Equal(t, RedirectError("/payments/4"), err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostEditRefundStatusWhenStatusSkipped(t *testing.T) {
+	refund := sirius.Payment{ID: 124, Amount: -1000, RefundStatus: shared.RefundStatusRequested, Case: &sirius.Case{ID: 4}}
+
+	client := &mockEditRefundStatusClient{}
+	client.
+		On("PaymentByID", mock.Anything, 124).
+		Return(refund, nil)
+	client.
+		On("Case", mock.Anything, 4).
+		Return(sirius.Case{ID: 4}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, editRefundStatusData{
+			Case:       sirius.Case{ID: 4},
+			Refund:     refund,
+			NextStatus: shared.RefundStatusApproved,
+			ReturnUrl:  "/payments/4",
+			Error: sirius.ValidationError{
+				Field: sirius.FieldErrors{
+					"status": {"reason": "This refund can only be marked as Approved"},
+				},
+			},
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"status": {"ISSUED"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=124", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := EditRefundStatus(client, template.Func)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostEditRefundStatusWhenOtherError(t *testing.T) {
+	client := &mockEditRefundStatusClient{}
+	client.
+		On("PaymentByID", mock.Anything, 124).
+		Return(sirius.Payment{ID: 124, Amount: -1000, RefundStatus: shared.RefundStatusRequested, Case: &sirius.Case{ID: 4}}, nil)
+	client.
+		On("Case", mock.Anything, 4).
+		Return(sirius.Case{ID: 4}, nil)
+	client.
+		On("EditRefundStatus", mock.Anything, 124, shared.RefundStatusApproved).
+		Return(errExample)
+
+	form := url.Values{
+		"status": {"APPROVED"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=124", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := EditRefundStatus(client, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+	mock.AssertExpectationsForObjects(t, client)
+}
@@ -2,28 +2,89 @@ package server
 
 import (
 	"bytes"
-	"encoding/base64"
+	"errors"
 	"fmt"
+	"html"
 	"io"
+	"mime"
 	"mime/multipart"
 	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/ministryofjustice/opg-go-common/telemetry"
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"golang.org/x/sync/errgroup"
 )
 
 const Megabyte = 1024 * 1024
 
+const (
+	// noteFilesMaxSize is the most that can be attached to a single note
+	noteFilesMaxSize = 32 * Megabyte
+
+	// noteFormMaxSize allows for the rest of the form alongside the files
+	noteFormMaxSize = noteFilesMaxSize + Megabyte
+
+	// noteFormMaxMemory is how much of the form is held in memory, the rest of
+	// the files are kept on disk until they are sent
+	noteFormMaxMemory = Megabyte
+)
+
+var noteFilesTooLarge = fmt.Sprintf("The selected files must be %dMB or smaller in total", noteFilesMaxSize/Megabyte)
+
+// noteFileTypes are the types of file that can be attached to a note, as
+// sniffed from their contents rather than trusting the browser
+var noteFileTypes = map[string]bool{
+	"application/msword":         true,
+	"application/pdf":            true,
+	"application/rtf":            true,
+	"application/vnd.ms-excel":   true,
+	"application/vnd.ms-outlook": true,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":       true,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": true,
+	"image/gif":      true,
+	"image/jpeg":     true,
+	"image/png":      true,
+	"message/rfc822": true,
+	"text/plain":     true,
+}
+
+// noteFileContainers are formats shared by several types of file, so the
+// extension decides which type a file is once its contents have been checked
+var noteFileContainers = map[string]map[string]string{
+	"application/x-ole-storage": {
+		".doc": "application/msword",
+		".xls": "application/vnd.ms-excel",
+		".msg": "application/vnd.ms-outlook",
+	},
+	"application/zip": {
+		".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	},
+	"text/plain": {
+		".eml": "message/rfc822",
+		".rtf": "application/rtf",
+	},
+}
+
+// oleSignature starts older Word, Excel and Outlook files
+var oleSignature = []byte{0xd0, 0xcf, 0x11, 0xe0, 0xa1, 0xb1, 0x1a, 0xe1}
+
 type EventClient interface {
+	NoteTemplates(ctx sirius.Context) ([]sirius.NoteTemplate, error)
 	NoteTypes(ctx sirius.Context) ([]string, error)
-	CreateNote(ctx sirius.Context, entityID int, entityType sirius.EntityType, noteType, name, description string, file *sirius.NoteFile) error
+	CreateNote(ctx sirius.Context, entityID int, entityType sirius.EntityType, noteType, name, description string, files []sirius.NoteFile) error
 	Person(ctx sirius.Context, id int) (sirius.Person, error)
 	Case(ctx sirius.Context, id int) (sirius.Case, error)
 }
 
 type eventData struct {
 	XSRFToken    string
+	IsPartial    bool
 	NoteTypes    []string
 	Entity       string
 	IsDigitalLpa bool
@@ -37,10 +98,20 @@ type eventData struct {
 	DonorId     int
 	EntityType  string
 	CaseUids    string
+	Templates   []sirius.NoteTemplate
+	Template    sirius.NoteTemplate
+	Fields      map[string]string
 }
 
 func Event(client EventClient, tmpl template.Template, partialTmpl template.Template) Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
+		// the size of an upload is limited before any of it is read
+		var parseErr error
+		if r.Method == http.MethodPost {
+			r.Body = http.MaxBytesReader(w, r.Body, noteFormMaxSize)
+			parseErr = r.ParseMultipartForm(noteFormMaxMemory)
+		}
+
 		entityID, err := strToIntOrStatusError(r.FormValue("id"))
 		if err != nil {
 			return err
@@ -52,7 +123,10 @@ func Event(client EventClient, tmpl template.Template, partialTmpl template.Temp
 		}
 
 		ctx := getContext(r)
-		data := eventData{XSRFToken: ctx.XSRFToken}
+		data := eventData{
+			XSRFToken: ctx.XSRFToken,
+			IsPartial: r.Header.Get("HX-Request") == "true",
+		}
 
 		group, groupCtx := errgroup.WithContext(ctx.Context)
 
@@ -66,14 +140,22 @@ func Event(client EventClient, tmpl template.Template, partialTmpl template.Temp
 			return nil
 		})
 
+		group.Go(func() error {
+			templates, err := client.NoteTemplates(ctx.With(groupCtx))
+			if err != nil {
+				telemetry.LoggerFromContext(ctx.Context).Warn("note templates lookup failed", "error", err)
+				return nil
+			}
+
+			data.Templates = templates
+			return nil
+		})
+
 		data.CaseUID = ""
 		data.IsDigitalLpa = false
 		data.DonorId = entityID
 		data.CaseUids = buildUIDQueryString(r.Form["uid[]"])
-
-		if r.Header.Get("HX-Request") == "true" {
-			data.EntityType = string(entityType)
-		}
+		data.EntityType = string(entityType)
 
 		group.Go(func() error {
 			switch entityType {
@@ -100,22 +182,58 @@ func Event(client EventClient, tmpl template.Template, partialTmpl template.Temp
 			return err
 		}
 
-		if r.Method == http.MethodPost {
-			if err := r.ParseMultipartForm(64 * Megabyte); err != nil { //#nosec G120 -- This value is high to allow large file uploads
-				return err
+		if templateID, err := strconv.Atoi(r.FormValue("template")); err == nil {
+			if template, ok := sirius.FindNoteTemplate(data.Templates, templateID); ok {
+				data.Template = template
 			}
+		}
 
+		if r.Method != http.MethodPost && data.Template.ID != 0 {
+			data.Type = data.Template.NoteType
+			data.Name = data.Template.Subject
+			data.Fields = map[string]string{}
+			for _, field := range data.Template.Fields {
+				data.Fields[field.Name] = field.Value
+			}
+		}
+
+		if r.Method == http.MethodPost {
 			var (
 				noteType    = postFormString(r, "type")
 				name        = postFormString(r, "name")
-				description = postFormString(r, "description")
-				file, err   = findNoteFile(r.MultipartForm, "file")
+				description = shared.SanitiseRichText(postFormString(r, "description"))
+				files       []sirius.NoteFile
+				fileErrors  map[string]string
+				maxBytesErr *http.MaxBytesError
 			)
-			if err != nil {
-				return err
+
+			if errors.As(parseErr, &maxBytesErr) {
+				fileErrors = map[string]string{"size": noteFilesTooLarge}
+			} else if parseErr != nil {
+				return parseErr
+			} else {
+				files, fileErrors, err = findNoteFiles(r.MultipartForm, "file")
+				if err != nil {
+					return err
+				}
 			}
 
-			err = client.CreateNote(ctx, entityID, entityType, noteType, name, description, file)
+			var fields map[string]string
+			noteDescription := description
+			if data.Template.ID != 0 {
+				fields = map[string]string{}
+				for _, field := range data.Template.Fields {
+					fields[field.Name] = postFormString(r, "field-"+field.Name)
+				}
+
+				noteDescription = shared.SanitiseRichText(noteTemplateDescription(data.Template, fields) + description)
+			}
+
+			if len(fileErrors) > 0 {
+				err = sirius.ValidationError{Field: sirius.FieldErrors{"file": fileErrors}}
+			} else {
+				err = client.CreateNote(ctx, entityID, entityType, noteType, name, noteDescription, files)
+			}
 
 			if ve, ok := err.(sirius.ValidationError); ok {
 				w.WriteHeader(http.StatusBadRequest)
@@ -123,6 +241,7 @@ func Event(client EventClient, tmpl template.Template, partialTmpl template.Temp
 				data.Type = noteType
 				data.Name = name
 				data.Description = description
+				data.Fields = fields
 			} else if err != nil {
 				return err
 			} else {
@@ -146,32 +265,96 @@ func Event(client EventClient, tmpl template.Template, partialTmpl template.Temp
 	}
 }
 
-func findNoteFile(form *multipart.Form, key string) (*sirius.NoteFile, error) {
-	files := form.File["file"]
-	if len(files) != 1 {
-		return nil, nil
+// noteTemplateDescription writes the answers to a note template's fields at the
+// start of the note, leaving out any that were not filled in
+func noteTemplateDescription(template sirius.NoteTemplate, fields map[string]string) string {
+	var sb strings.Builder
+
+	for _, field := range template.Fields {
+		value := strings.TrimSpace(strings.ReplaceAll(fields[field.Name], "\r\n", "\n"))
+		if value == "" {
+			continue
+		}
+
+		lines := strings.Split(value, "\n")
+		for i, line := range lines {
+			lines[i] = html.EscapeString(line)
+		}
+
+		fmt.Fprintf(&sb, "<p><strong>%s:</strong> %s</p>", html.EscapeString(field.Label), strings.Join(lines, "<br>"))
 	}
 
-	f, err := files[0].Open()
+	return sb.String()
+}
+
+// findNoteFiles checks the files attached to a note. Any that cannot be sent
+// are left out and given a reason, keyed by their position in the form.
+func findNoteFiles(form *multipart.Form, key string) ([]sirius.NoteFile, map[string]string, error) {
+	var (
+		files   []sirius.NoteFile
+		reasons = map[string]string{}
+		size    int64
+	)
+
+	for i, header := range form.File[key] {
+		size += header.Size
+
+		if header.Size == 0 {
+			reasons[strconv.Itoa(i)] = fmt.Sprintf("%s is empty", header.Filename)
+			continue
+		}
+
+		fileType, err := sniffNoteFile(header)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !noteFileTypes[fileType] {
+			reasons[strconv.Itoa(i)] = fmt.Sprintf("%s must be a PDF, Word, Excel, email, image or text file", header.Filename)
+			continue
+		}
+
+		files = append(files, sirius.NoteFile{
+			Name: header.Filename,
+			Type: fileType,
+			Open: func() (io.ReadCloser, error) { return header.Open() },
+		})
+	}
+
+	if size > noteFilesMaxSize {
+		reasons["size"] = noteFilesTooLarge
+	}
+
+	return files, reasons, nil
+}
+
+// sniffNoteFile finds the type of a file from the start of its contents
+func sniffNoteFile(header *multipart.FileHeader) (string, error) {
+	f, err := header.Open()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer f.Close() //nolint:errcheck // no need to check error when closing body
+	defer f.Close() //nolint:errcheck // no need to check error when closing file
 
-	var buf bytes.Buffer
-	enc := base64.NewEncoder(base64.StdEncoding, &buf)
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
 
-	if _, err := io.Copy(enc, f); err != nil {
-		return nil, err
+	mediaType := "application/x-ole-storage"
+	if !bytes.HasPrefix(buf[:n], oleSignature) {
+		mediaType, _, err = mime.ParseMediaType(http.DetectContentType(buf[:n]))
+		if err != nil {
+			return "", err
+		}
 	}
 
-	if err := enc.Close(); err != nil {
-		return nil, err
+	if types, ok := noteFileContainers[mediaType]; ok {
+		if fileType, ok := types[strings.ToLower(filepath.Ext(header.Filename))]; ok {
+			return fileType, nil
+		}
 	}
 
-	return &sirius.NoteFile{
-		Name:   files[0].Filename,
-		Type:   files[0].Header.Get("Content-Type"),
-		Source: buf.String(),
-	}, nil
+	return mediaType, nil
 }
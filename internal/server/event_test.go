@@ -2,6 +2,7 @@ package server
 
 import (
 	"bytes"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
@@ -16,11 +17,16 @@ type mockEventClient struct {
 	mock.Mock
 }
 
-func (m *mockEventClient) CreateNote(ctx sirius.Context, entityID int, entityType sirius.EntityType, noteType, name, description string, file *sirius.NoteFile) error {
-	args := m.Called(ctx, entityID, entityType, noteType, name, description, file)
+func (m *mockEventClient) CreateNote(ctx sirius.Context, entityID int, entityType sirius.EntityType, noteType, name, description string, files []sirius.NoteFile) error {
+	args := m.Called(ctx, entityID, entityType, noteType, name, description, files)
 	return args.Error(0)
 }
 
+func (m *mockEventClient) NoteTemplates(ctx sirius.Context) ([]sirius.NoteTemplate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]sirius.NoteTemplate), args.Error(1)
+}
+
 func (m *mockEventClient) NoteTypes(ctx sirius.Context) ([]string, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]string), args.Error(1)
@@ -80,15 +86,20 @@ func TestGetEvent(t *testing.T) {
 			client.
 				On("NoteTypes", mock.Anything).
 				Return([]string{"a", "b"}, nil)
+			client.
+				On("NoteTemplates", mock.Anything).
+				Return([]sirius.NoteTemplate(nil), nil).
+				Maybe()
 			tc.clientSetup(client)
 
 			template := &mockTemplate{}
 			template.
 				On("Func", mock.Anything, eventData{
-					NoteTypes: []string{"a", "b"},
-					Entity:    tc.expectedEntity,
-					CaseUID:   tc.expectedCaseUID,
-					DonorId:   123,
+					NoteTypes:  []string{"a", "b"},
+					Entity:     tc.expectedEntity,
+					CaseUID:    tc.expectedCaseUID,
+					DonorId:    123,
+					EntityType: name,
 				}).
 				Return(nil)
 
@@ -117,6 +128,10 @@ func TestGetEventBadQueryString(t *testing.T) {
 			client.
 				On("NoteTypes", mock.Anything).
 				Return([]string{"a", "b"}, nil)
+			client.
+				On("NoteTemplates", mock.Anything).
+				Return([]sirius.NoteTemplate(nil), nil).
+				Maybe()
 
 			template := &mockTemplate{}
 			template.
@@ -140,6 +155,10 @@ func TestGetEventWhenNoteTypeErrors(t *testing.T) {
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{}, errExample)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Person", mock.Anything, 123).
 		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
@@ -157,6 +176,10 @@ func TestGetEventWhenTemplateErrors(t *testing.T) {
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Person", mock.Anything, 123).
 		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
@@ -179,20 +202,25 @@ func TestPostEvent(t *testing.T) {
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Person", mock.Anything, 123).
 		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
 	client.
-		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words", (*sirius.NoteFile)(nil)).
+		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words", []sirius.NoteFile(nil)).
 		Return(nil)
 
 	template := &mockTemplate{}
 	template.
 		On("Func", mock.Anything, eventData{
-			Success:   true,
-			NoteTypes: []string{"a", "b"},
-			Entity:    "John Doe",
-			DonorId:   123,
+			Success:    true,
+			NoteTypes:  []string{"a", "b"},
+			Entity:     "John Doe",
+			DonorId:    123,
+			EntityType: "person",
 		}).
 		Return(nil)
 
@@ -214,26 +242,35 @@ func TestPostEvent(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
-func TestPostEventWithFile(t *testing.T) {
+func TestPostEventWithFiles(t *testing.T) {
 	client := &mockEventClient{}
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Person", mock.Anything, 123).
 		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
 	client.
 		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words",
-			&sirius.NoteFile{Name: "test.txt", Type: "application/octet-stream", Source: "SGV5"}).
+			mock.MatchedBy(func(files []sirius.NoteFile) bool {
+				return len(files) == 2 &&
+					files[0].Name == "test.txt" && files[0].Type == "text/plain" && readNoteFile(files[0]) == "Hey" &&
+					files[1].Name == "letter.pdf" && files[1].Type == "application/pdf" && readNoteFile(files[1]) == "%PDF-1.4"
+			})).
 		Return(nil)
 
 	template := &mockTemplate{}
 	template.
 		On("Func", mock.Anything, eventData{
-			Success:   true,
-			NoteTypes: []string{"a", "b"},
-			Entity:    "John Doe",
-			DonorId:   123,
+			Success:    true,
+			NoteTypes:  []string{"a", "b"},
+			Entity:     "John Doe",
+			DonorId:    123,
+			EntityType: "person",
 		}).
 		Return(nil)
 
@@ -244,6 +281,8 @@ func TestPostEventWithFile(t *testing.T) {
 	_ = form.WriteField("description", "More words")
 	part, _ := form.CreateFormFile("file", "test.txt")
 	_, _ = part.Write([]byte("Hey"))
+	part, _ = form.CreateFormFile("file", "letter.pdf")
+	_, _ = part.Write([]byte("%PDF-1.4"))
 	_ = form.Close()
 
 	r, _ := http.NewRequest(http.MethodPost, "/?id=123&entity=person", &buf)
@@ -255,6 +294,217 @@ func TestPostEventWithFile(t *testing.T) {
 
 	assert.Nil(t, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostEventWithInvalidFiles(t *testing.T) {
+	testCases := map[string]struct {
+		files  map[string][]byte
+		errors map[string]string
+	}{
+		"type": {
+			files: map[string][]byte{
+				"test.txt":    []byte("Hey"),
+				"program.exe": {'M', 'Z', 0x90, 0x00},
+			},
+			errors: map[string]string{"1": "program.exe must be a PDF, Word, Excel, email, image or text file"},
+		},
+		"empty": {
+			files: map[string][]byte{
+				"test.txt":  []byte("Hey"),
+				"empty.pdf": {},
+			},
+			errors: map[string]string{"1": "empty.pdf is empty"},
+		},
+		"total size": {
+			files: map[string][]byte{
+				"test.txt":  []byte("Hey"),
+				"large.txt": bytes.Repeat([]byte("a"), noteFilesMaxSize),
+			},
+			errors: map[string]string{"size": "The selected files must be 32MB or smaller in total"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockEventClient{}
+			client.
+				On("NoteTypes", mock.Anything).
+				Return([]string{"a", "b"}, nil)
+			client.
+				On("NoteTemplates", mock.Anything).
+				Return([]sirius.NoteTemplate(nil), nil).
+				Maybe()
+			client.
+				On("Person", mock.Anything, 123).
+				Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
+
+			template := &mockTemplate{}
+			template.
+				On("Func", mock.Anything, eventData{
+					NoteTypes:   []string{"a", "b"},
+					Entity:      "John Doe",
+					DonorId:     123,
+					EntityType:  "person",
+					Type:        "Application processing",
+					Name:        "Something",
+					Description: "More words",
+					Error: sirius.ValidationError{
+						Field: sirius.FieldErrors{"file": tc.errors},
+					},
+				}).
+				Return(nil)
+
+			var buf bytes.Buffer
+			form := multipart.NewWriter(&buf)
+			_ = form.WriteField("type", "Application processing")
+			_ = form.WriteField("name", "Something")
+			_ = form.WriteField("description", "More words")
+			part, _ := form.CreateFormFile("file", "test.txt")
+			_, _ = part.Write(tc.files["test.txt"])
+			for filename, contents := range tc.files {
+				if filename != "test.txt" {
+					part, _ = form.CreateFormFile("file", filename)
+					_, _ = part.Write(contents)
+				}
+			}
+			_ = form.Close()
+
+			r, _ := http.NewRequest(http.MethodPost, "/?id=123&entity=person", &buf)
+			r.Header.Add("Content-Type", form.FormDataContentType())
+			w := httptest.NewRecorder()
+
+			err := Event(client, template.Func, nil)(w, r)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			mock.AssertExpectationsForObjects(t, client, template)
+			client.AssertNotCalled(t, "CreateNote", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPostEventWithOfficeAndEmailFiles(t *testing.T) {
+	ole := []byte{0xd0, 0xcf, 0x11, 0xe0, 0xa1, 0xb1, 0x1a, 0xe1}
+	zip := []byte("PK\x03\x04")
+
+	testCases := map[string]struct {
+		contents []byte
+		fileType string
+	}{
+		"letter.doc":    {contents: ole, fileType: "application/msword"},
+		"letter.docx":   {contents: zip, fileType: "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+		"fees.xls":      {contents: ole, fileType: "application/vnd.ms-excel"},
+		"fees.xlsx":     {contents: zip, fileType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+		"reply.msg":     {contents: ole, fileType: "application/vnd.ms-outlook"},
+		"reply.eml":     {contents: []byte("From: someone@example.com"), fileType: "message/rfc822"},
+		"statement.rtf": {contents: []byte(`{\rtf1\ansi Hey}`), fileType: "application/rtf"},
+	}
+
+	for filename, tc := range testCases {
+		t.Run(filename, func(t *testing.T) {
+			client := &mockEventClient{}
+			client.
+				On("NoteTypes", mock.Anything).
+				Return([]string{"a", "b"}, nil)
+			client.
+				On("NoteTemplates", mock.Anything).
+				Return([]sirius.NoteTemplate(nil), nil).
+				Maybe()
+			client.
+				On("Person", mock.Anything, 123).
+				Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
+			client.
+				On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words",
+					mock.MatchedBy(func(files []sirius.NoteFile) bool {
+						return len(files) == 1 && files[0].Name == filename && files[0].Type == tc.fileType
+					})).
+				Return(nil)
+
+			template := &mockTemplate{}
+			template.
+				On("Func", mock.Anything, mock.Anything).
+				Return(nil)
+
+			var buf bytes.Buffer
+			form := multipart.NewWriter(&buf)
+			_ = form.WriteField("type", "Application processing")
+			_ = form.WriteField("name", "Something")
+			_ = form.WriteField("description", "More words")
+			part, _ := form.CreateFormFile("file", filename)
+			_, _ = part.Write(tc.contents)
+			_ = form.Close()
+
+			r, _ := http.NewRequest(http.MethodPost, "/?id=123&entity=person", &buf)
+			r.Header.Add("Content-Type", form.FormDataContentType())
+			w := httptest.NewRecorder()
+
+			err := Event(client, template.Func, nil)(w, r)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			mock.AssertExpectationsForObjects(t, client, template)
+		})
+	}
+}
+
+func TestPostEventWhenFormTooLarge(t *testing.T) {
+	client := &mockEventClient{}
+	client.
+		On("NoteTypes", mock.Anything).
+		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
+	client.
+		On("Person", mock.Anything, 123).
+		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, eventData{
+			NoteTypes:  []string{"a", "b"},
+			Entity:     "John Doe",
+			DonorId:    123,
+			EntityType: "person",
+			Error: sirius.ValidationError{
+				Field: sirius.FieldErrors{"file": {"size": "The selected files must be 32MB or smaller in total"}},
+			},
+		}).
+		Return(nil)
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	_ = form.WriteField("type", "Application processing")
+	part, _ := form.CreateFormFile("file", "large.txt")
+	_, _ = part.Write(bytes.Repeat([]byte("a"), noteFormMaxSize))
+	_ = form.Close()
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123&entity=person", &buf)
+	r.Header.Add("Content-Type", form.FormDataContentType())
+	w := httptest.NewRecorder()
+
+	err := Event(client, template.Func, nil)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+	client.AssertNotCalled(t, "CreateNote", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func readNoteFile(file sirius.NoteFile) string {
+	f, err := file.Open()
+	if err != nil {
+		return ""
+	}
+	defer f.Close() //nolint:errcheck // no need to check error when closing file
+
+	data, _ := io.ReadAll(f)
+	return string(data)
 }
 
 func TestPostEventWithBadForm(t *testing.T) {
@@ -262,6 +512,10 @@ func TestPostEventWithBadForm(t *testing.T) {
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Person", mock.Anything, 123).
 		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
@@ -286,20 +540,25 @@ func TestPostEventWhenCreateNoteFails(t *testing.T) {
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Person", mock.Anything, 123).
 		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
 	client.
-		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words", (*sirius.NoteFile)(nil)).
+		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words", []sirius.NoteFile(nil)).
 		Return(errExample)
 
 	template := &mockTemplate{}
 	template.
 		On("Func", mock.Anything, eventData{
-			Success:   true,
-			NoteTypes: []string{"a", "b"},
-			Entity:    "John Doe",
-			DonorId:   123,
+			Success:    true,
+			NoteTypes:  []string{"a", "b"},
+			Entity:     "John Doe",
+			DonorId:    123,
+			EntityType: "person",
 		}).
 		Return(nil)
 
@@ -330,11 +589,15 @@ func TestPostEventWhenValidationError(t *testing.T) {
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Person", mock.Anything, 123).
 		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
 	client.
-		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words", (*sirius.NoteFile)(nil)).
+		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words", []sirius.NoteFile(nil)).
 		Return(expectedErrors)
 
 	template := &mockTemplate{}
@@ -348,6 +611,7 @@ func TestPostEventWhenValidationError(t *testing.T) {
 			Type:        "Application processing",
 			Name:        "Something",
 			Description: "More words",
+			EntityType:  "person",
 		}).
 		Return(nil)
 
@@ -374,6 +638,10 @@ func TestPostEventDigitalLpaRedirect(t *testing.T) {
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Case", mock.Anything, 123).
 		Return(sirius.Case{
@@ -381,7 +649,7 @@ func TestPostEventDigitalLpaRedirect(t *testing.T) {
 			CaseType: "DIGITAL_LPA",
 		}, nil)
 	client.
-		On("CreateNote", mock.Anything, 123, sirius.EntityTypeLpa, "Application processing", "Something", "More words", (*sirius.NoteFile)(nil)).
+		On("CreateNote", mock.Anything, 123, sirius.EntityTypeLpa, "Application processing", "Something", "More words", []sirius.NoteFile(nil)).
 		Return(nil)
 
 	template := &mockTemplate{}
@@ -414,6 +682,10 @@ func TestGetEventHtmx(t *testing.T) {
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Person", mock.Anything, 123).
 		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
@@ -421,6 +693,7 @@ func TestGetEventHtmx(t *testing.T) {
 	template := &mockTemplate{}
 	template.
 		On("Func", mock.Anything, eventData{
+			IsPartial:  true,
 			NoteTypes:  []string{"a", "b"},
 			Entity:     "John Doe",
 			DonorId:    123,
@@ -444,16 +717,21 @@ func TestPostEventHtmx(t *testing.T) {
 	client.
 		On("NoteTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil).
+		Maybe()
 	client.
 		On("Person", mock.Anything, 123).
 		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
 	client.
-		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words", (*sirius.NoteFile)(nil)).
+		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something", "More words", []sirius.NoteFile(nil)).
 		Return(nil)
 
 	partialTemplate := &mockTemplate{}
 	partialTemplate.
 		On("Func", mock.Anything, eventData{
+			IsPartial:  true,
 			Success:    true,
 			NoteTypes:  []string{"a", "b"},
 			Entity:     "John Doe",
@@ -480,3 +758,174 @@ func TestPostEventHtmx(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+var phoneCallTemplate = sirius.NoteTemplate{
+	ID:       2,
+	Name:     "Phone call",
+	NoteType: "Phone call",
+	Subject:  "Call from donor",
+	Fields: []sirius.NoteTemplateField{
+		{Name: "caller", Label: "Caller"},
+		{Name: "number", Label: "Number"},
+		{Name: "summary", Label: "Summary", Value: "Caller asked about", Multiline: true},
+		{Name: "actions", Label: "Actions", Multiline: true},
+	},
+}
+
+func TestGetEventWithTemplate(t *testing.T) {
+	client := &mockEventClient{}
+	client.
+		On("NoteTypes", mock.Anything).
+		Return([]string{"Phone call"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate{phoneCallTemplate}, nil)
+	client.
+		On("Person", mock.Anything, 123).
+		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, eventData{
+			NoteTypes:  []string{"Phone call"},
+			Entity:     "John Doe",
+			DonorId:    123,
+			EntityType: "person",
+			Templates:  []sirius.NoteTemplate{phoneCallTemplate},
+			Template:   phoneCallTemplate,
+			Type:       "Phone call",
+			Name:       "Call from donor",
+			Fields: map[string]string{
+				"caller":  "",
+				"number":  "",
+				"summary": "Caller asked about",
+				"actions": "",
+			},
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123&entity=person&template=2", nil)
+	w := httptest.NewRecorder()
+
+	err := Event(client, template.Func, nil)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetEventWhenNoteTemplatesError(t *testing.T) {
+	client := &mockEventClient{}
+	client.
+		On("NoteTypes", mock.Anything).
+		Return([]string{"Phone call"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate{}, errExample)
+	client.
+		On("Person", mock.Anything, 123).
+		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, eventData{
+			NoteTypes:  []string{"Phone call"},
+			Entity:     "John Doe",
+			DonorId:    123,
+			EntityType: "person",
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123&entity=person&template=2", nil)
+	w := httptest.NewRecorder()
+
+	err := Event(client, template.Func, nil)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostEventWithTemplate(t *testing.T) {
+	client := &mockEventClient{}
+	client.
+		On("NoteTypes", mock.Anything).
+		Return([]string{"Phone call"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate{phoneCallTemplate}, nil)
+	client.
+		On("Person", mock.Anything, 123).
+		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
+	client.
+		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Phone call", "Call from donor",
+			"<p><strong>Caller:</strong> Jo &lt;Smith&gt;</p>"+
+				"<p><strong>Summary:</strong> Asked about fees<br>and refunds</p>"+
+				"<p>Call back <strong>tomorrow</strong></p>",
+			[]sirius.NoteFile(nil)).
+		Return(nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.Anything).
+		Return(nil)
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	_ = form.WriteField("template", "2")
+	_ = form.WriteField("type", "Phone call")
+	_ = form.WriteField("name", "Call from donor")
+	_ = form.WriteField("field-caller", "Jo <Smith>")
+	_ = form.WriteField("field-number", " ")
+	_ = form.WriteField("field-summary", "Asked about fees\r\nand refunds")
+	_ = form.WriteField("description", `<p onclick="x()">Call back <strong>tomorrow</p>`)
+	_ = form.Close()
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123&entity=person", &buf)
+	r.Header.Add("Content-Type", form.FormDataContentType())
+	w := httptest.NewRecorder()
+
+	err := Event(client, template.Func, nil)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostEventSanitisesDescription(t *testing.T) {
+	client := &mockEventClient{}
+	client.
+		On("NoteTypes", mock.Anything).
+		Return([]string{"a", "b"}, nil)
+	client.
+		On("NoteTemplates", mock.Anything).
+		Return([]sirius.NoteTemplate(nil), nil)
+	client.
+		On("Person", mock.Anything, 123).
+		Return(sirius.Person{Firstname: "John", Surname: "Doe"}, nil)
+	client.
+		On("CreateNote", mock.Anything, 123, sirius.EntityTypePerson, "Application processing", "Something",
+			`<ul><li>One</li></ul>alert(1)<a class="govuk-link" href="https://www.gov.uk" rel="noreferrer noopener" target="_blank">link</a>`,
+			[]sirius.NoteFile(nil)).
+		Return(nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.Anything).
+		Return(nil)
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	_ = form.WriteField("type", "Application processing")
+	_ = form.WriteField("name", "Something")
+	_ = form.WriteField("description", `<ul><li>One</ul><script>alert(1)</script><a href="https://www.gov.uk">link</a>`)
+	_ = form.Close()
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123&entity=person", &buf)
+	r.Header.Add("Content-Type", form.FormDataContentType())
+	w := httptest.NewRecorder()
+
+	err := Event(client, template.Func, nil)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client)
+}
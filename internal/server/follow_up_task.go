@@ -0,0 +1,30 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/ministryofjustice/opg-go-common/telemetry"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+type FollowUpTaskClient interface {
+	TaskTemplates(ctx sirius.Context) ([]sirius.TaskTemplate, error)
+}
+
+// followUpTaskURL links to the create task page filled in from the template
+// for the action, so the caseworker can confirm it. It is only a suggestion,
+// so a failure is logged and no task is offered.
+func followUpTaskURL(ctx sirius.Context, client FollowUpTaskClient, caseID int, trigger sirius.FollowUpTrigger) string {
+	templates, err := client.TaskTemplates(ctx)
+	if err != nil {
+		telemetry.LoggerFromContext(ctx.Context).Warn("follow-up task lookup failed", "error", err)
+		return ""
+	}
+
+	template, ok := sirius.FollowUpTaskTemplate(templates, trigger)
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("/create-task?id=%d&template=%d&followUp=%s", caseID, template.ID, trigger)
+}
@@ -33,6 +33,10 @@ type getLpaDetails struct {
 	ReplacementAttorneysDecisions   shared.HowAttorneysMakeDecisions
 }
 
+func (d getLpaDetails) AttorneyStructure() attorneyStructure {
+	return newAttorneyStructure(d.DigitalLpa.LpaStoreData, d.ReplacementAttorneysDecisions)
+}
+
 func GetLpaDetails(client GetLpaDetailsClient, tmpl template.Template) Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		uid := r.PathValue("uid")
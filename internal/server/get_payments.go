@@ -30,10 +30,7 @@ type getPaymentsData struct {
 	FeeReductionTypes []sirius.RefDataItem
 	IsReducedFeesUser bool
 	IsSysAdminUser    bool
-	TotalPaid         int
-	TotalRefunds      int
-	OutstandingFee    int
-	RefundAmount      int
+	Ledger            sirius.PaymentLedger
 	FlashMessage      FlashNotification
 	InActionPanel     bool
 	IsPartial         bool
@@ -76,28 +73,10 @@ func GetPayments(client GetPaymentsClient, tmpl template.Template) Handler {
 			if err != nil {
 				return err
 			}
-			totalPaidAndReductions := 0
-			for _, p := range payments {
-				if p.Amount < 0 {
-					data.Refunds = append(data.Refunds, p)
-					data.TotalRefunds = data.TotalRefunds + (p.Amount * -1)
-				} else {
-					if p.Source == sirius.FeeReductionSource {
-						data.FeeReductions = append(data.FeeReductions, p)
-					} else {
-						data.Payments = append(data.Payments, p)
-						data.TotalPaid = data.TotalPaid + p.Amount
-					}
-				}
-				totalPaidAndReductions = totalPaidAndReductions + p.Amount
-			}
-
-			outstandingFeeOrRefund := data.Case.ExpectedPaymentTotal - totalPaidAndReductions
-			if outstandingFeeOrRefund < 0 {
-				data.RefundAmount = outstandingFeeOrRefund * -1 /*convert to pos num for display*/
-			} else {
-				data.OutstandingFee = outstandingFeeOrRefund
-			}
+			data.Ledger = sirius.NewPaymentLedger(data.Case.ExpectedPaymentTotal, payments)
+			data.Payments = data.Ledger.Payments
+			data.FeeReductions = data.Ledger.FeeReductions
+			data.Refunds = data.Ledger.Refunds
 
 			return nil
 		})
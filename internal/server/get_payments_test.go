@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -64,7 +65,7 @@ func TestGetPayments(t *testing.T) {
 		},
 	}
 
-	expectedPaymentTotal := 8000
+	expectedPaymentTotal := shared.Money(8000)
 
 	caseItem := sirius.Case{
 		UID:                  "7000-0000-0021",
@@ -118,10 +119,9 @@ func TestGetPayments(t *testing.T) {
 			Payments:          nonReductionPayments,
 			FeeReductionTypes: feeReductionTypes,
 			Case:              caseItem,
-			TotalPaid:         5538,
 			IsReducedFeesUser: true,
 			IsSysAdminUser:    false,
-			OutstandingFee:    expectedPaymentTotal - 5538,
+			Ledger:            sirius.NewPaymentLedger(expectedPaymentTotal, allPayments),
 		}).
 		Return(nil)
 
@@ -158,7 +158,7 @@ func TestGetPaymentsHtmx(t *testing.T) {
 		},
 	}
 
-	expectedPaymentTotal := 8000
+	expectedPaymentTotal := shared.Money(8000)
 
 	caseItem := sirius.Case{
 		UID:                  "7000-0000-0021",
@@ -212,10 +212,9 @@ func TestGetPaymentsHtmx(t *testing.T) {
 			Payments:          nonReductionPayments,
 			FeeReductionTypes: feeReductionTypes,
 			Case:              caseItem,
-			TotalPaid:         5538,
 			IsReducedFeesUser: true,
 			IsSysAdminUser:    false,
-			OutstandingFee:    expectedPaymentTotal - 5538,
+			Ledger:            sirius.NewPaymentLedger(expectedPaymentTotal, allPayments),
 			InActionPanel:     true,
 			IsPartial:         true,
 		}).
@@ -534,7 +533,7 @@ func TestGetPaymentsWhenTemplateErrors(t *testing.T) {
 		},
 	}
 
-	expectedPaymentTotal := 8000
+	expectedPaymentTotal := shared.Money(8000)
 
 	caseItem := sirius.Case{
 		UID:                  "7000-0000-0021",
@@ -587,11 +586,10 @@ func TestGetPaymentsWhenTemplateErrors(t *testing.T) {
 			PaymentSources:    paymentSources,
 			ReferenceTypes:    referenceTypes,
 			Case:              caseItem,
-			TotalPaid:         4100,
 			IsReducedFeesUser: false,
 			IsSysAdminUser:    true,
 			FeeReductionTypes: feeReductionTypes,
-			OutstandingFee:    expectedPaymentTotal - 4100,
+			Ledger:            sirius.NewPaymentLedger(expectedPaymentTotal, payments),
 		}).
 		Return(errExample)
 
@@ -638,7 +636,7 @@ func TestGetPaymentWhenRefundDue(t *testing.T) {
 		},
 	}
 
-	expectedPaymentTotal := 8000
+	expectedPaymentTotal := shared.Money(8000)
 
 	caseItem := sirius.Case{
 		ID:                   742,
@@ -695,10 +693,9 @@ func TestGetPaymentWhenRefundDue(t *testing.T) {
 			FeeReductions:     feeReductions,
 			FeeReductionTypes: feeReductionTypes,
 			Case:              caseItem,
-			TotalPaid:         5000,
 			IsReducedFeesUser: true,
 			IsSysAdminUser:    false,
-			RefundAmount:      (expectedPaymentTotal - 4100 - 5000) * -1,
+			Ledger:            sirius.NewPaymentLedger(expectedPaymentTotal, allPayments),
 		}).
 		Return(nil)
 
@@ -746,10 +743,9 @@ func TestGetPaymentWhenRefundDue(t *testing.T) {
 			FeeReductionTypes: feeReductionTypes,
 			Case:              caseItem,
 			CaseSummary:       caseSummary,
-			TotalPaid:         5000,
 			IsReducedFeesUser: true,
 			IsSysAdminUser:    false,
-			RefundAmount:      (expectedPaymentTotal - 4100 - 5000) * -1,
+			Ledger:            sirius.NewPaymentLedger(expectedPaymentTotal, allPayments),
 		}).
 		Return(nil)
 
@@ -767,10 +763,10 @@ func TestGetPaymentsCalculations(t *testing.T) {
 		payments       []sirius.Payment
 		feeReductions  []sirius.Payment
 		refunds        []sirius.Payment
-		totalPaid      int
-		totalRefunds   int
-		outstandingFee int
-		refundAmount   int
+		totalPaid      shared.Money
+		totalRefunds   shared.Money
+		outstandingFee shared.Money
+		refundAmount   shared.Money
 	}{
 		{
 			[]sirius.Payment{{ID: 2, Amount: 4100}, {ID: 3, Amount: 1500}, {ID: 4, Amount: -4100}},
@@ -821,6 +817,12 @@ func TestGetPaymentsCalculations(t *testing.T) {
 			ExpectedPaymentTotal: 8200,
 		}
 
+		ledger := sirius.NewPaymentLedger(caseItem.ExpectedPaymentTotal, tc.allPayments)
+		assert.Equal(t, tc.totalPaid, ledger.TotalPaid)
+		assert.Equal(t, tc.totalRefunds, ledger.TotalRefunds)
+		assert.Equal(t, tc.outstandingFee, ledger.AmountDue())
+		assert.Equal(t, tc.refundAmount, ledger.Overpaid())
+
 		paymentSources := []sirius.RefDataItem{
 			{
 				Handle: "PHONE",
@@ -869,10 +871,7 @@ func TestGetPaymentsCalculations(t *testing.T) {
 				FeeReductions:     tc.feeReductions,
 				Refunds:           tc.refunds,
 				Case:              caseItem,
-				TotalPaid:         tc.totalPaid,
-				TotalRefunds:      tc.totalRefunds,
-				OutstandingFee:    tc.outstandingFee,
-				RefundAmount:      tc.refundAmount,
+				Ledger:            ledger,
 				IsReducedFeesUser: true,
 				IsSysAdminUser:    false,
 			}).
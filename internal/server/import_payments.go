@@ -0,0 +1,426 @@
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+const maxImportPaymentRows = 500
+
+var (
+	errImportPaymentsHeader = importPaymentsFileError("The file must have a header row with uid, amount, source and payment date columns")
+	importPaymentUIDPattern = regexp.MustCompile(`^(M(-[0-9A-Z]{4}){3}|7000(-[0-9]{4}){2})$`)
+)
+
+type ImportPaymentsClient interface {
+	AddPayment(ctx sirius.Context, caseID int, amount shared.Money, source string, paymentDate sirius.DateString) error
+	CaseByUID(ctx sirius.Context, uid string) (sirius.Case, error)
+	Payments(ctx sirius.Context, id int) ([]sirius.Payment, error)
+	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
+}
+
+// importPaymentRow is a line of a finance CSV. Rows with problems are not
+// imported, warnings are shown in the preview but do not stop the import.
+type importPaymentRow struct {
+	Line        int
+	UID         string
+	Amount      shared.Money
+	Source      string
+	PaymentDate sirius.DateString
+	Case        sirius.Case
+	Problems    []string
+	Warnings    []string
+	Added       bool
+	Result      string
+}
+
+func (r importPaymentRow) Accepted() bool {
+	return len(r.Problems) == 0
+}
+
+type importPaymentsData struct {
+	XSRFToken string
+	Error     sirius.ValidationError
+
+	Confirmed     bool
+	File          string
+	Rows          []importPaymentRow
+	AcceptedCount int
+	AddedCount    int
+	Report        string
+}
+
+func ImportPayments(client ImportPaymentsClient, tmpl template.Template) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ctx := getContext(r)
+		data := importPaymentsData{XSRFToken: ctx.XSRFToken}
+
+		if r.Method != http.MethodPost {
+			return tmpl(w, data)
+		}
+
+		if err := r.ParseMultipartForm(8 * Megabyte); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+			return err
+		}
+
+		step := postFormString(r, "step")
+
+		if step == "report" {
+			report, err := base64.StdEncoding.DecodeString(postFormString(r, "report"))
+			if err != nil {
+				return sirius.StatusError{Code: http.StatusBadRequest}
+			}
+
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="payment-import-%s.csv"`, time.Now().Format("2006-01-02")))
+			_, err = w.Write(report)
+			return err
+		}
+
+		var file []byte
+		if step == "confirm" {
+			var err error
+			file, err = base64.StdEncoding.DecodeString(postFormString(r, "file"))
+			if err != nil {
+				return sirius.StatusError{Code: http.StatusBadRequest}
+			}
+		} else if r.MultipartForm != nil && len(r.MultipartForm.File["file"]) == 1 {
+			f, err := r.MultipartForm.File["file"][0].Open()
+			if err != nil {
+				return err
+			}
+			defer f.Close() //nolint:errcheck // no need to check error when closing body
+
+			if file, err = io.ReadAll(f); err != nil {
+				return err
+			}
+		}
+
+		if len(bytes.TrimSpace(file)) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			data.Error = importPaymentsFileError("Select a CSV file of payments")
+
+			return tmpl(w, data)
+		}
+
+		sources, err := client.RefDataByCategory(ctx, sirius.PaymentSourceCategory)
+		if err != nil {
+			return err
+		}
+
+		rows, err := parseImportPayments(bytes.NewReader(file), sources)
+		if ve, ok := err.(sirius.ValidationError); ok {
+			w.WriteHeader(http.StatusBadRequest)
+			data.Error = ve
+
+			return tmpl(w, data)
+		} else if err != nil {
+			return err
+		}
+
+		if err := matchImportPayments(ctx, client, rows); err != nil {
+			return err
+		}
+
+		data.File = base64.StdEncoding.EncodeToString(file)
+		data.Rows = rows
+		for _, row := range rows {
+			if row.Accepted() {
+				data.AcceptedCount++
+			}
+		}
+
+		if step != "confirm" {
+			return tmpl(w, data)
+		}
+
+		data.Confirmed = true
+		for i, row := range data.Rows {
+			if !row.Accepted() {
+				data.Rows[i].Result = strings.Join(row.Problems, "; ")
+				continue
+			}
+
+			err := client.AddPayment(ctx, row.Case.ID, row.Amount, row.Source, row.PaymentDate)
+			if ve, ok := err.(sirius.ValidationError); ok {
+				data.Rows[i].Result = ve.Error()
+			} else if err != nil {
+				data.Rows[i].Result = fmt.Sprintf("Could not add payment: %s", err.Error())
+			} else {
+				data.Rows[i].Added = true
+				data.Rows[i].Result = "Payment added"
+				data.AddedCount++
+			}
+		}
+
+		report, err := importPaymentsReport(data.Rows)
+		if err != nil {
+			return err
+		}
+		data.Report = base64.StdEncoding.EncodeToString(report)
+
+		return tmpl(w, data)
+	}
+}
+
+func importPaymentsFileError(reason string) sirius.ValidationError {
+	return sirius.ValidationError{
+		Field: sirius.FieldErrors{
+			"file": {"reason": reason},
+		},
+	}
+}
+
+// parseImportPayments reads a finance CSV. Columns are found by their header so
+// they can be in any order, and unknown columns are ignored.
+func parseImportPayments(r io.Reader, sources []sirius.RefDataItem) ([]importPaymentRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errImportPaymentsHeader
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		name = strings.NewReplacer(" ", "", "_", "").Replace(name)
+		columns[name] = i
+	}
+
+	uidColumn, okUID := columns["uid"]
+	amountColumn, okAmount := columns["amount"]
+	sourceColumn, okSource := columns["source"]
+	dateColumn, okDate := columns["paymentdate"]
+	if !okUID || !okAmount || !okSource || !okDate {
+		return nil, errImportPaymentsHeader
+	}
+
+	validSources := map[string]bool{}
+	for _, source := range sources {
+		if source.UserSelectable {
+			validSources[source.Handle] = true
+		}
+	}
+
+	var rows []importPaymentRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, importPaymentsFileError(fmt.Sprintf("The file could not be read: %s", err.Error()))
+		}
+
+		line, _ := reader.FieldPos(0)
+		field := func(i int) string {
+			if i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		if strings.Join(record, "") == "" {
+			continue
+		}
+
+		if len(rows) == maxImportPaymentRows {
+			return nil, importPaymentsFileError(fmt.Sprintf("The file must have no more than %d payments", maxImportPaymentRows))
+		}
+
+		row := importPaymentRow{
+			Line:   line,
+			UID:    strings.ToUpper(field(uidColumn)),
+			Source: strings.ToUpper(field(sourceColumn)),
+		}
+
+		if row.UID == "" {
+			row.Problems = append(row.Problems, "UID is missing")
+		} else if !importPaymentUIDPattern.MatchString(row.UID) {
+			row.Problems = append(row.Problems, fmt.Sprintf("UID %q is not valid", field(uidColumn)))
+		}
+
+		if row.Amount, err = shared.ParseMoney(field(amountColumn)); err != nil || row.Amount == 0 {
+			row.Problems = append(row.Problems, fmt.Sprintf("Amount %q is not valid", field(amountColumn)))
+		}
+
+		if !validSources[row.Source] {
+			row.Problems = append(row.Problems, fmt.Sprintf("Source %q is not a payment method", field(sourceColumn)))
+		}
+
+		if row.PaymentDate, err = parseImportPaymentDate(field(dateColumn)); err != nil {
+			row.Problems = append(row.Problems, fmt.Sprintf("Payment date %q is not valid", field(dateColumn)))
+		}
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, importPaymentsFileError("The file does not contain any payments")
+	}
+
+	return rows, nil
+}
+
+func parseImportPaymentDate(s string) (sirius.DateString, error) {
+	for _, layout := range []string{"02/01/2006", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			if t.After(time.Now()) {
+				return "", errors.New("payment date is in the future")
+			}
+
+			return sirius.DateString(t.Format("2006-01-02")), nil
+		}
+	}
+
+	return "", errors.New("payment date is not valid")
+}
+
+// matchImportPayments finds the case for each row, and flags rows that repeat a
+// payment already on the case or earlier in the file. Amounts that do not
+// match the balance due on the case are a warning only.
+func matchImportPayments(ctx sirius.Context, client ImportPaymentsClient, rows []importPaymentRow) error {
+	type match struct {
+		found    bool
+		caseitem sirius.Case
+		payments []sirius.Payment
+	}
+
+	var uids []string
+	matches := map[string]*match{}
+	for _, row := range rows {
+		if importPaymentUIDPattern.MatchString(row.UID) && matches[row.UID] == nil {
+			matches[row.UID] = &match{}
+			uids = append(uids, row.UID)
+		}
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx.Context)
+	group.SetLimit(5)
+
+	for _, uid := range uids {
+		m := matches[uid]
+
+		group.Go(func() error {
+			caseitem, err := client.CaseByUID(ctx.With(groupCtx), uid)
+			if se, ok := err.(sirius.StatusError); ok && se.Code == http.StatusNotFound {
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			payments, err := client.Payments(ctx.With(groupCtx), caseitem.ID)
+			if err != nil {
+				return err
+			}
+
+			m.found = true
+			m.caseitem = caseitem
+			m.payments = payments
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	seen := map[string]int{}
+	due := map[string]shared.Money{}
+
+	for i := range rows {
+		row := &rows[i]
+		m := matches[row.UID]
+		if m == nil {
+			continue
+		}
+
+		if !m.found {
+			row.Problems = append(row.Problems, "No case found with this UID")
+			continue
+		}
+		row.Case = m.caseitem
+
+		if !row.Accepted() {
+			continue
+		}
+
+		for _, p := range m.payments {
+			if p.Amount == row.Amount && p.Source == row.Source && p.PaymentDate == row.PaymentDate {
+				row.Problems = append(row.Problems, "Matches a payment already on this case")
+				break
+			}
+		}
+
+		key := strings.Join([]string{row.UID, strconv.Itoa(row.Amount.Pence()), row.Source, string(row.PaymentDate)}, "|")
+		if line, ok := seen[key]; ok {
+			row.Problems = append(row.Problems, fmt.Sprintf("Duplicate of line %d", line))
+		}
+
+		if !row.Accepted() {
+			continue
+		}
+		seen[key] = row.Line
+
+		amountDue, ok := due[row.UID]
+		if !ok {
+			amountDue = sirius.NewPaymentLedger(m.caseitem.ExpectedPaymentTotal, m.payments).AmountDue()
+		}
+
+		if row.Amount != amountDue {
+			row.Warnings = append(row.Warnings, fmt.Sprintf("Amount does not match the %s due on this case", amountDue))
+		}
+
+		if row.Amount < amountDue {
+			due[row.UID] = amountDue - row.Amount
+		} else {
+			due[row.UID] = 0
+		}
+	}
+
+	return nil
+}
+
+func importPaymentsReport(rows []importPaymentRow) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write([]string{"line", "uid", "amount", "source", "payment date", "added", "result"}); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if err := writer.Write([]string{
+			strconv.Itoa(row.Line),
+			row.UID,
+			row.Amount.Decimal(),
+			row.Source,
+			string(row.PaymentDate),
+			strconv.FormatBool(row.Added),
+			row.Result,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
@@ -0,0 +1,460 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockImportPaymentsClient struct {
+	mock.Mock
+}
+
+func (m *mockImportPaymentsClient) AddPayment(ctx sirius.Context, caseID int, amount shared.Money, source string, paymentDate sirius.DateString) error {
+	return m.Called(ctx, caseID, amount, source, paymentDate).Error(0)
+}
+
+func (m *mockImportPaymentsClient) CaseByUID(ctx sirius.Context, uid string) (sirius.Case, error) {
+	args := m.Called(ctx, uid)
+	return args.Get(0).(sirius.Case), args.Error(1)
+}
+
+func (m *mockImportPaymentsClient) Payments(ctx sirius.Context, id int) ([]sirius.Payment, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]sirius.Payment), args.Error(1)
+}
+
+func (m *mockImportPaymentsClient) RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error) {
+	args := m.Called(ctx, category)
+	if args.Get(0) != nil {
+		return args.Get(0).([]sirius.RefDataItem), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func TestParseImportPayments(t *testing.T) {
+	paymentsCSV := `UID,Amount,Source,Payment Date,Notes
+7000-0000-0001,41.00,ONLINE,02/01/2022,
+7000-0000-0001,41.00,PHONE,2022-01-01,
+7000-0000-0001,41.00,online,02/01/2022,sent twice
+7000-0000-0002,82.00,CHEQUE,03/01/2022,
+7000-0000-0003,50.00,CHEQUE,03/01/2022,
+7000-0000-0003,abc,BANK,31/02/2022,
+7000-0000/../1,41.00,PHONE,02/01/2022,
+m-abcd-1234-efgh,41.00,PHONE,02/01/2022,
+`
+	paymentSources := []sirius.RefDataItem{
+		{Handle: "PHONE", UserSelectable: true},
+		{Handle: "ONLINE", UserSelectable: true},
+		{Handle: "CHEQUE", UserSelectable: true},
+		{Handle: "MIGRATED", UserSelectable: false},
+	}
+
+	rows, err := parseImportPayments(strings.NewReader(paymentsCSV), paymentSources)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []importPaymentRow{
+		{Line: 2, UID: "7000-0000-0001", Amount: 4100, Source: "ONLINE", PaymentDate: "2022-01-02"},
+		{Line: 3, UID: "7000-0000-0001", Amount: 4100, Source: "PHONE", PaymentDate: "2022-01-01"},
+		{Line: 4, UID: "7000-0000-0001", Amount: 4100, Source: "ONLINE", PaymentDate: "2022-01-02"},
+		{Line: 5, UID: "7000-0000-0002", Amount: 8200, Source: "CHEQUE", PaymentDate: "2022-01-03"},
+		{Line: 6, UID: "7000-0000-0003", Amount: 5000, Source: "CHEQUE", PaymentDate: "2022-01-03"},
+		{Line: 7, UID: "7000-0000-0003", Source: "BANK", Problems: []string{
+			`Amount "abc" is not valid`,
+			`Source "BANK" is not a payment method`,
+			`Payment date "31/02/2022" is not valid`,
+		}},
+		{Line: 8, UID: "7000-0000/../1", Amount: 4100, Source: "PHONE", PaymentDate: "2022-01-02", Problems: []string{
+			`UID "7000-0000/../1" is not valid`,
+		}},
+		{Line: 9, UID: "M-ABCD-1234-EFGH", Amount: 4100, Source: "PHONE", PaymentDate: "2022-01-02"},
+	}, rows)
+}
+
+func TestParseImportPaymentsWhenFileInvalid(t *testing.T) {
+	paymentSources := []sirius.RefDataItem{
+		{Handle: "PHONE", UserSelectable: true},
+		{Handle: "ONLINE", UserSelectable: true},
+		{Handle: "CHEQUE", UserSelectable: true},
+		{Handle: "MIGRATED", UserSelectable: false},
+	}
+
+	testCases := map[string]struct {
+		csv    string
+		reason string
+	}{
+		"missing column": {
+			csv:    "uid,amount,source\n7000-0000-0001,41.00,PHONE\n",
+			reason: "The file must have a header row with uid, amount, source and payment date columns",
+		},
+		"no rows": {
+			csv:    "uid,amount,source,payment_date\n\n",
+			reason: "The file does not contain any payments",
+		},
+		"bad quoting": {
+			csv:    "uid,amount,source,paymentDate\n\"7000,41.00,PHONE,01/01/2022\n",
+			reason: `The file could not be read: parse error on line 2, column 30: extraneous or missing " in quoted-field`,
+		},
+		"future date": {
+			csv:    "uid,amount,source,paymentDate\n7000-0000-0001,41.00,PHONE,01/01/2999\n",
+			reason: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rows, err := parseImportPayments(strings.NewReader(tc.csv), paymentSources)
+
+			if tc.reason == "" {
+				assert.Nil(t, err)
+				assert.Equal(t, []string{`Payment date "01/01/2999" is not valid`}, rows[0].Problems)
+			} else {
+				assert.Equal(t, importPaymentsFileError(tc.reason), err)
+			}
+		})
+	}
+}
+
+func TestMatchImportPayments(t *testing.T) {
+	paymentsCSV := `UID,Amount,Source,Payment Date,Notes
+7000-0000-0001,41.00,ONLINE,02/01/2022,
+7000-0000-0001,41.00,PHONE,2022-01-01,
+7000-0000-0001,41.00,online,02/01/2022,sent twice
+7000-0000-0002,82.00,CHEQUE,03/01/2022,
+7000-0000-0003,50.00,CHEQUE,03/01/2022,
+7000-0000-0003,abc,BANK,31/02/2022,
+`
+	paymentSources := []sirius.RefDataItem{
+		{Handle: "PHONE", UserSelectable: true},
+		{Handle: "ONLINE", UserSelectable: true},
+		{Handle: "CHEQUE", UserSelectable: true},
+		{Handle: "MIGRATED", UserSelectable: false},
+	}
+
+	client := &mockImportPaymentsClient{}
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return(paymentSources, nil)
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0001").
+		Return(sirius.Case{ID: 1, UID: "7000-0000-0001", ExpectedPaymentTotal: 8200}, nil)
+	client.
+		On("Payments", mock.Anything, 1).
+		Return([]sirius.Payment{{ID: 10, Amount: 4100, Source: "PHONE", PaymentDate: "2022-01-01"}}, nil)
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0002").
+		Return(sirius.Case{}, sirius.StatusError{Code: http.StatusNotFound})
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0003").
+		Return(sirius.Case{ID: 3, UID: "7000-0000-0003", ExpectedPaymentTotal: 8200}, nil)
+	client.
+		On("Payments", mock.Anything, 3).
+		Return([]sirius.Payment{}, nil)
+
+	rows, _ := parseImportPayments(strings.NewReader(paymentsCSV), paymentSources)
+	err := matchImportPayments(sirius.Context{Context: context.Background()}, client, rows)
+
+	assert.Nil(t, err)
+
+	problems := make([][]string, len(rows))
+	warnings := make([][]string, len(rows))
+	for i, row := range rows {
+		problems[i] = row.Problems
+		warnings[i] = row.Warnings
+	}
+
+	assert.Equal(t, [][]string{
+		nil,
+		{"Matches a payment already on this case"},
+		{"Duplicate of line 2"},
+		{"No case found with this UID"},
+		nil,
+		{`Amount "abc" is not valid`, `Source "BANK" is not a payment method`, `Payment date "31/02/2022" is not valid`},
+	}, problems)
+	assert.Equal(t, [][]string{
+		nil,
+		nil,
+		nil,
+		nil,
+		{"Amount does not match the £82.00 due on this case"},
+		nil,
+	}, warnings)
+	assert.Equal(t, 1, rows[0].Case.ID)
+	assert.Equal(t, 3, rows[4].Case.ID)
+}
+
+func TestMatchImportPaymentsWhenClientErrors(t *testing.T) {
+	expectedError := errors.New("service unavailable")
+
+	client := &mockImportPaymentsClient{}
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0001").
+		Return(sirius.Case{}, expectedError)
+
+	rows := []importPaymentRow{{Line: 2, UID: "7000-0000-0001"}}
+	err := matchImportPayments(sirius.Context{Context: context.Background()}, client, rows)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestMatchImportPaymentsSkipsInvalidUIDs(t *testing.T) {
+	client := &mockImportPaymentsClient{}
+
+	rows := []importPaymentRow{{Line: 2, UID: "7000-0000/../1", Problems: []string{`UID "7000-0000/../1" is not valid`}}}
+	err := matchImportPayments(sirius.Context{Context: context.Background()}, client, rows)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`UID "7000-0000/../1" is not valid`}, rows[0].Problems)
+	client.AssertNotCalled(t, "CaseByUID", mock.Anything, mock.Anything)
+}
+
+func TestGetImportPayments(t *testing.T) {
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, importPaymentsData{}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+
+	err := ImportPayments(nil, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, template)
+}
+
+func TestPostImportPaymentsPreview(t *testing.T) {
+	paymentsCSV := `UID,Amount,Source,Payment Date,Notes
+7000-0000-0001,41.00,ONLINE,02/01/2022,
+7000-0000-0001,41.00,PHONE,2022-01-01,
+7000-0000-0001,41.00,online,02/01/2022,sent twice
+7000-0000-0002,82.00,CHEQUE,03/01/2022,
+7000-0000-0003,50.00,CHEQUE,03/01/2022,
+7000-0000-0003,abc,BANK,31/02/2022,
+`
+	paymentSources := []sirius.RefDataItem{
+		{Handle: "PHONE", UserSelectable: true},
+		{Handle: "ONLINE", UserSelectable: true},
+		{Handle: "CHEQUE", UserSelectable: true},
+		{Handle: "MIGRATED", UserSelectable: false},
+	}
+
+	client := &mockImportPaymentsClient{}
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return(paymentSources, nil)
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0001").
+		Return(sirius.Case{ID: 1, UID: "7000-0000-0001", ExpectedPaymentTotal: 8200}, nil)
+	client.
+		On("Payments", mock.Anything, 1).
+		Return([]sirius.Payment{{ID: 10, Amount: 4100, Source: "PHONE", PaymentDate: "2022-01-01"}}, nil)
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0002").
+		Return(sirius.Case{}, sirius.StatusError{Code: http.StatusNotFound})
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0003").
+		Return(sirius.Case{ID: 3, UID: "7000-0000-0003", ExpectedPaymentTotal: 8200}, nil)
+	client.
+		On("Payments", mock.Anything, 3).
+		Return([]sirius.Payment{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data importPaymentsData) bool {
+			return !data.Confirmed &&
+				len(data.Rows) == 6 &&
+				data.AcceptedCount == 2 &&
+				data.File == base64.StdEncoding.EncodeToString([]byte(paymentsCSV))
+		})).
+		Return(nil)
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	_ = form.WriteField("step", "preview")
+	part, _ := form.CreateFormFile("file", "payments.csv")
+	_, _ = part.Write([]byte(paymentsCSV))
+	_ = form.Close()
+
+	r, _ := http.NewRequest(http.MethodPost, "/", &buf)
+	r.Header.Add("Content-Type", form.FormDataContentType())
+	w := httptest.NewRecorder()
+
+	err := ImportPayments(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+	client.AssertNotCalled(t, "AddPayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPostImportPaymentsWithoutFile(t *testing.T) {
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, importPaymentsData{
+			Error: importPaymentsFileError("Select a CSV file of payments"),
+		}).
+		Return(nil)
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	_ = form.WriteField("step", "preview")
+	_ = form.Close()
+
+	r, _ := http.NewRequest(http.MethodPost, "/", &buf)
+	r.Header.Add("Content-Type", form.FormDataContentType())
+	w := httptest.NewRecorder()
+
+	err := ImportPayments(nil, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	mock.AssertExpectationsForObjects(t, template)
+}
+
+func TestPostImportPaymentsWhenFileInvalid(t *testing.T) {
+	paymentSources := []sirius.RefDataItem{
+		{Handle: "PHONE", UserSelectable: true},
+		{Handle: "ONLINE", UserSelectable: true},
+		{Handle: "CHEQUE", UserSelectable: true},
+		{Handle: "MIGRATED", UserSelectable: false},
+	}
+
+	client := &mockImportPaymentsClient{}
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return(paymentSources, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, importPaymentsData{
+			Error: errImportPaymentsHeader,
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"step": {"confirm"},
+		"file": {base64.StdEncoding.EncodeToString([]byte("a,b,c\n1,2,3\n"))},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := ImportPayments(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostImportPaymentsConfirm(t *testing.T) {
+	paymentsCSV := `UID,Amount,Source,Payment Date,Notes
+7000-0000-0001,41.00,ONLINE,02/01/2022,
+7000-0000-0001,41.00,PHONE,2022-01-01,
+7000-0000-0001,41.00,online,02/01/2022,sent twice
+7000-0000-0002,82.00,CHEQUE,03/01/2022,
+7000-0000-0003,50.00,CHEQUE,03/01/2022,
+7000-0000-0003,abc,BANK,31/02/2022,
+`
+	paymentSources := []sirius.RefDataItem{
+		{Handle: "PHONE", UserSelectable: true},
+		{Handle: "ONLINE", UserSelectable: true},
+		{Handle: "CHEQUE", UserSelectable: true},
+		{Handle: "MIGRATED", UserSelectable: false},
+	}
+
+	client := &mockImportPaymentsClient{}
+	client.
+		On("RefDataByCategory", mock.Anything, sirius.PaymentSourceCategory).
+		Return(paymentSources, nil)
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0001").
+		Return(sirius.Case{ID: 1, UID: "7000-0000-0001", ExpectedPaymentTotal: 8200}, nil)
+	client.
+		On("Payments", mock.Anything, 1).
+		Return([]sirius.Payment{{ID: 10, Amount: 4100, Source: "PHONE", PaymentDate: "2022-01-01"}}, nil)
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0002").
+		Return(sirius.Case{}, sirius.StatusError{Code: http.StatusNotFound})
+	client.
+		On("CaseByUID", mock.Anything, "7000-0000-0003").
+		Return(sirius.Case{ID: 3, UID: "7000-0000-0003", ExpectedPaymentTotal: 8200}, nil)
+	client.
+		On("Payments", mock.Anything, 3).
+		Return([]sirius.Payment{}, nil)
+	client.
+		On("AddPayment", mock.Anything, 1, shared.Money(4100), "ONLINE", sirius.DateString("2022-01-02")).
+		Return(nil)
+	client.
+		On("AddPayment", mock.Anything, 3, shared.Money(5000), "CHEQUE", sirius.DateString("2022-01-03")).
+		Return(errors.New("service unavailable"))
+
+	expectedReport := `line,uid,amount,source,payment date,added,result
+2,7000-0000-0001,41.00,ONLINE,2022-01-02,true,Payment added
+3,7000-0000-0001,41.00,PHONE,2022-01-01,false,Matches a payment already on this case
+4,7000-0000-0001,41.00,ONLINE,2022-01-02,false,Duplicate of line 2
+5,7000-0000-0002,82.00,CHEQUE,2022-01-03,false,No case found with this UID
+6,7000-0000-0003,50.00,CHEQUE,2022-01-03,false,Could not add payment: service unavailable
+7,7000-0000-0003,0.00,BANK,,false,"Amount ""abc"" is not valid; Source ""BANK"" is not a payment method; Payment date ""31/02/2022"" is not valid"
+`
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data importPaymentsData) bool {
+			report, _ := base64.StdEncoding.DecodeString(data.Report)
+
+			return data.Confirmed &&
+				data.AcceptedCount == 2 &&
+				data.AddedCount == 1 &&
+				data.Rows[0].Added &&
+				!data.Rows[4].Added &&
+				string(report) == expectedReport
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"step": {"confirm"},
+		"file": {base64.StdEncoding.EncodeToString([]byte(paymentsCSV))},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := ImportPayments(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostImportPaymentsReport(t *testing.T) {
+	form := url.Values{
+		"step":   {"report"},
+		"report": {base64.StdEncoding.EncodeToString([]byte("line,uid\n2,7000-0000-0001\n"))},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := ImportPayments(nil, nil)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+	assert.Contains(t, resp.Header.Get("Content-Disposition"), "attachment; filename=\"payment-import-")
+	assert.Equal(t, "line,uid\n2,7000-0000-0001\n", w.Body.String())
+}
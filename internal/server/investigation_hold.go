@@ -3,15 +3,18 @@ package server
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 )
 
 type InvestigationHoldClient interface {
+	FollowUpTaskClient
 	PlaceInvestigationOnHold(ctx sirius.Context, investigationID int, reason string) error
 	TakeInvestigationOffHold(ctx sirius.Context, investigationID int) error
 	Investigation(ctx sirius.Context, id int) (sirius.Investigation, error)
+	BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error)
 }
 
 type investigationHoldData struct {
@@ -20,9 +23,17 @@ type investigationHoldData struct {
 	Error         sirius.ValidationError
 	Investigation sirius.Investigation
 	Reason        string
+	Hold          sirius.HoldSummary
+	FollowUpTask  string
+
+	WithoutBankHolidays bool
 }
 
 func InvestigationHold(client InvestigationHoldClient, tmpl template.Template) Handler {
+	return investigationHoldWithNow(client, tmpl, time.Now)
+}
+
+func investigationHoldWithNow(client InvestigationHoldClient, tmpl template.Template, now func() time.Time) Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		id, err := strToIntOrStatusError(r.FormValue("id"))
 		if err != nil {
@@ -71,10 +82,20 @@ func InvestigationHold(client InvestigationHoldClient, tmpl template.Template) H
 				return err
 			} else {
 				data.Success = true
+
+				if !investigation.IsOnHold && len(investigation.CaseItems) > 0 {
+					data.FollowUpTask = followUpTaskURL(ctx, client, investigation.CaseItems[0].ID, sirius.FollowUpInvestigationOnHold)
+				}
 			}
+		}
 
+		var bankHolidays sirius.BankHolidays
+		if len(investigation.HoldPeriods) > 0 {
+			bankHolidays, data.WithoutBankHolidays = bankHolidaysOrWarn(ctx, client)
 		}
 
+		data.Hold = sirius.NewHoldSummary(investigation.HoldPeriods, now(), bankHolidays)
+
 		return tmpl(w, data)
 	}
 }
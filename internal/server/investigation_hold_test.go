@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +18,11 @@ type mockInvestigationHoldClient struct {
 	mock.Mock
 }
 
+func (m *mockInvestigationHoldClient) TaskTemplates(ctx sirius.Context) ([]sirius.TaskTemplate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]sirius.TaskTemplate), args.Error(1)
+}
+
 func (m *mockInvestigationHoldClient) PlaceInvestigationOnHold(ctx sirius.Context, investigationID int, reason string) error {
 	args := m.Called(ctx, investigationID, reason)
 	return args.Error(0)
@@ -32,6 +38,11 @@ func (m *mockInvestigationHoldClient) Investigation(ctx sirius.Context, id int)
 	return args.Get(0).(sirius.Investigation), args.Error(1)
 }
 
+func (m *mockInvestigationHoldClient) BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(sirius.BankHolidays), args.Error(1)
+}
+
 func TestGetPlaceInvestigationOnHold(t *testing.T) {
 	investigation := sirius.Investigation{
 		ID:       123,
@@ -47,6 +58,7 @@ func TestGetPlaceInvestigationOnHold(t *testing.T) {
 	template.
 		On("Func", mock.Anything, investigationHoldData{
 			Investigation: investigation,
+			Hold:          sirius.NewHoldSummary(investigation.HoldPeriods, time.Now(), sirius.BankHolidays{}),
 		}).
 		Return(nil)
 
@@ -109,6 +121,7 @@ func TestGetPlaceInvestigationOnHoldWhenTemplateErrors(t *testing.T) {
 	template.
 		On("Func", mock.Anything, investigationHoldData{
 			Investigation: investigation,
+			Hold:          sirius.NewHoldSummary(investigation.HoldPeriods, time.Now(), sirius.BankHolidays{}),
 		}).
 		Return(errExample)
 
@@ -139,6 +152,7 @@ func TestPostPlaceInvestigationOnHold(t *testing.T) {
 		On("Func", mock.Anything, investigationHoldData{
 			Success:       true,
 			Investigation: investigation,
+			Hold:          sirius.NewHoldSummary(investigation.HoldPeriods, time.Now(), sirius.BankHolidays{}),
 			Reason:        "Police Investigation",
 		}).
 		Return(nil)
@@ -159,6 +173,42 @@ func TestPostPlaceInvestigationOnHold(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, client, template)
 }
 
+func TestPostPlaceInvestigationOnHoldOffersFollowUpTask(t *testing.T) {
+	investigation := sirius.Investigation{
+		ID:        123,
+		CaseItems: []sirius.Case{{ID: 45, UID: "M-1111-2222-3333"}},
+	}
+
+	client := &mockInvestigationHoldClient{}
+	client.
+		On("Investigation", mock.Anything, 123).
+		Return(investigation, nil).
+		On("PlaceInvestigationOnHold", mock.Anything, 123, "Police Investigation").
+		Return(nil).
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate{{ID: 9, Trigger: sirius.FollowUpInvestigationOnHold}}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data investigationHoldData) bool {
+			return data.Success && data.FollowUpTask == "/create-task?id=45&template=9&followUp=investigation-on-hold"
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"reason": {"Police Investigation"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := InvestigationHold(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
 func TestPostPlaceInvestigationOnHoldWhenValidationError(t *testing.T) {
 	expectedError := sirius.ValidationError{
 		Field: sirius.FieldErrors{"field": {"": "problem"}},
@@ -182,6 +232,7 @@ func TestPostPlaceInvestigationOnHoldWhenValidationError(t *testing.T) {
 			Success:       false,
 			Error:         expectedError,
 			Investigation: investigation,
+			Hold:          sirius.NewHoldSummary(investigation.HoldPeriods, time.Now(), sirius.BankHolidays{}),
 			Reason:        "Invalid Reason",
 		}).
 		Return(nil)
@@ -244,12 +295,15 @@ func TestGetTakeInvestigationOffHold(t *testing.T) {
 	client := &mockInvestigationHoldClient{}
 	client.
 		On("Investigation", mock.Anything, 123).
-		Return(investigation, nil)
+		Return(investigation, nil).
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{}, nil)
 
 	template := &mockTemplate{}
 	template.
 		On("Func", mock.Anything, investigationHoldData{
 			Investigation: investigation,
+			Hold:          sirius.NewHoldSummary(investigation.HoldPeriods, time.Now(), sirius.BankHolidays{}),
 			Reason:        "Police Investigation",
 		}).
 		Return(nil)
@@ -315,6 +369,8 @@ func TestPostTakeInvestigationOffHold(t *testing.T) {
 	client.
 		On("Investigation", mock.Anything, 123).
 		Return(investigation, nil).
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{}, nil).
 		On("TakeInvestigationOffHold", mock.Anything, 1).
 		Return(nil)
 
@@ -323,6 +379,7 @@ func TestPostTakeInvestigationOffHold(t *testing.T) {
 		On("Func", mock.Anything, investigationHoldData{
 			Success:       true,
 			Investigation: investigation,
+			Hold:          sirius.NewHoldSummary(investigation.HoldPeriods, time.Now(), sirius.BankHolidays{}),
 			Reason:        "Police Investigation",
 		}).
 		Return(nil)
@@ -362,6 +419,8 @@ func TestPostTakeInvestigationOnHoldUsesCorrectHoldPeriodWhenMultiple(t *testing
 	client.
 		On("Investigation", mock.Anything, 123).
 		Return(investigation, nil).
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{}, nil).
 		On("TakeInvestigationOffHold", mock.Anything, 2).
 		Return(nil)
 
@@ -370,6 +429,7 @@ func TestPostTakeInvestigationOnHoldUsesCorrectHoldPeriodWhenMultiple(t *testing
 		On("Func", mock.Anything, investigationHoldData{
 			Success:       true,
 			Investigation: investigation,
+			Hold:          sirius.NewHoldSummary(investigation.HoldPeriods, time.Now(), sirius.BankHolidays{}),
 			Reason:        "LA Investigation",
 		}).
 		Return(nil)
@@ -406,6 +466,8 @@ func TestPostTakeInvestigationOffHoldWhenValidationError(t *testing.T) {
 	client.
 		On("Investigation", mock.Anything, 123).
 		Return(investigation, nil).
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{}, nil).
 		On("TakeInvestigationOffHold", mock.Anything, 1).
 		Return(expectedError)
 
@@ -415,6 +477,7 @@ func TestPostTakeInvestigationOffHoldWhenValidationError(t *testing.T) {
 			Success:       false,
 			Error:         expectedError,
 			Investigation: investigation,
+			Hold:          sirius.NewHoldSummary(investigation.HoldPeriods, time.Now(), sirius.BankHolidays{}),
 			Reason:        "Police Investigation",
 		}).
 		Return(nil)
@@ -459,3 +522,88 @@ func TestPostTakeInvestigationOffHoldWhenOtherError(t *testing.T) {
 	assert.Equal(t, errExample, err)
 	mock.AssertExpectationsForObjects(t, client)
 }
+
+func TestGetInvestigationHoldSummary(t *testing.T) {
+	investigation := sirius.Investigation{
+		ID:       123,
+		IsOnHold: true,
+		HoldPeriods: []sirius.HoldPeriod{
+			{
+				ID:        1,
+				Reason:    "Police Investigation",
+				StartDate: sirius.DateString("2024-04-01"),
+				EndDate:   sirius.DateString("2024-04-05"),
+			},
+			{
+				ID:        2,
+				Reason:    "LA Investigation",
+				StartDate: sirius.DateString("2024-05-24"),
+			},
+		},
+	}
+
+	bankHolidays := sirius.BankHolidays{
+		"england-and-wales": {"Spring bank holiday": "2024-05-27T00:00:00+01:00"},
+	}
+
+	client := &mockInvestigationHoldClient{}
+	client.
+		On("Investigation", mock.Anything, 123).
+		Return(investigation, nil).
+		On("BankHolidays", mock.Anything).
+		Return(bankHolidays, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data investigationHoldData) bool {
+			return assert.True(t, data.Hold.IsOnHold) &&
+				assert.Equal(t, 4, data.Hold.CurrentWorkingDays) &&
+				assert.Equal(t, 8, data.Hold.TotalWorkingDays) &&
+				assert.Equal(t, sirius.DateString("2024-05-24"), data.Hold.CurrentStartDate) &&
+				assert.Len(t, data.Hold.Periods, 2)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123", nil)
+	w := httptest.NewRecorder()
+
+	today := time.Date(2024, time.May, 31, 9, 0, 0, 0, time.UTC)
+	err := investigationHoldWithNow(client, template.Func, func() time.Time { return today })(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetInvestigationHoldSummaryWhenBankHolidaysError(t *testing.T) {
+	investigation := sirius.Investigation{
+		ID:       123,
+		IsOnHold: true,
+		HoldPeriods: []sirius.HoldPeriod{
+			{ID: 2, Reason: "LA Investigation", StartDate: sirius.DateString("2024-05-24")},
+		},
+	}
+
+	client := &mockInvestigationHoldClient{}
+	client.
+		On("Investigation", mock.Anything, 123).
+		Return(investigation, nil).
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays(nil), errExample)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data investigationHoldData) bool {
+			return assert.Equal(t, 5, data.Hold.CurrentWorkingDays) &&
+				assert.True(t, data.WithoutBankHolidays)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123", nil)
+	w := httptest.NewRecorder()
+
+	today := time.Date(2024, time.May, 31, 9, 0, 0, 0, time.UTC)
+	err := investigationHoldWithNow(client, template.Func, func() time.Time { return today })(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
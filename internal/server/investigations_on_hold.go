@@ -0,0 +1,102 @@
+package server
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+// defaultHoldThreshold is the number of working days after which an open hold
+// is flagged, unless another threshold is given
+const defaultHoldThreshold = 20
+
+type InvestigationsOnHoldClient interface {
+	OnHoldInvestigations(ctx sirius.Context) ([]sirius.Investigation, error)
+	BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error)
+}
+
+// investigationsOnHoldRow is an investigation currently on hold, with the case
+// it was opened on
+type investigationsOnHoldRow struct {
+	Investigation sirius.Investigation
+	Case          sirius.Case
+	Hold          sirius.HoldSummary
+}
+
+type investigationsOnHoldData struct {
+	Rows      []investigationsOnHoldRow
+	Sort      string
+	Threshold int
+	Overdue   int
+
+	WithoutBankHolidays bool
+}
+
+func InvestigationsOnHold(client InvestigationsOnHoldClient, tmpl template.Template) Handler {
+	return investigationsOnHoldWithNow(client, tmpl, time.Now)
+}
+
+func investigationsOnHoldWithNow(client InvestigationsOnHoldClient, tmpl template.Template, now func() time.Time) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ctx := getContext(r)
+
+		data := investigationsOnHoldData{
+			Sort:      "oldest",
+			Threshold: defaultHoldThreshold,
+		}
+
+		if r.FormValue("sort") == "newest" {
+			data.Sort = "newest"
+		}
+
+		if threshold, err := strconv.Atoi(r.FormValue("threshold")); err == nil && threshold > 0 {
+			data.Threshold = threshold
+		}
+
+		investigations, err := client.OnHoldInvestigations(ctx)
+		if err != nil {
+			return err
+		}
+
+		var bankHolidays sirius.BankHolidays
+		if len(investigations) > 0 {
+			bankHolidays, data.WithoutBankHolidays = bankHolidaysOrWarn(ctx, client)
+		}
+
+		today := now()
+
+		for _, investigation := range investigations {
+			row := investigationsOnHoldRow{
+				Investigation: investigation,
+				Hold:          sirius.NewHoldSummary(investigation.HoldPeriods, today, bankHolidays),
+			}
+
+			if !row.Hold.IsOnHold {
+				continue
+			}
+
+			if len(investigation.CaseItems) > 0 {
+				row.Case = investigation.CaseItems[0]
+			}
+
+			if row.Hold.IsOverdue(data.Threshold) {
+				data.Overdue++
+			}
+
+			data.Rows = append(data.Rows, row)
+		}
+
+		sort.SliceStable(data.Rows, func(i, j int) bool {
+			if data.Sort == "newest" {
+				return data.Rows[i].Hold.CurrentWorkingDays < data.Rows[j].Hold.CurrentWorkingDays
+			}
+			return data.Rows[i].Hold.CurrentWorkingDays > data.Rows[j].Hold.CurrentWorkingDays
+		})
+
+		return tmpl(w, data)
+	}
+}
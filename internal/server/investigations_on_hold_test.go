@@ -0,0 +1,225 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockInvestigationsOnHoldClient struct {
+	mock.Mock
+}
+
+func (m *mockInvestigationsOnHoldClient) OnHoldInvestigations(ctx sirius.Context) ([]sirius.Investigation, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]sirius.Investigation), args.Error(1)
+}
+
+func (m *mockInvestigationsOnHoldClient) BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(sirius.BankHolidays), args.Error(1)
+}
+
+var (
+	investigationsOnHoldToday = time.Date(2024, time.May, 31, 9, 0, 0, 0, time.UTC)
+
+	investigationsOnHoldBankHolidays = sirius.BankHolidays{
+		"england-and-wales": {"Spring bank holiday": "2024-05-27T00:00:00+01:00"},
+	}
+
+	longHold = sirius.Investigation{
+		ID:       1,
+		Title:    "Long hold",
+		IsOnHold: true,
+		HoldPeriods: []sirius.HoldPeriod{
+			{ID: 1, Reason: "Police Investigation", StartDate: "2024-04-01", EndDate: "2024-04-05"},
+			{ID: 2, Reason: "LA Investigation", StartDate: "2024-05-01"},
+		},
+		CaseItems: []sirius.Case{
+			{ID: 11, UID: "7000-1111-1111", Donor: &sirius.Person{ID: 21}},
+		},
+	}
+
+	shortHold = sirius.Investigation{
+		ID:       2,
+		Title:    "Short hold",
+		IsOnHold: true,
+		HoldPeriods: []sirius.HoldPeriod{
+			{ID: 3, Reason: "Police Investigation", StartDate: "2024-05-24"},
+		},
+	}
+
+	offHold = sirius.Investigation{
+		ID:    3,
+		Title: "Taken off hold",
+		HoldPeriods: []sirius.HoldPeriod{
+			{ID: 4, Reason: "Police Investigation", StartDate: "2024-05-01", EndDate: "2024-05-10"},
+		},
+	}
+)
+
+func TestGetInvestigationsOnHold(t *testing.T) {
+	client := &mockInvestigationsOnHoldClient{}
+	client.
+		On("OnHoldInvestigations", mock.Anything).
+		Return([]sirius.Investigation{shortHold, offHold, longHold}, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(investigationsOnHoldBankHolidays, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data investigationsOnHoldData) bool {
+			return assert.Len(t, data.Rows, 2) &&
+				assert.Equal(t, "oldest", data.Sort) &&
+				assert.Equal(t, defaultHoldThreshold, data.Threshold) &&
+				assert.Equal(t, 1, data.Overdue) &&
+				assert.Equal(t, longHold, data.Rows[0].Investigation) &&
+				assert.Equal(t, longHold.CaseItems[0], data.Rows[0].Case) &&
+				assert.Equal(t, 21, data.Rows[0].Hold.CurrentWorkingDays) &&
+				assert.Equal(t, 25, data.Rows[0].Hold.TotalWorkingDays) &&
+				assert.Equal(t, sirius.DateString("2024-05-01"), data.Rows[0].Hold.CurrentStartDate) &&
+				assert.Equal(t, shortHold, data.Rows[1].Investigation) &&
+				assert.Equal(t, sirius.Case{}, data.Rows[1].Case) &&
+				assert.Equal(t, 4, data.Rows[1].Hold.CurrentWorkingDays)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/investigations/on-hold", nil)
+	w := httptest.NewRecorder()
+
+	err := investigationsOnHoldWithNow(client, template.Func, func() time.Time { return investigationsOnHoldToday })(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetInvestigationsOnHoldNewestFirstWithThreshold(t *testing.T) {
+	client := &mockInvestigationsOnHoldClient{}
+	client.
+		On("OnHoldInvestigations", mock.Anything).
+		Return([]sirius.Investigation{longHold, shortHold}, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(investigationsOnHoldBankHolidays, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data investigationsOnHoldData) bool {
+			return assert.Len(t, data.Rows, 2) &&
+				assert.Equal(t, "newest", data.Sort) &&
+				assert.Equal(t, 3, data.Threshold) &&
+				assert.Equal(t, 2, data.Overdue) &&
+				assert.Equal(t, shortHold, data.Rows[0].Investigation) &&
+				assert.Equal(t, longHold, data.Rows[1].Investigation)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/investigations/on-hold?sort=newest&threshold=3", nil)
+	w := httptest.NewRecorder()
+
+	err := investigationsOnHoldWithNow(client, template.Func, func() time.Time { return investigationsOnHoldToday })(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetInvestigationsOnHoldIgnoresInvalidThreshold(t *testing.T) {
+	for name, threshold := range map[string]string{
+		"not a number": "abc",
+		"zero":         "0",
+		"negative":     "-5",
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := &mockInvestigationsOnHoldClient{}
+			client.
+				On("OnHoldInvestigations", mock.Anything).
+				Return([]sirius.Investigation{}, nil)
+
+			template := &mockTemplate{}
+			template.
+				On("Func", mock.Anything, investigationsOnHoldData{
+					Sort:      "oldest",
+					Threshold: defaultHoldThreshold,
+				}).
+				Return(nil)
+
+			r, _ := http.NewRequest(http.MethodGet, "/investigations/on-hold?threshold="+threshold, nil)
+			w := httptest.NewRecorder()
+
+			err := investigationsOnHoldWithNow(client, template.Func, func() time.Time { return investigationsOnHoldToday })(w, r)
+
+			assert.Nil(t, err)
+			mock.AssertExpectationsForObjects(t, client, template)
+		})
+	}
+}
+
+func TestGetInvestigationsOnHoldWhenBankHolidaysErrors(t *testing.T) {
+	client := &mockInvestigationsOnHoldClient{}
+	client.
+		On("OnHoldInvestigations", mock.Anything).
+		Return([]sirius.Investigation{shortHold}, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{}, errExample)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data investigationsOnHoldData) bool {
+			return assert.Len(t, data.Rows, 1) &&
+				assert.Equal(t, 5, data.Rows[0].Hold.CurrentWorkingDays) &&
+				assert.True(t, data.WithoutBankHolidays)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/investigations/on-hold", nil)
+	w := httptest.NewRecorder()
+
+	err := investigationsOnHoldWithNow(client, template.Func, func() time.Time { return investigationsOnHoldToday })(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetInvestigationsOnHoldWhenInvestigationsError(t *testing.T) {
+	client := &mockInvestigationsOnHoldClient{}
+	client.
+		On("OnHoldInvestigations", mock.Anything).
+		Return([]sirius.Investigation{}, errExample)
+
+	r, _ := http.NewRequest(http.MethodGet, "/investigations/on-hold", nil)
+	w := httptest.NewRecorder()
+
+	err := InvestigationsOnHold(client, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestGetInvestigationsOnHoldWhenTemplateErrors(t *testing.T) {
+	client := &mockInvestigationsOnHoldClient{}
+	client.
+		On("OnHoldInvestigations", mock.Anything).
+		Return([]sirius.Investigation{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.Anything).
+		Return(errExample)
+
+	r, _ := http.NewRequest(http.MethodGet, "/investigations/on-hold", nil)
+	w := httptest.NewRecorder()
+
+	err := InvestigationsOnHold(client, template.Func)(w, r)
+
+	assert.Equal(t, errExample, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/localize"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"golang.org/x/text/language"
+)
+
+type Templates interface {
+	Get(name string) template.Template
+}
+
+// LocalisedTemplates holds a set of parsed templates for each supported
+// language, and renders the set for the language of the current request
+type LocalisedTemplates map[language.Tag]Templates
+
+func (lt LocalisedTemplates) Get(name string) template.Template {
+	return func(w io.Writer, data interface{}) error {
+		templates, ok := lt[languageForWriter(w)]
+		if !ok {
+			templates = lt[localize.English]
+		}
+
+		return templates.Get(name)(w, data)
+	}
+}
+
+type languageResponseWriter struct {
+	http.ResponseWriter
+	lang language.Tag
+}
+
+func languageForWriter(w io.Writer) language.Tag {
+	if lw, ok := w.(*languageResponseWriter); ok {
+		return lw.lang
+	}
+
+	return localize.English
+}
+
+func languageFromRequest(r *http.Request) language.Tag {
+	if cookie, err := r.Cookie(localize.CookieName); err == nil {
+		lang, _ := localize.Parse(cookie.Value)
+		return lang
+	}
+
+	return localize.English
+}
+
+func languageHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(&languageResponseWriter{ResponseWriter: w, lang: languageFromRequest(r)}, r)
+	})
+}
+
+// LanguageToggle stores the chosen language in a cookie and returns the user
+// to the page they came from
+func LanguageToggle(siriusPublicURL string) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		lang, ok := localize.Parse(r.FormValue("lang"))
+		if !ok {
+			return sirius.StatusError{Code: http.StatusBadRequest}
+		}
+
+		c := &http.Cookie{
+			Name:     localize.CookieName,
+			Value:    localize.Code(lang),
+			HttpOnly: true,
+			Path:     "/",
+			MaxAge:   365 * 24 * 60 * 60,
+		}
+
+		if secureCookies {
+			c.SameSite = http.SameSiteLaxMode
+			c.Secure = true
+		}
+
+		http.SetCookie(w, c)
+
+		redirect := siriusPublicURL + "/lpa"
+		if referer, err := url.Parse(r.Referer()); err == nil && referer.Path != "" && !strings.HasPrefix(referer.Path, "//") {
+			redirect = referer.RequestURI()
+		}
+
+		http.Redirect(w, r, redirect, http.StatusFound)
+		return nil
+	}
+}
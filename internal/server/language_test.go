@@ -0,0 +1,110 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/localize"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockTemplates map[string]*mockTemplate
+
+func (m mockTemplates) Get(name string) template.Template {
+	return m[name].Func
+}
+
+func TestLocalisedTemplates(t *testing.T) {
+	english := &mockTemplate{}
+	english.On("Func", mock.Anything, "data").Return(nil)
+
+	welsh := &mockTemplate{}
+	welsh.On("Func", mock.Anything, "data").Return(nil)
+
+	templates := LocalisedTemplates{
+		localize.English: mockTemplates{"page.gohtml": english},
+		localize.Welsh:   mockTemplates{"page.gohtml": welsh},
+	}
+
+	tmpl := templates.Get("page.gohtml")
+
+	assert.Nil(t, tmpl(httptest.NewRecorder(), "data"))
+	english.AssertNumberOfCalls(t, "Func", 1)
+	welsh.AssertNumberOfCalls(t, "Func", 0)
+
+	assert.Nil(t, tmpl(&languageResponseWriter{ResponseWriter: httptest.NewRecorder(), lang: localize.Welsh}, "data"))
+	english.AssertNumberOfCalls(t, "Func", 1)
+	welsh.AssertNumberOfCalls(t, "Func", 1)
+}
+
+func TestLanguageHandler(t *testing.T) {
+	testCases := map[string]struct {
+		cookie   *http.Cookie
+		expected string
+	}{
+		"no cookie": {expected: "en"},
+		"welsh":     {cookie: &http.Cookie{Name: localize.CookieName, Value: "cy"}, expected: "cy"},
+		"english":   {cookie: &http.Cookie{Name: localize.CookieName, Value: "en"}, expected: "en"},
+		"unknown":   {cookie: &http.Cookie{Name: localize.CookieName, Value: "fr"}, expected: "en"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var lang string
+			handler := languageHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				lang = localize.Code(languageForWriter(w))
+			}))
+
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			if tc.cookie != nil {
+				req.AddCookie(tc.cookie)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(t, tc.expected, lang)
+		})
+	}
+}
+
+func TestLanguageToggle(t *testing.T) {
+	testCases := map[string]struct {
+		referer  string
+		expected string
+	}{
+		"no referer":        {expected: "http://sirius/lpa"},
+		"referer":           {referer: "http://localhost:8888/lpa-frontend/lpa/M-1234?x=y", expected: "/lpa-frontend/lpa/M-1234?x=y"},
+		"protocol relative": {referer: "http://localhost:8888//example.com", expected: "http://sirius/lpa"},
+		"unparseable":       {referer: "http://[::1", expected: "http://sirius/lpa"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/language?lang=cy", nil)
+			req.Header.Set("Referer", tc.referer)
+			w := httptest.NewRecorder()
+
+			err := LanguageToggle("http://sirius")(w, req)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusFound, resp.StatusCode)
+			assert.Equal(t, tc.expected, resp.Header.Get("Location"))
+			assert.Equal(t, localize.CookieName, resp.Cookies()[0].Name)
+			assert.Equal(t, "cy", resp.Cookies()[0].Value)
+		})
+	}
+}
+
+func TestLanguageToggleUnsupportedLanguage(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/language?lang=fr", nil)
+	w := httptest.NewRecorder()
+
+	err := LanguageToggle("http://sirius")(w, req)
+
+	assert.Equal(t, sirius.StatusError{Code: http.StatusBadRequest}, err)
+	assert.Empty(t, w.Result().Cookies())
+}
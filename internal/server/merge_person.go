@@ -0,0 +1,398 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"golang.org/x/sync/errgroup"
+)
+
+type MergePersonClient interface {
+	CasesByDonor(sirius.Context, int) ([]sirius.Case, error)
+	Documents(sirius.Context, sirius.CaseType, int, []string, []string) ([]sirius.Document, error)
+	MergePeople(sirius.Context, sirius.PersonMerge) error
+	Person(sirius.Context, int) (sirius.Person, error)
+	PersonByUid(sirius.Context, string) (sirius.Person, error)
+	WarningsForCase(sirius.Context, int) ([]sirius.Warning, error)
+}
+
+// mergePersonField is one of the details compared between the two records.
+// Choice is the ID of the person whose value the surviving record will keep,
+// or 0 if the values conflict and the caseworker has not chosen yet.
+type mergePersonField struct {
+	Name        string
+	Label       string
+	EntityValue string
+	OtherValue  string
+	Conflict    bool
+	Choice      int
+}
+
+type mergePersonData struct {
+	XSRFToken   string
+	Entity      sirius.Person
+	OtherPerson sirius.Person
+	SurvivorId  int
+	Fields      []mergePersonField
+	Cases       []sirius.Case
+	Documents   []sirius.Document
+	Warnings    []sirius.Warning
+	Error       sirius.ValidationError
+	Success     bool
+	CaseUids    string
+}
+
+// Survivor is the record that will be kept
+func (d mergePersonData) Survivor() sirius.Person {
+	if d.SurvivorId == d.OtherPerson.ID {
+		return d.OtherPerson
+	}
+	return d.Entity
+}
+
+// Merged is the record that will be merged into the survivor and removed
+func (d mergePersonData) Merged() sirius.Person {
+	if d.SurvivorId == d.OtherPerson.ID {
+		return d.Entity
+	}
+	return d.OtherPerson
+}
+
+type personMergeField struct {
+	name  string
+	label string
+	value func(sirius.Person) string
+	apply func(to *sirius.Person, from sirius.Person)
+}
+
+var personMergeFields = []personMergeField{
+	{
+		name:  "salutation",
+		label: "Salutation",
+		value: func(p sirius.Person) string { return p.Salutation },
+		apply: func(to *sirius.Person, from sirius.Person) { to.Salutation = from.Salutation },
+	},
+	{
+		name:  "firstname",
+		label: "First names",
+		value: func(p sirius.Person) string { return p.Firstname },
+		apply: func(to *sirius.Person, from sirius.Person) { to.Firstname = from.Firstname },
+	},
+	{
+		name:  "middlenames",
+		label: "Middle names",
+		value: func(p sirius.Person) string { return p.Middlenames },
+		apply: func(to *sirius.Person, from sirius.Person) { to.Middlenames = from.Middlenames },
+	},
+	{
+		name:  "surname",
+		label: "Last name",
+		value: func(p sirius.Person) string { return p.Surname },
+		apply: func(to *sirius.Person, from sirius.Person) { to.Surname = from.Surname },
+	},
+	{
+		name:  "dob",
+		label: "Date of birth",
+		value: func(p sirius.Person) string {
+			dob, _ := p.DateOfBirth.ToSirius()
+			return dob
+		},
+		apply: func(to *sirius.Person, from sirius.Person) { to.DateOfBirth = from.DateOfBirth },
+	},
+	{
+		name:  "previousNames",
+		label: "Previously known as",
+		value: func(p sirius.Person) string { return p.PreviouslyKnownAs },
+		apply: func(to *sirius.Person, from sirius.Person) { to.PreviouslyKnownAs = from.PreviouslyKnownAs },
+	},
+	{
+		name:  "otherNames",
+		label: "Also known as",
+		value: func(p sirius.Person) string { return p.AlsoKnownAs },
+		apply: func(to *sirius.Person, from sirius.Person) { to.AlsoKnownAs = from.AlsoKnownAs },
+	},
+	{
+		// the address is kept or replaced as a whole so lines from different
+		// addresses are never mixed together
+		name:  "address",
+		label: "Address",
+		value: func(p sirius.Person) string { return p.AddressSummary() },
+		apply: func(to *sirius.Person, from sirius.Person) {
+			to.AddressLine1 = from.AddressLine1
+			to.AddressLine2 = from.AddressLine2
+			to.AddressLine3 = from.AddressLine3
+			to.Town = from.Town
+			to.County = from.County
+			to.Postcode = from.Postcode
+			to.Country = from.Country
+			to.IsAirmailRequired = from.IsAirmailRequired
+		},
+	},
+	{
+		name:  "phoneNumber",
+		label: "Phone number",
+		value: func(p sirius.Person) string { return p.PhoneNumber },
+		apply: func(to *sirius.Person, from sirius.Person) { to.PhoneNumber = from.PhoneNumber },
+	},
+	{
+		name:  "email",
+		label: "Email",
+		value: func(p sirius.Person) string { return p.Email },
+		apply: func(to *sirius.Person, from sirius.Person) { to.Email = from.Email },
+	},
+}
+
+// compareMergeFields lists the details held on either record. Where only one
+// record has a value it is kept, and where both have the same value the
+// survivor's is kept; otherwise the choice posted for the field is used.
+func compareMergeFields(entity, other sirius.Person, survivorId int, form url.Values) []mergePersonField {
+	var fields []mergePersonField
+
+	for _, f := range personMergeFields {
+		field := mergePersonField{
+			Name:        f.name,
+			Label:       f.label,
+			EntityValue: f.value(entity),
+			OtherValue:  f.value(other),
+		}
+
+		switch {
+		case field.EntityValue == "" && field.OtherValue == "":
+			continue
+		case field.OtherValue == "":
+			field.Choice = entity.ID
+		case field.EntityValue == "":
+			field.Choice = other.ID
+		case sameMergeValue(field.EntityValue, field.OtherValue):
+			field.Choice = survivorId
+		default:
+			field.Conflict = true
+			if choice, err := strconv.Atoi(form.Get("field-" + f.name)); err == nil && (choice == entity.ID || choice == other.ID) {
+				field.Choice = choice
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+func sameMergeValue(a, b string) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(a), " "), strings.Join(strings.Fields(b), " "))
+}
+
+// buildPersonMerge applies the chosen values to the survivor, and records
+// where each of them came from for the audit event
+func buildPersonMerge(data mergePersonData) sirius.PersonMerge {
+	survivor, merged := data.Survivor(), data.Merged()
+
+	merge := sirius.PersonMerge{
+		SurvivorID:   survivor.ID,
+		MergedID:     merged.ID,
+		Person:       survivor,
+		FieldSources: map[string]int{},
+	}
+
+	for _, field := range data.Fields {
+		merge.FieldSources[field.Name] = field.Choice
+	}
+
+	for _, f := range personMergeFields {
+		if merge.FieldSources[f.name] == merged.ID {
+			f.apply(&merge.Person, merged)
+		}
+	}
+
+	for _, c := range data.Cases {
+		merge.CaseIDs = append(merge.CaseIDs, c.ID)
+	}
+	for _, d := range data.Documents {
+		merge.DocumentIDs = append(merge.DocumentIDs, d.ID)
+	}
+	for _, w := range data.Warnings {
+		merge.WarningIDs = append(merge.WarningIDs, w.ID)
+	}
+
+	return merge
+}
+
+// mergeMoves finds the cases of the person being merged, along with the
+// documents and warnings on those cases, as these will all move to the
+// survivor. A warning on more than one case is only listed once.
+func mergeMoves(ctx sirius.Context, client MergePersonClient, personId int) ([]sirius.Case, []sirius.Document, []sirius.Warning, error) {
+	cases, err := client.CasesByDonor(ctx, personId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		mu        sync.Mutex
+		documents []sirius.Document
+		warnings  = map[int]sirius.Warning{}
+	)
+
+	group, groupCtx := errgroup.WithContext(ctx.Context)
+
+	for _, c := range cases {
+		group.Go(func() error {
+			caseWarnings, err := client.WarningsForCase(ctx.With(groupCtx), c.ID)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			for _, w := range caseWarnings {
+				warnings[w.ID] = w
+			}
+			mu.Unlock()
+
+			return nil
+		})
+
+		caseType, err := sirius.ParseCaseType(c.CaseType)
+		if err != nil {
+			continue
+		}
+
+		group.Go(func() error {
+			caseDocuments, err := client.Documents(ctx.With(groupCtx), caseType, c.ID, []string{}, []string{sirius.TypeDraft, sirius.TypePreview})
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			documents = append(documents, caseDocuments...)
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	sort.Slice(documents, func(i, j int) bool {
+		return documents[i].ID > documents[j].ID
+	})
+
+	warningList := make([]sirius.Warning, 0, len(warnings))
+	for _, w := range warnings {
+		warningList = append(warningList, w)
+	}
+	sort.Slice(warningList, func(i, j int) bool {
+		return warningList[i].ID < warningList[j].ID
+	})
+
+	return cases, documents, warningList, nil
+}
+
+func MergePerson(client MergePersonClient, tmpl template.Template, partialTmpl template.Template) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		personID, err := strToIntOrStatusError(r.FormValue("id"))
+		if err != nil {
+			return err
+		}
+
+		ctx := getContext(r)
+		data := mergePersonData{
+			XSRFToken: ctx.XSRFToken,
+			CaseUids:  buildUIDQueryString(r.Form["uid[]"]),
+		}
+
+		data.Entity, err = client.Person(ctx, personID)
+		if err != nil {
+			return err
+		}
+
+		render := func() error {
+			if r.Header.Get("HX-Request") == "true" {
+				return partialTmpl(w, data)
+			}
+
+			return tmpl(w, data)
+		}
+
+		if r.Method != http.MethodPost {
+			return render()
+		}
+
+		data.OtherPerson, err = client.PersonByUid(ctx, postFormString(r, "uid"))
+		if ve, ok := err.(sirius.StatusError); ok && ve.Code == http.StatusNotFound {
+			w.WriteHeader(http.StatusBadRequest)
+			data.Error = sirius.ValidationError{
+				Field: sirius.FieldErrors{
+					"uid": {"notFound": "A record matching the supplied uId cannot be found."},
+				},
+			}
+
+			return render()
+		} else if err != nil {
+			return err
+		}
+
+		if data.OtherPerson.ID == data.Entity.ID {
+			w.WriteHeader(http.StatusBadRequest)
+			data.OtherPerson = sirius.Person{}
+			data.Error = sirius.ValidationError{
+				Field: sirius.FieldErrors{
+					"uid": {"sameRecord": "A record cannot be merged with itself."},
+				},
+			}
+
+			return render()
+		}
+
+		data.SurvivorId = data.Entity.ID
+		if survivorId, err := postFormInt(r, "survivor-id"); err == nil && survivorId == data.OtherPerson.ID {
+			data.SurvivorId = survivorId
+		}
+
+		data.Fields = compareMergeFields(data.Entity, data.OtherPerson, data.SurvivorId, r.PostForm)
+
+		data.Cases, data.Documents, data.Warnings, err = mergeMoves(ctx, client, data.Merged().ID)
+		if err != nil {
+			return err
+		}
+
+		if postFormString(r, "action") != "merge" {
+			return render()
+		}
+
+		fieldErrors := sirius.FieldErrors{}
+		for _, field := range data.Fields {
+			if field.Choice == 0 {
+				fieldErrors["field-"+field.Name] = map[string]string{
+					"required": fmt.Sprintf("Select which %s to keep", strings.ToLower(field.Label)),
+				}
+			}
+		}
+
+		if len(fieldErrors) > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			data.Error = sirius.ValidationError{Field: fieldErrors}
+
+			return render()
+		}
+
+		err = client.MergePeople(ctx, buildPersonMerge(data))
+		if ve, ok := err.(sirius.ValidationError); ok {
+			w.WriteHeader(http.StatusBadRequest)
+			data.Error = ve
+		} else if err != nil {
+			return err
+		} else {
+			data.Success = true
+		}
+
+		return render()
+	}
+}
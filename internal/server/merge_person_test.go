@@ -0,0 +1,746 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockMergePersonClient struct {
+	mock.Mock
+}
+
+func (m *mockMergePersonClient) CasesByDonor(ctx sirius.Context, id int) ([]sirius.Case, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]sirius.Case), args.Error(1)
+}
+
+func (m *mockMergePersonClient) Documents(ctx sirius.Context, caseType sirius.CaseType, caseId int, docTypes []string, notDocTypes []string) ([]sirius.Document, error) {
+	args := m.Called(ctx, caseType, caseId, docTypes, notDocTypes)
+	return args.Get(0).([]sirius.Document), args.Error(1)
+}
+
+func (m *mockMergePersonClient) MergePeople(ctx sirius.Context, merge sirius.PersonMerge) error {
+	args := m.Called(ctx, merge)
+	return args.Error(0)
+}
+
+func (m *mockMergePersonClient) Person(ctx sirius.Context, id int) (sirius.Person, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(sirius.Person), args.Error(1)
+}
+
+func (m *mockMergePersonClient) PersonByUid(ctx sirius.Context, uid string) (sirius.Person, error) {
+	args := m.Called(ctx, uid)
+	return args.Get(0).(sirius.Person), args.Error(1)
+}
+
+func (m *mockMergePersonClient) WarningsForCase(ctx sirius.Context, caseId int) ([]sirius.Warning, error) {
+	args := m.Called(ctx, caseId)
+	return args.Get(0).([]sirius.Warning), args.Error(1)
+}
+
+func TestMergePerson(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+
+	for _, isHtmx := range []bool{true, false} {
+		t.Run("Is HTMX"+strconv.FormatBool(isHtmx), func(t *testing.T) {
+			client := &mockMergePersonClient{}
+			client.
+				On("Person", mock.Anything, 123).
+				Return(entity, nil)
+
+			template := &mockTemplate{}
+			template.
+				On("Func", mock.Anything, mergePersonData{
+					Entity: entity,
+				}).
+				Return(nil)
+
+			r, _ := http.NewRequest(http.MethodGet, "/?id=123", nil)
+			w := httptest.NewRecorder()
+
+			if isHtmx {
+				r.Header.Add("HX-Request", "true")
+			}
+
+			err := MergePerson(client, template.Func, template.Func)(w, r)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			mock.AssertExpectationsForObjects(t, client, template)
+		})
+	}
+}
+
+func TestMergePersonNoID(t *testing.T) {
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(nil, nil, nil)(w, r)
+
+	assert.Equal(t, sirius.StatusError{Code: http.StatusNotFound}, err)
+}
+
+func TestMergePersonGetFails(t *testing.T) {
+	client := &mockMergePersonClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(sirius.Person{}, errExample)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123", nil)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(client, nil, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+}
+
+func TestPostMergePersonNotFound(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+
+	client := &mockMergePersonClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(entity, nil)
+	client.
+		On("PersonByUid", mock.Anything, "7000-0000-0009").
+		Return(sirius.Person{}, sirius.StatusError{Code: http.StatusNotFound})
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mergePersonData{
+			Entity: entity,
+			Error: sirius.ValidationError{
+				Field: sirius.FieldErrors{
+					"uid": {"notFound": "A record matching the supplied uId cannot be found."},
+				},
+			},
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader("uid=7000-0000-0009"))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(client, template.Func, nil)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostMergePersonWithItself(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+
+	client := &mockMergePersonClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(entity, nil)
+	client.
+		On("PersonByUid", mock.Anything, "7000-0000-0001").
+		Return(entity, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mergePersonData{
+			Entity: entity,
+			Error: sirius.ValidationError{
+				Field: sirius.FieldErrors{
+					"uid": {"sameRecord": "A record cannot be merged with itself."},
+				},
+			},
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader("uid=7000-0000-0001"))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(client, template.Func, nil)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostMergePersonPreview(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+	other := sirius.Person{
+		ID:           456,
+		UID:          "7000-0000-0002",
+		Firstname:    "Jon",
+		Surname:      "doe",
+		AddressLine1: "2 High Street",
+		Postcode:     "EF3 4GH",
+		PhoneNumber:  "01234567890",
+	}
+	cases := []sirius.Case{
+		{ID: 800, UID: "7000-1000-0001", CaseType: "LPA"},
+		{ID: 801, UID: "7000-1000-0002", CaseType: "DIGITAL_LPA"},
+	}
+	documents := []sirius.Document{{ID: 900, FriendlyDescription: "Letter"}}
+	warnings := []sirius.Warning{{ID: 700, WarningType: "Complaint Received"}}
+
+	client := &mockMergePersonClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(entity, nil)
+	client.
+		On("PersonByUid", mock.Anything, "7000-0000-0002").
+		Return(other, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 456).
+		Return(cases, nil)
+	client.
+		On("WarningsForCase", mock.Anything, 800).
+		Return(warnings, nil)
+	client.
+		On("WarningsForCase", mock.Anything, 801).
+		Return(warnings, nil)
+	client.
+		On("Documents", mock.Anything, sirius.CaseTypeLpa, 800, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+		Return(documents, nil)
+	client.
+		On("Documents", mock.Anything, sirius.CaseTypeDigitalLpa, 801, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+		Return([]sirius.Document{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mergePersonData{
+			Entity:      entity,
+			OtherPerson: other,
+			SurvivorId:  123,
+			Fields: []mergePersonField{
+				{Name: "firstname", Label: "First names", EntityValue: "John", OtherValue: "Jon", Conflict: true},
+				{Name: "surname", Label: "Last name", EntityValue: "Doe", OtherValue: "doe", Choice: 123},
+				{Name: "dob", Label: "Date of birth", EntityValue: "02/01/1950", Choice: 123},
+				{Name: "address", Label: "Address", EntityValue: "1 Main Street, AB1 2CD", OtherValue: "2 High Street, EF3 4GH", Conflict: true, Choice: 456},
+				{Name: "phoneNumber", Label: "Phone number", OtherValue: "01234567890", Choice: 456},
+			},
+			Cases:     cases,
+			Documents: documents,
+			Warnings:  warnings,
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"uid":           {"7000-0000-0002"},
+		"field-address": {"456"},
+		"action":        {"preview"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(client, template.Func, nil)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostMergePersonPreviewWhenMovesFail(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+	other := sirius.Person{
+		ID:           456,
+		UID:          "7000-0000-0002",
+		Firstname:    "Jon",
+		Surname:      "doe",
+		AddressLine1: "2 High Street",
+		Postcode:     "EF3 4GH",
+		PhoneNumber:  "01234567890",
+	}
+
+	client := &mockMergePersonClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(entity, nil)
+	client.
+		On("PersonByUid", mock.Anything, "7000-0000-0002").
+		Return(other, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 456).
+		Return([]sirius.Case{}, errExample)
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader("uid=7000-0000-0002"))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(client, nil, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+}
+
+func TestPostMergePersonWhenChoiceMissing(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+	other := sirius.Person{
+		ID:           456,
+		UID:          "7000-0000-0002",
+		Firstname:    "Jon",
+		Surname:      "doe",
+		AddressLine1: "2 High Street",
+		Postcode:     "EF3 4GH",
+		PhoneNumber:  "01234567890",
+	}
+	cases := []sirius.Case{
+		{ID: 800, UID: "7000-1000-0001", CaseType: "LPA"},
+		{ID: 801, UID: "7000-1000-0002", CaseType: "DIGITAL_LPA"},
+	}
+	documents := []sirius.Document{{ID: 900, FriendlyDescription: "Letter"}}
+	warnings := []sirius.Warning{{ID: 700, WarningType: "Complaint Received"}}
+
+	client := &mockMergePersonClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(entity, nil)
+	client.
+		On("PersonByUid", mock.Anything, "7000-0000-0002").
+		Return(other, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 456).
+		Return(cases, nil)
+	client.
+		On("WarningsForCase", mock.Anything, 800).
+		Return(warnings, nil)
+	client.
+		On("WarningsForCase", mock.Anything, 801).
+		Return(warnings, nil)
+	client.
+		On("Documents", mock.Anything, sirius.CaseTypeLpa, 800, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+		Return(documents, nil)
+	client.
+		On("Documents", mock.Anything, sirius.CaseTypeDigitalLpa, 801, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+		Return([]sirius.Document{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data mergePersonData) bool {
+			return assert.Equal(t, sirius.ValidationError{
+				Field: sirius.FieldErrors{
+					"field-firstname": {"required": "Select which first names to keep"},
+				},
+			}, data.Error) && !data.Success
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"uid":           {"7000-0000-0002"},
+		"field-address": {"456"},
+		"action":        {"merge"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(client, template.Func, nil)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostMergePerson(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+	other := sirius.Person{
+		ID:           456,
+		UID:          "7000-0000-0002",
+		Firstname:    "Jon",
+		Surname:      "doe",
+		AddressLine1: "2 High Street",
+		Postcode:     "EF3 4GH",
+		PhoneNumber:  "01234567890",
+	}
+	cases := []sirius.Case{
+		{ID: 800, UID: "7000-1000-0001", CaseType: "LPA"},
+		{ID: 801, UID: "7000-1000-0002", CaseType: "DIGITAL_LPA"},
+	}
+	documents := []sirius.Document{{ID: 900, FriendlyDescription: "Letter"}}
+	warnings := []sirius.Warning{{ID: 700, WarningType: "Complaint Received"}}
+
+	for _, isHtmx := range []bool{true, false} {
+		t.Run("Is HTMX"+strconv.FormatBool(isHtmx), func(t *testing.T) {
+			client := &mockMergePersonClient{}
+			client.
+				On("Person", mock.Anything, 123).
+				Return(entity, nil)
+			client.
+				On("PersonByUid", mock.Anything, "7000-0000-0002").
+				Return(other, nil)
+			client.
+				On("CasesByDonor", mock.Anything, 456).
+				Return(cases, nil)
+			client.
+				On("WarningsForCase", mock.Anything, 800).
+				Return(warnings, nil)
+			client.
+				On("WarningsForCase", mock.Anything, 801).
+				Return(warnings, nil)
+			client.
+				On("Documents", mock.Anything, sirius.CaseTypeLpa, 800, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+				Return(documents, nil)
+			client.
+				On("Documents", mock.Anything, sirius.CaseTypeDigitalLpa, 801, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+				Return([]sirius.Document{}, nil)
+			client.
+				On("MergePeople", mock.Anything, sirius.PersonMerge{
+					SurvivorID: 123,
+					MergedID:   456,
+					Person: sirius.Person{
+						ID:           123,
+						UID:          "7000-0000-0001",
+						Firstname:    "Jon",
+						Surname:      "Doe",
+						DateOfBirth:  "1950-01-02",
+						AddressLine1: "1 Main Street",
+						Postcode:     "AB1 2CD",
+						PhoneNumber:  "01234567890",
+					},
+					FieldSources: map[string]int{
+						"firstname":   456,
+						"surname":     123,
+						"dob":         123,
+						"address":     123,
+						"phoneNumber": 456,
+					},
+					CaseIDs:     []int{800, 801},
+					DocumentIDs: []int{900},
+					WarningIDs:  []int{700},
+				}).
+				Return(nil)
+
+			template := &mockTemplate{}
+			template.
+				On("Func", mock.Anything, mock.MatchedBy(func(data mergePersonData) bool {
+					return data.Success && !data.Error.Any()
+				})).
+				Return(nil)
+
+			form := url.Values{
+				"uid":             {"7000-0000-0002"},
+				"survivor-id":     {"123"},
+				"field-firstname": {"456"},
+				"field-address":   {"123"},
+				"action":          {"merge"},
+			}
+
+			r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+			r.Header.Add("Content-Type", formUrlEncoded)
+			w := httptest.NewRecorder()
+
+			if isHtmx {
+				r.Header.Add("HX-Request", "true")
+			}
+
+			err := MergePerson(client, template.Func, template.Func)(w, r)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			mock.AssertExpectationsForObjects(t, client, template)
+		})
+	}
+}
+
+func TestPostMergePersonIntoOtherPerson(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+	other := sirius.Person{
+		ID:           456,
+		UID:          "7000-0000-0002",
+		Firstname:    "Jon",
+		Surname:      "doe",
+		AddressLine1: "2 High Street",
+		Postcode:     "EF3 4GH",
+		PhoneNumber:  "01234567890",
+	}
+
+	client := &mockMergePersonClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(entity, nil)
+	client.
+		On("PersonByUid", mock.Anything, "7000-0000-0002").
+		Return(other, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 123).
+		Return([]sirius.Case{}, nil)
+	client.
+		On("MergePeople", mock.Anything, sirius.PersonMerge{
+			SurvivorID: 456,
+			MergedID:   123,
+			Person: sirius.Person{
+				ID:           456,
+				UID:          "7000-0000-0002",
+				Firstname:    "John",
+				Surname:      "doe",
+				DateOfBirth:  "1950-01-02",
+				AddressLine1: "2 High Street",
+				Postcode:     "EF3 4GH",
+				PhoneNumber:  "01234567890",
+			},
+			FieldSources: map[string]int{
+				"firstname":   123,
+				"surname":     456,
+				"dob":         123,
+				"address":     456,
+				"phoneNumber": 456,
+			},
+		}).
+		Return(nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data mergePersonData) bool {
+			return data.Success && data.SurvivorId == 456
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"uid":             {"7000-0000-0002"},
+		"survivor-id":     {"456"},
+		"field-firstname": {"123"},
+		"field-address":   {"456"},
+		"action":          {"merge"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(client, template.Func, nil)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostMergePersonWhenValidationError(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+	other := sirius.Person{
+		ID:           456,
+		UID:          "7000-0000-0002",
+		Firstname:    "Jon",
+		Surname:      "doe",
+		AddressLine1: "2 High Street",
+		Postcode:     "EF3 4GH",
+		PhoneNumber:  "01234567890",
+	}
+	cases := []sirius.Case{
+		{ID: 800, UID: "7000-1000-0001", CaseType: "LPA"},
+		{ID: 801, UID: "7000-1000-0002", CaseType: "DIGITAL_LPA"},
+	}
+	documents := []sirius.Document{{ID: 900, FriendlyDescription: "Letter"}}
+	warnings := []sirius.Warning{{ID: 700, WarningType: "Complaint Received"}}
+
+	expectedError := sirius.ValidationError{
+		Field: sirius.FieldErrors{
+			"mergedId": {"hasChildren": "The record has linked records"},
+		},
+	}
+
+	client := &mockMergePersonClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(entity, nil)
+	client.
+		On("PersonByUid", mock.Anything, "7000-0000-0002").
+		Return(other, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 456).
+		Return(cases, nil)
+	client.
+		On("WarningsForCase", mock.Anything, 800).
+		Return(warnings, nil)
+	client.
+		On("WarningsForCase", mock.Anything, 801).
+		Return(warnings, nil)
+	client.
+		On("Documents", mock.Anything, sirius.CaseTypeLpa, 800, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+		Return(documents, nil)
+	client.
+		On("Documents", mock.Anything, sirius.CaseTypeDigitalLpa, 801, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+		Return([]sirius.Document{}, nil)
+	client.
+		On("MergePeople", mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data mergePersonData) bool {
+			return assert.Equal(t, expectedError, data.Error) && !data.Success
+		})).
+		Return(nil)
+
+	form := url.Values{
+		"uid":             {"7000-0000-0002"},
+		"field-firstname": {"123"},
+		"field-address":   {"123"},
+		"action":          {"merge"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(client, template.Func, nil)(w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostMergePersonWhenMergeErrors(t *testing.T) {
+	entity := sirius.Person{
+		ID:           123,
+		UID:          "7000-0000-0001",
+		Firstname:    "John",
+		Surname:      "Doe",
+		DateOfBirth:  "1950-01-02",
+		AddressLine1: "1 Main Street",
+		Postcode:     "AB1 2CD",
+	}
+	other := sirius.Person{
+		ID:           456,
+		UID:          "7000-0000-0002",
+		Firstname:    "Jon",
+		Surname:      "doe",
+		AddressLine1: "2 High Street",
+		Postcode:     "EF3 4GH",
+		PhoneNumber:  "01234567890",
+	}
+	cases := []sirius.Case{
+		{ID: 800, UID: "7000-1000-0001", CaseType: "LPA"},
+		{ID: 801, UID: "7000-1000-0002", CaseType: "DIGITAL_LPA"},
+	}
+	documents := []sirius.Document{{ID: 900, FriendlyDescription: "Letter"}}
+	warnings := []sirius.Warning{{ID: 700, WarningType: "Complaint Received"}}
+
+	client := &mockMergePersonClient{}
+	client.
+		On("Person", mock.Anything, 123).
+		Return(entity, nil)
+	client.
+		On("PersonByUid", mock.Anything, "7000-0000-0002").
+		Return(other, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 456).
+		Return(cases, nil)
+	client.
+		On("WarningsForCase", mock.Anything, 800).
+		Return(warnings, nil)
+	client.
+		On("WarningsForCase", mock.Anything, 801).
+		Return(warnings, nil)
+	client.
+		On("Documents", mock.Anything, sirius.CaseTypeLpa, 800, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+		Return(documents, nil)
+	client.
+		On("Documents", mock.Anything, sirius.CaseTypeDigitalLpa, 801, []string{}, []string{sirius.TypeDraft, sirius.TypePreview}).
+		Return([]sirius.Document{}, nil)
+	client.
+		On("MergePeople", mock.Anything, mock.Anything).
+		Return(errExample)
+
+	form := url.Values{
+		"uid":             {"7000-0000-0002"},
+		"field-firstname": {"123"},
+		"field-address":   {"123"},
+		"action":          {"merge"},
+	}
+
+	r, _ := http.NewRequest(http.MethodPost, "/?id=123", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	w := httptest.NewRecorder()
+
+	err := MergePerson(client, nil, nil)(w, r)
+
+	assert.Equal(t, errExample, err)
+}
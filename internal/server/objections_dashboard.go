@@ -0,0 +1,114 @@
+package server
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+type ObjectionsDashboardClient interface {
+	UnresolvedObjections(ctx sirius.Context) ([]sirius.Objection, error)
+	DigitalLpa(ctx sirius.Context, uid string, presignImages bool) (sirius.DigitalLpa, error)
+	BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error)
+}
+
+// objectionsDashboardRow is an objection against a single LPA that does not
+// yet have an outcome recorded
+type objectionsDashboardRow struct {
+	Objection sirius.Objection
+	LpaUid    string
+	Donor     sirius.Donor
+	Deadline  sirius.ObjectionDeadline
+}
+
+type objectionsDashboardData struct {
+	XSRFToken           string
+	Rows                []objectionsDashboardRow
+	WithoutBankHolidays bool
+}
+
+func ObjectionsDashboard(client ObjectionsDashboardClient, tmpl template.Template) Handler {
+	return objectionsDashboardWithNow(client, tmpl, time.Now)
+}
+
+func objectionsDashboardWithNow(client ObjectionsDashboardClient, tmpl template.Template, now func() time.Time) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ctx := getContext(r)
+
+		objections, err := client.UnresolvedObjections(ctx)
+		if err != nil {
+			return err
+		}
+
+		var uids []string
+		lpas := map[string]*sirius.SiriusData{}
+		for _, objection := range objections {
+			for _, uid := range objection.LpaUids {
+				if _, ok := lpas[uid]; !ok && !objection.IsResolvedFor(uid) {
+					lpas[uid] = &sirius.SiriusData{}
+					uids = append(uids, uid)
+				}
+			}
+		}
+
+		group, groupCtx := errgroup.WithContext(ctx.Context)
+		group.SetLimit(5)
+
+		for _, uid := range uids {
+			lpa := lpas[uid]
+
+			group.Go(func() error {
+				digitalLpa, err := client.DigitalLpa(ctx.With(groupCtx), uid, false)
+				if se, ok := err.(sirius.StatusError); ok && se.Code == http.StatusNotFound {
+					return nil
+				} else if err != nil {
+					return err
+				}
+
+				*lpa = digitalLpa.SiriusData
+				return nil
+			})
+		}
+
+		if err := group.Wait(); err != nil {
+			return err
+		}
+
+		data := objectionsDashboardData{XSRFToken: ctx.XSRFToken}
+		today := now()
+
+		var bankHolidays sirius.BankHolidays
+		if len(uids) > 0 {
+			bankHolidays, data.WithoutBankHolidays = bankHolidaysOrWarn(ctx, client)
+		}
+
+		for _, objection := range objections {
+			for _, uid := range objection.LpaUids {
+				if objection.IsResolvedFor(uid) {
+					continue
+				}
+
+				deadline := sirius.NewObjectionDeadline(objection, lpas[uid].NoticeGivenDate, today, bankHolidays)
+				deadline.WithoutBankHolidays = data.WithoutBankHolidays
+
+				data.Rows = append(data.Rows, objectionsDashboardRow{
+					Objection: objection,
+					LpaUid:    uid,
+					Donor:     lpas[uid].Donor,
+					Deadline:  deadline,
+				})
+			}
+		}
+
+		sort.SliceStable(data.Rows, func(i, j int) bool {
+			return data.Rows[i].Deadline.WorkingDaysOpen > data.Rows[j].Deadline.WorkingDaysOpen
+		})
+
+		return tmpl(w, data)
+	}
+}
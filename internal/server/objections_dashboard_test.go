@@ -0,0 +1,207 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockObjectionsDashboardClient struct {
+	mock.Mock
+}
+
+func (m *mockObjectionsDashboardClient) UnresolvedObjections(ctx sirius.Context) ([]sirius.Objection, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]sirius.Objection), args.Error(1)
+}
+
+func (m *mockObjectionsDashboardClient) DigitalLpa(ctx sirius.Context, uid string, presignImages bool) (sirius.DigitalLpa, error) {
+	args := m.Called(ctx, uid, presignImages)
+	return args.Get(0).(sirius.DigitalLpa), args.Error(1)
+}
+
+func (m *mockObjectionsDashboardClient) BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(sirius.BankHolidays), args.Error(1)
+}
+
+func TestGetObjectionsDashboard(t *testing.T) {
+	today := time.Date(2024, time.May, 31, 9, 0, 0, 0, time.UTC)
+
+	newer := sirius.Objection{
+		ID:            2,
+		ReceivedDate:  "2024-05-20",
+		ObjectionType: "factual",
+		LpaUids:       []string{"M-1111-1111-1111"},
+	}
+	older := sirius.Objection{
+		ID:            1,
+		ReceivedDate:  "2024-05-01",
+		ObjectionType: "prescribed",
+		LpaUids:       []string{"M-1111-1111-1111", "M-2222-2222-2222", "M-3333-3333-3333"},
+		Resolutions: []sirius.ObjectionResolution{
+			{Uid: "M-2222-2222-2222", Resolution: "upheld"},
+		},
+	}
+
+	bankHolidays := sirius.BankHolidays{
+		"england-and-wales": {"Spring bank holiday": "2024-05-27T00:00:00+01:00"},
+	}
+
+	lpa1 := sirius.DigitalLpa{SiriusData: sirius.SiriusData{
+		UID:             "M-1111-1111-1111",
+		NoticeGivenDate: "2024-04-10",
+		Donor:           sirius.Donor{Firstname: "Zoraida", Surname: "Swanberg"},
+	}}
+
+	client := &mockObjectionsDashboardClient{}
+	client.
+		On("UnresolvedObjections", mock.Anything).
+		Return([]sirius.Objection{newer, older}, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(bankHolidays, nil)
+	client.
+		On("DigitalLpa", mock.Anything, "M-1111-1111-1111", false).
+		Return(lpa1, nil)
+	client.
+		On("DigitalLpa", mock.Anything, "M-3333-3333-3333", false).
+		Return(sirius.DigitalLpa{}, sirius.StatusError{Code: http.StatusNotFound})
+
+	inTime := true
+	outOfTime := false
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, objectionsDashboardData{
+			Rows: []objectionsDashboardRow{
+				{
+					Objection: older,
+					LpaUid:    "M-1111-1111-1111",
+					Donor:     lpa1.SiriusData.Donor,
+					Deadline:  sirius.ObjectionDeadline{WorkingDaysOpen: 21, Deadline: "2024-05-07", InTime: &inTime},
+				},
+				{
+					Objection: older,
+					LpaUid:    "M-3333-3333-3333",
+					Deadline:  sirius.ObjectionDeadline{WorkingDaysOpen: 21},
+				},
+				{
+					Objection: newer,
+					LpaUid:    "M-1111-1111-1111",
+					Donor:     lpa1.SiriusData.Donor,
+					Deadline:  sirius.ObjectionDeadline{WorkingDaysOpen: 8, Deadline: "2024-05-07", InTime: &outOfTime},
+				},
+			},
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/objections", nil)
+	w := httptest.NewRecorder()
+
+	err := objectionsDashboardWithNow(client, template.Func, func() time.Time { return today })(w, r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetObjectionsDashboardWhenBankHolidaysError(t *testing.T) {
+	objection := sirius.Objection{ID: 1, ReceivedDate: "2024-05-20", LpaUids: []string{"M-1111-1111-1111"}}
+
+	client := &mockObjectionsDashboardClient{}
+	client.
+		On("UnresolvedObjections", mock.Anything).
+		Return([]sirius.Objection{objection}, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays(nil), errExample)
+	client.
+		On("DigitalLpa", mock.Anything, "M-1111-1111-1111", false).
+		Return(sirius.DigitalLpa{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, objectionsDashboardData{
+			Rows: []objectionsDashboardRow{
+				{
+					Objection: objection,
+					LpaUid:    "M-1111-1111-1111",
+					Deadline:  sirius.ObjectionDeadline{WorkingDaysOpen: 9, WithoutBankHolidays: true},
+				},
+			},
+			WithoutBankHolidays: true,
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/objections", nil)
+	w := httptest.NewRecorder()
+
+	err := objectionsDashboardWithNow(client, template.Func, func() time.Time {
+		return time.Date(2024, time.May, 31, 9, 0, 0, 0, time.UTC)
+	})(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetObjectionsDashboardWhenNoObjections(t *testing.T) {
+	client := &mockObjectionsDashboardClient{}
+	client.
+		On("UnresolvedObjections", mock.Anything).
+		Return([]sirius.Objection{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, objectionsDashboardData{}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/objections", nil)
+	w := httptest.NewRecorder()
+
+	err := ObjectionsDashboard(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+	client.AssertNotCalled(t, "BankHolidays", mock.Anything)
+}
+
+func TestGetObjectionsDashboardWhenClientErrors(t *testing.T) {
+	objection := sirius.Objection{ID: 1, ReceivedDate: "2024-05-01", LpaUids: []string{"M-1111-1111-1111"}}
+
+	testCases := map[string]func(*mockObjectionsDashboardClient){
+		"objections": func(client *mockObjectionsDashboardClient) {
+			client.
+				On("UnresolvedObjections", mock.Anything).
+				Return([]sirius.Objection{}, errExample)
+		},
+		"digital lpa": func(client *mockObjectionsDashboardClient) {
+			client.
+				On("UnresolvedObjections", mock.Anything).
+				Return([]sirius.Objection{objection}, nil)
+			client.
+				On("DigitalLpa", mock.Anything, "M-1111-1111-1111", false).
+				Return(sirius.DigitalLpa{}, errExample)
+		},
+	}
+
+	for name, setup := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockObjectionsDashboardClient{}
+			setup(client)
+
+			r, _ := http.NewRequest(http.MethodGet, "/objections", nil)
+			w := httptest.NewRecorder()
+
+			err := ObjectionsDashboard(client, nil)(w, r)
+
+			assert.Equal(t, errExample, err)
+			mock.AssertExpectationsForObjects(t, client)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/ministryofjustice/opg-go-common/telemetry"
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	personNetworkDefaultDepth = 1
+	personNetworkMaxDepth     = 3
+
+	// personNetworkMaxSearches limits how many people are searched for on
+	// other donors' LPAs, so a large network does not flood Sirius
+	personNetworkMaxSearches = 30
+	personNetworkConcurrency = 5
+)
+
+type PersonNetworkClient interface {
+	Case(sirius.Context, int) (sirius.Case, error)
+	CasesByDonor(sirius.Context, int) ([]sirius.Case, error)
+	Person(sirius.Context, int) (sirius.Person, error)
+	PersonReferences(sirius.Context, int) ([]sirius.PersonReference, error)
+	Search(ctx sirius.Context, term string, page int, personTypeFilters []string) (sirius.SearchResponse, *sirius.Pagination, error)
+}
+
+type personNetworkData struct {
+	Donor        sirius.Person
+	Depth        int
+	Depths       []int
+	Network      *personNetwork
+	SearchFailed bool
+}
+
+// personNetworkRecords are what is fetched for each person expanded in the
+// network
+type personNetworkRecords struct {
+	person     sirius.Person
+	references []sirius.PersonReference
+	cases      []sirius.Case
+}
+
+func fetchPersonNetworkRecords(ctx sirius.Context, client PersonNetworkClient, id int) (personNetworkRecords, error) {
+	var records personNetworkRecords
+
+	group, groupCtx := errgroup.WithContext(ctx.Context)
+
+	group.Go(func() error {
+		var err error
+		records.person, err = client.Person(ctx.With(groupCtx), id)
+		return err
+	})
+
+	group.Go(func() error {
+		var err error
+		records.references, err = client.PersonReferences(ctx.With(groupCtx), id)
+		return err
+	})
+
+	group.Go(func() error {
+		cases, err := client.CasesByDonor(ctx.With(groupCtx), id)
+		if err != nil {
+			return err
+		}
+
+		// the case list does not include the people on each case
+		records.cases = make([]sirius.Case, len(cases))
+
+		caseGroup, caseCtx := errgroup.WithContext(groupCtx)
+		caseGroup.SetLimit(personNetworkConcurrency)
+
+		for i, c := range cases {
+			caseGroup.Go(func() error {
+				var err error
+				records.cases[i], err = client.Case(ctx.With(caseCtx), c.ID)
+				return err
+			})
+		}
+
+		return caseGroup.Wait()
+	})
+
+	return records, group.Wait()
+}
+
+// buildPersonNetwork starts from the donor and adds the people on their cases,
+// the records linked to theirs and the people they reference. Linked and
+// referenced people are expanded in the same way until depth is reached.
+func buildPersonNetwork(ctx sirius.Context, client PersonNetworkClient, donorID int, depth int) (*personNetwork, sirius.Person, error) {
+	var donor sirius.Person
+
+	network := newPersonNetwork()
+	seen := map[int]bool{donorID: true}
+	frontier := []int{donorID}
+
+	for level := 0; level < depth && len(frontier) > 0; level++ {
+		records := make([]personNetworkRecords, len(frontier))
+
+		group, groupCtx := errgroup.WithContext(ctx.Context)
+		group.SetLimit(personNetworkConcurrency)
+
+		for i, id := range frontier {
+			group.Go(func() error {
+				var err error
+				records[i], err = fetchPersonNetworkRecords(ctx.With(groupCtx), client, id)
+				return err
+			})
+		}
+
+		if err := group.Wait(); err != nil {
+			return nil, donor, err
+		}
+
+		if level == 0 {
+			donor = records[0].person
+		}
+
+		var next []int
+		queue := func(id int) {
+			if id != 0 && !seen[id] {
+				seen[id] = true
+				next = append(next, id)
+			}
+		}
+
+		for _, r := range records {
+			role := r.person.PersonType
+			if role == "" {
+				role = "Donor"
+			}
+
+			key := network.addPerson(r.person, role, level)
+
+			if r.person.Parent != nil {
+				network.connect(key, network.addPerson(*r.person.Parent, role, level+1), "Linked record")
+				queue(r.person.Parent.ID)
+			}
+
+			for _, child := range r.person.Children {
+				network.connect(key, network.addPerson(child, role, level+1), "Linked record")
+				queue(child.ID)
+			}
+
+			for _, ref := range r.references {
+				label := "Reference"
+				if ref.Reason != "" {
+					label += ": " + ref.Reason
+				}
+
+				network.connect(key, network.addReference(ref, level+1), label)
+				queue(ref.ID)
+			}
+
+			for _, c := range r.cases {
+				network.addCase(key, c, level+1)
+			}
+		}
+
+		frontier = next
+	}
+
+	network.layout()
+	return network, donor, nil
+}
+
+// findOtherCases searches for each person in the network to find the cases
+// they are on for other donors, returning false if any search failed.
+func findOtherCases(ctx sirius.Context, client PersonNetworkClient, network *personNetwork) bool {
+	var (
+		mu      sync.Mutex
+		results = map[string][]sirius.Person{}
+	)
+
+	group, groupCtx := errgroup.WithContext(ctx.Context)
+	group.SetLimit(personNetworkConcurrency)
+
+	searches := 0
+	for _, node := range network.Nodes {
+		if len(node.Name) < 3 || searches >= personNetworkMaxSearches {
+			continue
+		}
+		searches++
+
+		group.Go(func() error {
+			response, _, err := client.Search(ctx.With(groupCtx), node.Name, 1, nil)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			results[node.Key] = response.Results
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		telemetry.LoggerFromContext(ctx.Context).Warn("person network search failed", "error", err)
+		return false
+	}
+
+	for key, people := range results {
+		network.addOtherCases(key, people)
+	}
+
+	network.layout()
+	return true
+}
+
+func PersonNetwork(client PersonNetworkClient, tmpl template.Template) Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		donorID, err := strToIntOrStatusError(r.PathValue("id"))
+		if err != nil {
+			return err
+		}
+
+		depth := personNetworkDefaultDepth
+		if v, err := strconv.Atoi(r.FormValue("depth")); err == nil && v >= 1 && v <= personNetworkMaxDepth {
+			depth = v
+		}
+
+		ctx := getContext(r)
+		data := personNetworkData{Depth: depth}
+
+		for d := 1; d <= personNetworkMaxDepth; d++ {
+			data.Depths = append(data.Depths, d)
+		}
+
+		data.Network, data.Donor, err = buildPersonNetwork(ctx, client, donorID, depth)
+		if err != nil {
+			return err
+		}
+
+		data.SearchFailed = !findOtherCases(ctx, client, data.Network)
+
+		return tmpl(w, data)
+	}
+}
@@ -0,0 +1,389 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/address"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/donormatch"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+)
+
+const (
+	networkNodeWidth  = 240
+	networkNodeHeight = 64
+	networkNodeGap    = 16
+	networkColumnGap  = 96
+
+	// networkPadding leaves room for the thicker outline of highlighted nodes
+	networkPadding = 4
+)
+
+// networkOtherCase is a case, outside of the network, that someone in the
+// network is also on
+type networkOtherCase struct {
+	UID  string
+	Role string
+}
+
+// networkNode is a person in the network. Sirius keeps a separate record of a
+// person for each case they are on, so records with the same name and date of
+// birth (or postcode when there is no date of birth) are drawn as one node.
+type networkNode struct {
+	Key        string
+	PersonID   int
+	UID        string
+	Name       string
+	Roles      []string
+	Cases      []string
+	OtherCases []networkOtherCase
+	Level      int
+	X          int
+	Y          int
+	Width      int
+	Height     int
+}
+
+// Highlighted is true for people who appear in several roles or on the LPAs of
+// donors outside the network, as they are of most interest when looking for
+// undue influence
+func (n networkNode) Highlighted() bool {
+	return len(n.Roles) > 1 || len(n.OtherCases) > 0
+}
+
+func (n networkNode) RoleSummary() string {
+	if len(n.Roles) == 0 {
+		return "Referenced person"
+	}
+
+	return strings.Join(n.Roles, ", ")
+}
+
+// networkEdge connects two nodes, with a label for each way they are
+// connected
+type networkEdge struct {
+	From   string
+	To     string
+	Labels []string
+	X1     int
+	Y1     int
+	X2     int
+	Y2     int
+}
+
+// personNetwork holds the people connected to a donor, and the layout used to
+// draw them as an SVG. Nodes are placed in columns by how many steps they are
+// from the donor.
+type personNetwork struct {
+	Nodes  []*networkNode
+	Edges  []*networkEdge
+	Width  int
+	Height int
+
+	nodes    map[string]*networkNode
+	edges    map[[2]string]*networkEdge
+	idToKey  map[int]string
+	caseUIDs map[string]bool
+}
+
+func newPersonNetwork() *personNetwork {
+	return &personNetwork{
+		nodes:    map[string]*networkNode{},
+		edges:    map[[2]string]*networkEdge{},
+		idToKey:  map[int]string{},
+		caseUIDs: map[string]bool{},
+	}
+}
+
+// Description summarises the network for screen readers
+func (n *personNetwork) Description() string {
+	var parts []string
+	for _, node := range n.Nodes {
+		part := node.Name + " (" + strings.ToLower(node.RoleSummary()) + ")"
+		if len(node.OtherCases) > 0 {
+			part += fmt.Sprintf(", also on %d other cases", len(node.OtherCases))
+		}
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// Connections lists how a node is connected to the rest of the network
+func (n *personNetwork) Connections(key string) []string {
+	var connections []string
+
+	for _, edge := range n.Edges {
+		var other *networkNode
+		switch key {
+		case edge.From:
+			other = n.nodes[edge.To]
+		case edge.To:
+			other = n.nodes[edge.From]
+		default:
+			continue
+		}
+
+		for _, label := range edge.Labels {
+			connections = append(connections, other.Name+": "+label)
+		}
+	}
+
+	return connections
+}
+
+func networkPersonKey(p sirius.Person) string {
+	name := donormatch.NormaliseName(p.Firstname + " " + p.Surname)
+	if name == "" {
+		name = donormatch.NormaliseName(p.CompanyName)
+	}
+
+	switch {
+	case name == "":
+		return fmt.Sprintf("id:%d", p.ID)
+	case p.DateOfBirth != "":
+		return name + "|" + string(p.DateOfBirth)
+	}
+
+	if postcode, err := address.NormalisePostcode(p.Postcode); err == nil {
+		return name + "|" + postcode
+	}
+
+	return fmt.Sprintf("%s|id:%d", name, p.ID)
+}
+
+func networkPersonName(p sirius.Person) string {
+	if name := strings.TrimSpace(p.Firstname + " " + p.Surname); name != "" {
+		return name
+	}
+	if p.CompanyName != "" {
+		return p.CompanyName
+	}
+
+	return p.DisplayName
+}
+
+// addPerson adds a person to the network, or adds the role to the node
+// already holding them, returning the node's key
+func (n *personNetwork) addPerson(p sirius.Person, role string, level int) string {
+	key, ok := n.idToKey[p.ID]
+	if !ok || p.ID == 0 {
+		key = networkPersonKey(p)
+	}
+
+	node, ok := n.nodes[key]
+	if !ok {
+		node = &networkNode{Key: key, Level: level}
+		n.nodes[key] = node
+	}
+
+	if p.ID != 0 {
+		// a reference to this person may have been added before their details
+		// were known
+		if ref, found := n.nodes[fmt.Sprintf("id:%d", p.ID)]; found && ref != node {
+			n.absorb(node, ref)
+		}
+
+		n.idToKey[p.ID] = key
+		if node.PersonID == 0 {
+			node.PersonID = p.ID
+		}
+	}
+
+	if node.UID == "" {
+		node.UID = p.UID
+	}
+	if name := networkPersonName(p); name != "" {
+		node.Name = name
+	}
+	if role != "" && !slices.Contains(node.Roles, role) {
+		node.Roles = append(node.Roles, role)
+	}
+	node.Level = min(node.Level, level)
+
+	return key
+}
+
+// addReference adds a person known only from a reference, so by ID and name
+func (n *personNetwork) addReference(ref sirius.PersonReference, level int) string {
+	if key, ok := n.idToKey[ref.ID]; ok {
+		return key
+	}
+
+	key := fmt.Sprintf("id:%d", ref.ID)
+	if _, ok := n.nodes[key]; !ok {
+		n.nodes[key] = &networkNode{Key: key, PersonID: ref.ID, Name: ref.DisplayName, Level: level}
+	}
+
+	return key
+}
+
+// absorb moves the connections of one node to another and removes it
+func (n *personNetwork) absorb(node, other *networkNode) {
+	delete(n.nodes, other.Key)
+	node.Level = min(node.Level, other.Level)
+	if node.Name == "" {
+		node.Name = other.Name
+	}
+
+	edges := n.edges
+	n.edges = map[[2]string]*networkEdge{}
+
+	for _, edge := range edges {
+		from, to := edge.From, edge.To
+		if from == other.Key {
+			from = node.Key
+		}
+		if to == other.Key {
+			to = node.Key
+		}
+
+		for _, label := range edge.Labels {
+			n.connect(from, to, label)
+		}
+	}
+}
+
+func (n *personNetwork) connect(from, to, label string) {
+	if from == to {
+		return
+	}
+
+	edge, ok := n.edges[[2]string{from, to}]
+	if !ok {
+		edge, ok = n.edges[[2]string{to, from}]
+	}
+	if !ok {
+		edge = &networkEdge{From: from, To: to}
+		n.edges[[2]string{from, to}] = edge
+	}
+
+	if !slices.Contains(edge.Labels, label) {
+		edge.Labels = append(edge.Labels, label)
+	}
+}
+
+// addCase adds the people on a case, connected to the donor's node
+func (n *personNetwork) addCase(donorKey string, c sirius.Case, level int) {
+	n.caseUIDs[c.UID] = true
+
+	add := func(p sirius.Person, role string) {
+		key := n.addPerson(p, role, level)
+		node := n.nodes[key]
+		if c.UID != "" && !slices.Contains(node.Cases, c.UID) {
+			node.Cases = append(node.Cases, c.UID)
+		}
+		n.connect(donorKey, key, role+" on "+c.UID)
+	}
+
+	if donor := n.nodes[donorKey]; c.UID != "" && !slices.Contains(donor.Cases, c.UID) {
+		donor.Cases = append(donor.Cases, c.UID)
+	}
+
+	for _, attorney := range c.Attorneys {
+		add(attorney.Person, "Attorney")
+	}
+	for _, attorney := range c.ReplacementAttorneys {
+		add(attorney.Person, "Replacement attorney")
+	}
+	for _, trustCorporation := range c.TrustCorporations {
+		add(trustCorporation.Person, "Trust corporation")
+	}
+	for _, certificateProvider := range c.CertificateProviders {
+		add(certificateProvider, "Certificate provider")
+	}
+	if c.Correspondent != nil {
+		add(c.Correspondent.Person, "Correspondent")
+	}
+}
+
+// addOtherCases records the cases outside the network that a search result
+// for a node is on, when the result is the same person as the node
+func (n *personNetwork) addOtherCases(key string, results []sirius.Person) {
+	node := n.nodes[key]
+
+	for _, result := range results {
+		if networkPersonKey(result) != node.Key {
+			continue
+		}
+
+		role := result.PersonType
+		if role == "" {
+			role = "Unknown role"
+		}
+
+		for _, c := range result.Cases {
+			if c == nil || c.UID == "" || n.caseUIDs[c.UID] {
+				continue
+			}
+
+			other := networkOtherCase{UID: c.UID, Role: role}
+			if !slices.Contains(node.OtherCases, other) {
+				node.OtherCases = append(node.OtherCases, other)
+			}
+		}
+	}
+}
+
+// layout orders the nodes and edges, and positions them in columns by level
+func (n *personNetwork) layout() {
+	n.Nodes = n.Nodes[:0]
+	for _, node := range n.nodes {
+		n.Nodes = append(n.Nodes, node)
+	}
+
+	sort.Slice(n.Nodes, func(i, j int) bool {
+		a, b := n.Nodes[i], n.Nodes[j]
+		if a.Level != b.Level {
+			return a.Level < b.Level
+		}
+		if a.Highlighted() != b.Highlighted() {
+			return a.Highlighted()
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Key < b.Key
+	})
+
+	rows := map[int]int{}
+	n.Width, n.Height = 0, 0
+
+	for _, node := range n.Nodes {
+		node.Width = networkNodeWidth
+		node.Height = networkNodeHeight
+		node.X = networkPadding + node.Level*(networkNodeWidth+networkColumnGap)
+		node.Y = networkPadding + rows[node.Level]*(networkNodeHeight+networkNodeGap)
+		rows[node.Level]++
+
+		n.Width = max(n.Width, node.X+node.Width+networkPadding)
+		n.Height = max(n.Height, node.Y+node.Height+networkPadding)
+	}
+
+	n.Edges = n.Edges[:0]
+	for _, edge := range n.edges {
+		from, to := n.nodes[edge.From], n.nodes[edge.To]
+		if from.Level > to.Level || (from.Level == to.Level && from.Y > to.Y) {
+			edge.From, edge.To = edge.To, edge.From
+			from, to = to, from
+		}
+
+		edge.X1, edge.Y1 = from.X+from.Width, from.Y+from.Height/2
+		edge.X2, edge.Y2 = to.X, to.Y+to.Height/2
+		if from.Level == to.Level {
+			edge.X1, edge.Y1 = from.X+from.Width/2, from.Y+from.Height
+			edge.X2, edge.Y2 = to.X+to.Width/2, to.Y
+		}
+
+		n.Edges = append(n.Edges, edge)
+	}
+
+	sort.Slice(n.Edges, func(i, j int) bool {
+		if n.Edges[i].From != n.Edges[j].From {
+			return n.Edges[i].From < n.Edges[j].From
+		}
+		return n.Edges[i].To < n.Edges[j].To
+	})
+}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkPersonKey(t *testing.T) {
+	testCases := map[string]struct {
+		person   sirius.Person
+		expected string
+	}{
+		"date of birth": {
+			person:   sirius.Person{ID: 1, Firstname: "Zoë", Surname: "O'Brien", DateOfBirth: "1950-01-02", Postcode: "SW1A 1AA"},
+			expected: "zoe obrien|1950-01-02",
+		},
+		"postcode": {
+			person:   sirius.Person{ID: 1, Firstname: "Zoe", Surname: "OBrien", Postcode: "sw1a1aa"},
+			expected: "zoe obrien|SW1A 1AA",
+		},
+		"name only": {
+			person:   sirius.Person{ID: 1, Firstname: "Zoe", Surname: "OBrien"},
+			expected: "zoe obrien|id:1",
+		},
+		"company": {
+			person:   sirius.Person{ID: 1, CompanyName: "Trusty Ltd", Postcode: "SW1A 1AA"},
+			expected: "trusty ltd|SW1A 1AA",
+		},
+		"no name": {
+			person:   sirius.Person{ID: 1},
+			expected: "id:1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, networkPersonKey(tc.person))
+		})
+	}
+}
+
+func TestPersonNetworkAddCase(t *testing.T) {
+	network := newPersonNetwork()
+	donorKey := network.addPerson(sirius.Person{ID: 1, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01"}, "Donor", 0)
+
+	helper := sirius.Person{Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"}
+
+	network.addCase(donorKey, sirius.Case{
+		UID: "7000-1010",
+		Attorneys: []sirius.Attorney{
+			{Person: sirius.Person{ID: 20, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"}},
+		},
+		CertificateProviders: []sirius.Person{
+			{ID: 21, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"},
+		},
+	}, 1)
+	network.addCase(donorKey, sirius.Case{
+		UID: "7000-1011",
+		Attorneys: []sirius.Attorney{
+			{Person: sirius.Person{ID: 30, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"}},
+		},
+		Correspondent: &sirius.Correspondent{Person: sirius.Person{ID: 31, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01"}},
+	}, 1)
+	network.layout()
+
+	assert.Len(t, network.Nodes, 2)
+
+	donor := network.nodes[donorKey]
+	assert.Equal(t, []string{"Donor", "Correspondent"}, donor.Roles)
+	assert.Equal(t, []string{"7000-1010", "7000-1011"}, donor.Cases)
+	assert.True(t, donor.Highlighted())
+
+	bob := network.nodes[networkPersonKey(helper)]
+	assert.Equal(t, 20, bob.PersonID)
+	assert.Equal(t, []string{"Attorney", "Certificate provider"}, bob.Roles)
+	assert.Equal(t, []string{"7000-1010", "7000-1011"}, bob.Cases)
+	assert.Equal(t, 1, bob.Level)
+
+	assert.Len(t, network.Edges, 1)
+	assert.Equal(t, []string{"Attorney on 7000-1010", "Certificate provider on 7000-1010", "Attorney on 7000-1011"}, network.Edges[0].Labels)
+	assert.Equal(t, []string{
+		"Ann Donor: Attorney on 7000-1010",
+		"Ann Donor: Certificate provider on 7000-1010",
+		"Ann Donor: Attorney on 7000-1011",
+	}, network.Connections(bob.Key))
+}
+
+func TestPersonNetworkReferenceIsReplacedByPerson(t *testing.T) {
+	network := newPersonNetwork()
+	donorKey := network.addPerson(sirius.Person{ID: 1, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01"}, "Donor", 0)
+	refKey := network.addReference(sirius.PersonReference{ID: 3, DisplayName: "R Ference"}, 1)
+	network.connect(donorKey, refKey, "Reference: Friend")
+
+	assert.Equal(t, "id:3", refKey)
+
+	key := network.addPerson(sirius.Person{ID: 3, UID: "7000-0003", Firstname: "Rae", Surname: "Ference", DateOfBirth: "1960-03-03"}, "Donor", 2)
+	network.layout()
+
+	assert.Equal(t, "rae ference|1960-03-03", key)
+	assert.Len(t, network.Nodes, 2)
+
+	node := network.nodes[key]
+	assert.Equal(t, "Rae Ference", node.Name)
+	assert.Equal(t, "7000-0003", node.UID)
+	assert.Equal(t, 1, node.Level)
+
+	assert.Len(t, network.Edges, 1)
+	assert.Equal(t, donorKey, network.Edges[0].From)
+	assert.Equal(t, key, network.Edges[0].To)
+	assert.Equal(t, []string{"Reference: Friend"}, network.Edges[0].Labels)
+
+	assert.Equal(t, key, network.addReference(sirius.PersonReference{ID: 3}, 1))
+}
+
+func TestPersonNetworkAddOtherCases(t *testing.T) {
+	network := newPersonNetwork()
+	donorKey := network.addPerson(sirius.Person{ID: 1, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01"}, "Donor", 0)
+	network.addCase(donorKey, sirius.Case{
+		UID:       "7000-1010",
+		Attorneys: []sirius.Attorney{{Person: sirius.Person{ID: 20, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"}}},
+	}, 1)
+
+	bobKey := networkPersonKey(sirius.Person{Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"})
+
+	network.addOtherCases(bobKey, []sirius.Person{
+		{ID: 20, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02", PersonType: "Attorney", Cases: []*sirius.Case{{UID: "7000-1010"}}},
+		{ID: 98, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02", PersonType: "Attorney", Cases: []*sirius.Case{{UID: "7000-9999"}, nil}},
+		{ID: 99, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02", PersonType: "Attorney", Cases: []*sirius.Case{{UID: "7000-9999"}}},
+		{ID: 97, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1980-02-02", PersonType: "Donor", Cases: []*sirius.Case{{UID: "7000-8888"}}},
+	})
+
+	bob := network.nodes[bobKey]
+	assert.Equal(t, []networkOtherCase{{UID: "7000-9999", Role: "Attorney"}}, bob.OtherCases)
+	assert.True(t, bob.Highlighted())
+}
+
+func TestPersonNetworkLayout(t *testing.T) {
+	network := newPersonNetwork()
+	donorKey := network.addPerson(sirius.Person{ID: 1, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01"}, "Donor", 0)
+	network.addCase(donorKey, sirius.Case{
+		UID: "7000-1010",
+		Attorneys: []sirius.Attorney{
+			{Person: sirius.Person{ID: 20, Firstname: "Zed", Surname: "Helper", DateOfBirth: "1970-02-02"}},
+			{Person: sirius.Person{ID: 21, Firstname: "Amy", Surname: "Helper", DateOfBirth: "1971-02-02"}},
+		},
+	}, 1)
+	network.layout()
+
+	assert.Equal(t, []string{"Ann Donor", "Amy Helper", "Zed Helper"}, []string{network.Nodes[0].Name, network.Nodes[1].Name, network.Nodes[2].Name})
+
+	assert.Equal(t, networkPadding, network.Nodes[0].X)
+	assert.Equal(t, networkPadding, network.Nodes[0].Y)
+	assert.Equal(t, networkPadding+networkNodeWidth+networkColumnGap, network.Nodes[1].X)
+	assert.Equal(t, networkPadding+networkNodeHeight+networkNodeGap, network.Nodes[2].Y)
+
+	assert.Equal(t, 2*networkNodeWidth+networkColumnGap+2*networkPadding, network.Width)
+	assert.Equal(t, 2*networkNodeHeight+networkNodeGap+2*networkPadding, network.Height)
+
+	for _, edge := range network.Edges {
+		assert.Equal(t, donorKey, edge.From)
+		assert.Equal(t, networkPadding+networkNodeWidth, edge.X1)
+		assert.Equal(t, networkPadding+networkNodeHeight/2, edge.Y1)
+		assert.Equal(t, networkPadding+networkNodeWidth+networkColumnGap, edge.X2)
+	}
+
+	assert.Equal(t, "Ann Donor (donor); Amy Helper (attorney); Zed Helper (attorney)", network.Description())
+}
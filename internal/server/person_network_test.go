@@ -0,0 +1,343 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPersonNetworkClient struct {
+	mock.Mock
+}
+
+func (m *mockPersonNetworkClient) Case(ctx sirius.Context, id int) (sirius.Case, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(sirius.Case), args.Error(1)
+}
+
+func (m *mockPersonNetworkClient) CasesByDonor(ctx sirius.Context, id int) ([]sirius.Case, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]sirius.Case), args.Error(1)
+}
+
+func (m *mockPersonNetworkClient) Person(ctx sirius.Context, id int) (sirius.Person, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(sirius.Person), args.Error(1)
+}
+
+func (m *mockPersonNetworkClient) PersonReferences(ctx sirius.Context, id int) ([]sirius.PersonReference, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]sirius.PersonReference), args.Error(1)
+}
+
+func (m *mockPersonNetworkClient) Search(ctx sirius.Context, term string, page int, personTypeFilters []string) (sirius.SearchResponse, *sirius.Pagination, error) {
+	args := m.Called(ctx, term, page, personTypeFilters)
+	return args.Get(0).(sirius.SearchResponse), args.Get(1).(*sirius.Pagination), args.Error(2)
+}
+
+func TestGetPersonNetwork(t *testing.T) {
+	networkDonor := sirius.Person{
+		ID:          1,
+		UID:         "7000-0000-0001",
+		Firstname:   "Ann",
+		Surname:     "Donor",
+		DateOfBirth: "1940-01-01",
+		Children: []sirius.Person{
+			{ID: 2, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01"},
+		},
+	}
+
+	client := &mockPersonNetworkClient{}
+	client.
+		On("Person", mock.Anything, 1).
+		Return(networkDonor, nil)
+	client.
+		On("PersonReferences", mock.Anything, 1).
+		Return([]sirius.PersonReference{{ID: 3, DisplayName: "Rae Ference", Reason: "Friend"}}, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 1).
+		Return([]sirius.Case{{ID: 10}}, nil)
+	client.
+		On("Case", mock.Anything, 10).
+		Return(sirius.Case{
+			ID:  10,
+			UID: "7000-1000-0010",
+			Attorneys: []sirius.Attorney{
+				{Person: sirius.Person{ID: 20, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"}},
+			},
+			CertificateProviders: []sirius.Person{
+				{ID: 21, Firstname: "Cat", Surname: "Provider", Postcode: "SW1A 1AA"},
+			},
+		}, nil)
+
+	client.
+		On("Search", mock.Anything, "Ann Donor", 1, []string(nil)).
+		Return(sirius.SearchResponse{}, &sirius.Pagination{}, nil)
+	client.
+		On("Search", mock.Anything, "Rae Ference", 1, []string(nil)).
+		Return(sirius.SearchResponse{}, &sirius.Pagination{}, nil)
+	client.
+		On("Search", mock.Anything, "Cat Provider", 1, []string(nil)).
+		Return(sirius.SearchResponse{}, &sirius.Pagination{}, nil)
+	client.
+		On("Search", mock.Anything, "Bob Helper", 1, []string(nil)).
+		Return(sirius.SearchResponse{
+			Results: []sirius.Person{
+				{ID: 99, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02", PersonType: "Attorney", Cases: []*sirius.Case{{UID: "7000-1000-0099"}}},
+			},
+		}, &sirius.Pagination{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data personNetworkData) bool {
+			if !assert.Len(t, data.Network.Nodes, 4) {
+				return false
+			}
+
+			donor, bob, cat, rae := data.Network.Nodes[0], data.Network.Nodes[1], data.Network.Nodes[2], data.Network.Nodes[3]
+
+			return assert.Equal(t, networkDonor, data.Donor) &&
+				assert.Equal(t, 1, data.Depth) &&
+				assert.Equal(t, []int{1, 2, 3}, data.Depths) &&
+				assert.False(t, data.SearchFailed) &&
+				assert.Equal(t, "Ann Donor", donor.Name) &&
+				assert.Equal(t, []string{"7000-1000-0010"}, donor.Cases) &&
+				assert.Equal(t, "Bob Helper", bob.Name) &&
+				assert.Equal(t, []networkOtherCase{{UID: "7000-1000-0099", Role: "Attorney"}}, bob.OtherCases) &&
+				assert.Equal(t, "Cat Provider", cat.Name) &&
+				assert.Equal(t, "Rae Ference", rae.Name) &&
+				assert.Equal(t, []string{"Ann Donor: Reference: Friend"}, data.Network.Connections(rae.Key))
+		})).
+		Return(nil)
+
+	server := newMockServer("/donor/{id}/network", PersonNetwork(client, template.Func))
+
+	r, _ := http.NewRequest(http.MethodGet, "/donor/1/network", nil)
+	resp, err := server.serve(r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetPersonNetworkWithDepth(t *testing.T) {
+	networkDonor := sirius.Person{
+		ID:          1,
+		UID:         "7000-0000-0001",
+		Firstname:   "Ann",
+		Surname:     "Donor",
+		DateOfBirth: "1940-01-01",
+		Children: []sirius.Person{
+			{ID: 2, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01"},
+		},
+	}
+
+	client := &mockPersonNetworkClient{}
+	client.
+		On("Person", mock.Anything, 1).
+		Return(networkDonor, nil)
+	client.
+		On("PersonReferences", mock.Anything, 1).
+		Return([]sirius.PersonReference{{ID: 3, DisplayName: "Rae Ference", Reason: "Friend"}}, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 1).
+		Return([]sirius.Case{{ID: 10}}, nil)
+	client.
+		On("Case", mock.Anything, 10).
+		Return(sirius.Case{
+			ID:  10,
+			UID: "7000-1000-0010",
+			Attorneys: []sirius.Attorney{
+				{Person: sirius.Person{ID: 20, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"}},
+			},
+			CertificateProviders: []sirius.Person{
+				{ID: 21, Firstname: "Cat", Surname: "Provider", Postcode: "SW1A 1AA"},
+			},
+		}, nil)
+
+	client.
+		On("Search", mock.Anything, "Ann Donor", 1, []string(nil)).
+		Return(sirius.SearchResponse{}, &sirius.Pagination{}, nil)
+	client.
+		On("Search", mock.Anything, "Rae Ference", 1, []string(nil)).
+		Return(sirius.SearchResponse{}, &sirius.Pagination{}, nil)
+	client.
+		On("Search", mock.Anything, "Cat Provider", 1, []string(nil)).
+		Return(sirius.SearchResponse{}, &sirius.Pagination{}, nil)
+	client.
+		On("Search", mock.Anything, "Bob Helper", 1, []string(nil)).
+		Return(sirius.SearchResponse{
+			Results: []sirius.Person{
+				{ID: 99, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02", PersonType: "Attorney", Cases: []*sirius.Case{{UID: "7000-1000-0099"}}},
+			},
+		}, &sirius.Pagination{}, nil)
+	client.
+		On("Person", mock.Anything, 2).
+		Return(sirius.Person{ID: 2, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01", Parent: &networkDonor}, nil)
+	client.
+		On("PersonReferences", mock.Anything, 2).
+		Return([]sirius.PersonReference{}, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 2).
+		Return([]sirius.Case{}, nil)
+	client.
+		On("Person", mock.Anything, 3).
+		Return(sirius.Person{ID: 3, UID: "7000-0000-0003", Firstname: "Rae", Surname: "Ference", DateOfBirth: "1960-03-03"}, nil)
+	client.
+		On("PersonReferences", mock.Anything, 3).
+		Return([]sirius.PersonReference{}, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 3).
+		Return([]sirius.Case{{ID: 11}}, nil)
+	client.
+		On("Case", mock.Anything, 11).
+		Return(sirius.Case{
+			ID:        11,
+			UID:       "7000-1000-0011",
+			Attorneys: []sirius.Attorney{{Person: sirius.Person{ID: 30, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"}}},
+		}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data personNetworkData) bool {
+			if !assert.Len(t, data.Network.Nodes, 4) {
+				return false
+			}
+
+			bob := data.Network.Nodes[1]
+
+			return assert.Equal(t, 2, data.Depth) &&
+				assert.Equal(t, "Bob Helper", bob.Name) &&
+				assert.Equal(t, []string{"7000-1000-0010", "7000-1000-0011"}, bob.Cases) &&
+				assert.Equal(t, "7000-0000-0003", data.Network.Nodes[3].UID) &&
+				assert.Equal(t, []string{
+					"Ann Donor: Attorney on 7000-1000-0010",
+					"Rae Ference: Attorney on 7000-1000-0011",
+				}, data.Network.Connections(bob.Key))
+		})).
+		Return(nil)
+
+	server := newMockServer("/donor/{id}/network", PersonNetwork(client, template.Func))
+
+	r, _ := http.NewRequest(http.MethodGet, "/donor/1/network?depth=2", nil)
+	resp, err := server.serve(r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetPersonNetworkWhenSearchFails(t *testing.T) {
+	networkDonor := sirius.Person{
+		ID:          1,
+		UID:         "7000-0000-0001",
+		Firstname:   "Ann",
+		Surname:     "Donor",
+		DateOfBirth: "1940-01-01",
+		Children: []sirius.Person{
+			{ID: 2, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01"},
+		},
+	}
+
+	client := &mockPersonNetworkClient{}
+	client.
+		On("Person", mock.Anything, 1).
+		Return(networkDonor, nil)
+	client.
+		On("PersonReferences", mock.Anything, 1).
+		Return([]sirius.PersonReference{{ID: 3, DisplayName: "Rae Ference", Reason: "Friend"}}, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 1).
+		Return([]sirius.Case{{ID: 10}}, nil)
+	client.
+		On("Case", mock.Anything, 10).
+		Return(sirius.Case{
+			ID:  10,
+			UID: "7000-1000-0010",
+			Attorneys: []sirius.Attorney{
+				{Person: sirius.Person{ID: 20, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02"}},
+			},
+			CertificateProviders: []sirius.Person{
+				{ID: 21, Firstname: "Cat", Surname: "Provider", Postcode: "SW1A 1AA"},
+			},
+		}, nil)
+
+	client.
+		On("Search", mock.Anything, "Ann Donor", 1, []string(nil)).
+		Return(sirius.SearchResponse{}, &sirius.Pagination{}, nil)
+	client.
+		On("Search", mock.Anything, "Rae Ference", 1, []string(nil)).
+		Return(sirius.SearchResponse{}, &sirius.Pagination{}, nil)
+	client.
+		On("Search", mock.Anything, "Cat Provider", 1, []string(nil)).
+		Return(sirius.SearchResponse{}, &sirius.Pagination{}, errExample)
+	client.
+		On("Search", mock.Anything, "Bob Helper", 1, []string(nil)).
+		Return(sirius.SearchResponse{
+			Results: []sirius.Person{
+				{ID: 99, Firstname: "Bob", Surname: "Helper", DateOfBirth: "1970-02-02", PersonType: "Attorney", Cases: []*sirius.Case{{UID: "7000-1000-0099"}}},
+			},
+		}, &sirius.Pagination{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data personNetworkData) bool {
+			return data.SearchFailed && len(data.Network.Nodes) == 4 && len(data.Network.Nodes[1].OtherCases) == 0
+		})).
+		Return(nil)
+
+	server := newMockServer("/donor/{id}/network", PersonNetwork(client, template.Func))
+
+	r, _ := http.NewRequest(http.MethodGet, "/donor/1/network?depth=9", nil)
+	resp, err := server.serve(r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mock.AssertExpectationsForObjects(t, template)
+}
+
+func TestGetPersonNetworkWhenFetchFails(t *testing.T) {
+	networkDonor := sirius.Person{
+		ID:          1,
+		UID:         "7000-0000-0001",
+		Firstname:   "Ann",
+		Surname:     "Donor",
+		DateOfBirth: "1940-01-01",
+		Children: []sirius.Person{
+			{ID: 2, Firstname: "Ann", Surname: "Donor", DateOfBirth: "1940-01-01"},
+		},
+	}
+
+	client := &mockPersonNetworkClient{}
+	client.
+		On("Person", mock.Anything, 1).
+		Return(networkDonor, nil)
+	client.
+		On("PersonReferences", mock.Anything, 1).
+		Return([]sirius.PersonReference{}, nil)
+	client.
+		On("CasesByDonor", mock.Anything, 1).
+		Return([]sirius.Case{{ID: 10}}, nil)
+	client.
+		On("Case", mock.Anything, 10).
+		Return(sirius.Case{}, errExample)
+
+	server := newMockServer("/donor/{id}/network", PersonNetwork(client, nil))
+
+	r, _ := http.NewRequest(http.MethodGet, "/donor/1/network", nil)
+	_, err := server.serve(r)
+
+	assert.Equal(t, errExample, err)
+}
+
+func TestGetPersonNetworkBadID(t *testing.T) {
+	server := newMockServer("/donor/{id}/network", PersonNetwork(nil, nil))
+
+	r, _ := http.NewRequest(http.MethodGet, "/donor/abc/network", nil)
+	_, err := server.serve(r)
+
+	assert.Equal(t, sirius.StatusError{Code: http.StatusBadRequest}, err)
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/ministryofjustice/opg-go-common/telemetry"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/donormatch"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"golang.org/x/sync/errgroup"
+)
+
+// possibleDonors are existing people who could be the donor being created,
+// shown so the caseworker can use one of them instead of making a duplicate
+type possibleDonors struct {
+	Matches  []donormatch.Match
+	ForDraft bool
+}
+
+// findPossibleDonors searches Sirius for people like candidate. The check only
+// helps the caseworker, so a failed search is logged rather than stopping the
+// donor from being created.
+func findPossibleDonors(ctx sirius.Context, client SearchDonorsClient, candidate donormatch.Candidate) []donormatch.Match {
+	var (
+		mu     sync.Mutex
+		people []sirius.Person
+	)
+
+	group, groupCtx := errgroup.WithContext(ctx.Context)
+
+	for _, term := range candidate.SearchTerms() {
+		group.Go(func() error {
+			results, err := client.SearchDonors(ctx.With(groupCtx), term)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			people = append(people, results...)
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		telemetry.LoggerFromContext(ctx.Context).Warn("possible donor search failed", "error", err)
+		return nil
+	}
+
+	return donormatch.Find(candidate, people)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/donormatch"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFindPossibleDonors(t *testing.T) {
+	byName := sirius.Person{ID: 1, Firstname: "Zackary", Surname: "Lemmonds", DateOfBirth: "1965-04-18"}
+	byPostcode := sirius.Person{ID: 2, Firstname: "Zack", Surname: "Lemmonds", Postcode: "NR16 2GB"}
+	neighbour := sirius.Person{ID: 3, Firstname: "Ada", Surname: "Brown", Postcode: "NR16 2GB"}
+
+	client := &mockSearchDonorsClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Zackary Lemmonds").
+		Return([]sirius.Person{byName, byPostcode}, nil)
+	client.
+		On("SearchDonors", mock.Anything, "NR16 2GB").
+		Return([]sirius.Person{byPostcode, neighbour}, nil)
+
+	matches := findPossibleDonors(sirius.Context{Context: context.Background()}, client, donormatch.Candidate{
+		Firstname:   "Zackary",
+		Surname:     "Lemmonds",
+		DateOfBirth: "1965-04-18",
+		Postcode:    "NR16 2GB",
+	})
+
+	if assert.Len(t, matches, 2) {
+		assert.Equal(t, 1, matches[0].Person.ID)
+		assert.Equal(t, 2, matches[1].Person.ID)
+	}
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestFindPossibleDonorsWhenSearchFails(t *testing.T) {
+	client := &mockSearchDonorsClient{}
+	client.
+		On("SearchDonors", mock.Anything, "Zackary Lemmonds").
+		Return([]sirius.Person{}, errExample)
+
+	matches := findPossibleDonors(sirius.Context{Context: context.Background()}, client, donormatch.Candidate{
+		Firstname: "Zackary",
+		Surname:   "Lemmonds",
+	})
+
+	assert.Nil(t, matches)
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"container/list"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/address"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	postcodeCacheSize = 500
+	postcodeCacheTTL  = time.Hour
+)
+
+type postcodeCacheEntry struct {
+	postcode  string
+	addresses []sirius.PostcodeLookupAddress
+	fetchedAt time.Time
+}
+
+type PostcodeCacheClient interface {
+	GetUserDetails(ctx sirius.Context) (sirius.User, error)
+	PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error)
+}
+
+// postcodeCache keeps the results of recent postcode lookups, keyed by the
+// normalised postcode, so that repeated searches for the same street do not
+// each call Sirius. The cache is shared, so the user's session is still checked
+// with Sirius before a cached result is returned. Expired entries are kept
+// until they are evicted so they can still be used if Sirius cannot be reached.
+//
+// Each lookup is traced with its result (hit, miss, stale or error), so that
+// latency and failure rate can be measured from the exported traces.
+type postcodeCache struct {
+	client PostcodeCacheClient
+	size   int
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+
+	tracer trace.Tracer
+}
+
+func newPostcodeCache(client PostcodeCacheClient, size int, ttl time.Duration) *postcodeCache {
+	return &postcodeCache{
+		client:  client,
+		size:    size,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		tracer:  otel.Tracer("github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/server"),
+	}
+}
+
+func (c *postcodeCache) PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error) {
+	spanCtx, span := c.tracer.Start(ctx.Context, "postcode lookup")
+	defer span.End()
+
+	addresses, result, err := c.lookup(ctx.With(spanCtx), postcode)
+
+	span.SetAttributes(attribute.String("postcode_lookup.result", result))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "postcode lookup failed")
+	}
+
+	return addresses, err
+}
+
+func (c *postcodeCache) lookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, string, error) {
+	key, err := address.NormalisePostcode(postcode)
+	if err != nil {
+		// not a UK postcode, so leave it to Sirius to decide what to return
+		addresses, err := c.client.PostcodeLookup(ctx, postcode)
+		if err != nil {
+			return nil, "error", err
+		}
+
+		return addresses, "miss", nil
+	}
+
+	entry, found := c.get(key)
+	if found && c.now().Sub(entry.fetchedAt) < c.ttl {
+		if _, err := c.client.GetUserDetails(ctx); err != nil {
+			return nil, "error", err
+		}
+
+		return entry.addresses, "hit", nil
+	}
+
+	addresses, err := c.client.PostcodeLookup(ctx, key)
+	if err != nil {
+		if found && siriusUnavailable(err) {
+			return entry.addresses, "stale", nil
+		}
+
+		return nil, "error", err
+	}
+
+	c.put(key, addresses)
+	return addresses, "miss", nil
+}
+
+// siriusUnavailable is true when Sirius could not be reached or failed, rather
+// than refusing the request
+func siriusUnavailable(err error) bool {
+	var statusErr sirius.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code >= http.StatusInternalServerError
+	}
+
+	var validationErr sirius.ValidationError
+	return !errors.As(err, &validationErr)
+}
+
+func (c *postcodeCache) get(postcode string) (postcodeCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[postcode]
+	if !ok {
+		return postcodeCacheEntry{}, false
+	}
+
+	c.order.MoveToFront(element)
+	return element.Value.(postcodeCacheEntry), true
+}
+
+func (c *postcodeCache) put(postcode string, addresses []sirius.PostcodeLookupAddress) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := postcodeCacheEntry{postcode: postcode, addresses: addresses, fetchedAt: c.now()}
+
+	if element, ok := c.entries[postcode]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[postcode] = c.order.PushFront(entry)
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(postcodeCacheEntry).postcode)
+	}
+}
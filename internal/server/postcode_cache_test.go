@@ -0,0 +1,280 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type mockPostcodeCacheClient struct {
+	mockPostcodeLookupClient
+}
+
+func (m *mockPostcodeCacheClient) GetUserDetails(ctx sirius.Context) (sirius.User, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(sirius.User), args.Error(1)
+}
+
+// recordingTracer keeps the attributes set on the spans it starts
+type recordingTracer struct {
+	noop.Tracer
+	attributes []attribute.KeyValue
+}
+
+type recordingSpan struct {
+	noop.Span
+	tracer *recordingTracer
+}
+
+func (t *recordingTracer) Start(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return ctx, recordingSpan{tracer: t}
+}
+
+func (s recordingSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.tracer.attributes = append(s.tracer.attributes, kv...)
+}
+
+var testPostcodeAddresses = []sirius.PostcodeLookupAddress{{
+	Line1:       "17 Some Road",
+	Town:        "Teston",
+	Postcode:    "SW1A 0AA",
+	Description: "17 Some Road, Teston",
+}}
+
+func TestPostcodeCacheHit(t *testing.T) {
+	client := &mockPostcodeCacheClient{}
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return(testPostcodeAddresses, nil).
+		Once()
+	client.
+		On("GetUserDetails", mock.Anything).
+		Return(sirius.User{ID: 1}, nil).
+		Twice()
+
+	cache := newPostcodeCache(client, 10, time.Hour)
+
+	for _, postcode := range []string{"SW1A 0AA", "sw1a0aa", " Sw1a 0aA "} {
+		addresses, err := cache.PostcodeLookup(sirius.Context{Context: context.Background()}, postcode)
+		assert.Nil(t, err)
+		assert.Equal(t, testPostcodeAddresses, addresses)
+	}
+
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostcodeCacheExpiry(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+	client := &mockPostcodeCacheClient{}
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return(testPostcodeAddresses, nil).
+		Twice()
+	client.
+		On("GetUserDetails", mock.Anything).
+		Return(sirius.User{ID: 1}, nil).
+		Once()
+
+	cache := newPostcodeCache(client, 10, time.Hour)
+	cache.now = func() time.Time { return now }
+
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+	now = now.Add(59 * time.Minute)
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+	now = now.Add(time.Minute)
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostcodeCacheStaleWhenLookupFails(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+	client := &mockPostcodeCacheClient{}
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return(testPostcodeAddresses, nil).
+		Once()
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return([]sirius.PostcodeLookupAddress{}, sirius.StatusError{Code: http.StatusBadGateway}).
+		Once()
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return([]sirius.PostcodeLookupAddress{}, errExample).
+		Once()
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 1AA").
+		Return([]sirius.PostcodeLookupAddress{}, errExample).
+		Once()
+
+	cache := newPostcodeCache(client, 10, time.Hour)
+	cache.now = func() time.Time { return now }
+
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+	now = now.Add(2 * time.Hour)
+
+	addresses, err := cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+	assert.Nil(t, err)
+	assert.Equal(t, testPostcodeAddresses, addresses)
+
+	addresses, err = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+	assert.Nil(t, err)
+	assert.Equal(t, testPostcodeAddresses, addresses)
+
+	_, err = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 1AA")
+	assert.Equal(t, errExample, err)
+
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostcodeCacheHitWhenUnauthorized(t *testing.T) {
+	unauthorized := sirius.StatusError{Code: http.StatusUnauthorized}
+
+	client := &mockPostcodeCacheClient{}
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return(testPostcodeAddresses, nil).
+		Once()
+	client.
+		On("GetUserDetails", mock.Anything).
+		Return(sirius.User{}, unauthorized).
+		Once()
+
+	cache := newPostcodeCache(client, 10, time.Hour)
+
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+	addresses, err := cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+
+	assert.Nil(t, addresses)
+	assert.Equal(t, unauthorized, err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostcodeCacheNotStaleWhenLookupRefused(t *testing.T) {
+	for _, lookupErr := range []error{
+		sirius.StatusError{Code: http.StatusUnauthorized},
+		sirius.StatusError{Code: http.StatusForbidden},
+		sirius.ValidationError{Detail: "Invalid postcode"},
+	} {
+		t.Run(lookupErr.Error(), func(t *testing.T) {
+			now := time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+			client := &mockPostcodeCacheClient{}
+			client.
+				On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+				Return(testPostcodeAddresses, nil).
+				Once()
+			client.
+				On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+				Return([]sirius.PostcodeLookupAddress{}, lookupErr).
+				Once()
+
+			cache := newPostcodeCache(client, 10, time.Hour)
+			cache.now = func() time.Time { return now }
+
+			_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+			now = now.Add(2 * time.Hour)
+
+			_, err := cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+			assert.Equal(t, lookupErr, err)
+			mock.AssertExpectationsForObjects(t, client)
+		})
+	}
+}
+
+func TestPostcodeCacheTracesResult(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+	client := &mockPostcodeCacheClient{}
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return(testPostcodeAddresses, nil).
+		Once()
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return([]sirius.PostcodeLookupAddress{}, errExample).
+		Once()
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 1AA").
+		Return([]sirius.PostcodeLookupAddress{}, errExample).
+		Once()
+	client.
+		On("GetUserDetails", mock.Anything).
+		Return(sirius.User{ID: 1}, nil).
+		Once()
+
+	tracer := &recordingTracer{}
+
+	cache := newPostcodeCache(client, 10, time.Hour)
+	cache.now = func() time.Time { return now }
+	cache.tracer = tracer
+
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+	now = now.Add(2 * time.Hour)
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 0AA")
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1A 1AA")
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("postcode_lookup.result", "miss"),
+		attribute.String("postcode_lookup.result", "hit"),
+		attribute.String("postcode_lookup.result", "stale"),
+		attribute.String("postcode_lookup.result", "error"),
+	}, tracer.attributes)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostcodeCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	client := &mockPostcodeCacheClient{}
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return(testPostcodeAddresses, nil).
+		Once()
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 1AA").
+		Return(testPostcodeAddresses, nil).
+		Once()
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 2AA").
+		Return(testPostcodeAddresses, nil).
+		Twice()
+	client.
+		On("GetUserDetails", mock.Anything).
+		Return(sirius.User{ID: 1}, nil).
+		Twice()
+
+	cache := newPostcodeCache(client, 2, time.Hour)
+
+	for _, postcode := range []string{"SW1A 2AA", "SW1A 0AA", "SW1A 0AA", "SW1A 1AA", "SW1A 0AA", "SW1A 2AA"} {
+		_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, postcode)
+	}
+
+	assert.Equal(t, 2, cache.order.Len())
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostcodeCacheSkipsInvalidPostcodes(t *testing.T) {
+	client := &mockPostcodeCacheClient{}
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1").
+		Return([]sirius.PostcodeLookupAddress{}, nil).
+		Twice()
+
+	cache := newPostcodeCache(client, 10, time.Hour)
+
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1")
+	_, _ = cache.PostcodeLookup(sirius.Context{Context: context.Background()}, "SW1")
+
+	assert.Equal(t, 0, cache.order.Len())
+	mock.AssertExpectationsForObjects(t, client)
+}
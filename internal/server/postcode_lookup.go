@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/ministryofjustice/opg-go-common/telemetry"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 )
 
@@ -11,13 +12,26 @@ type PostcodeLookupClient interface {
 	PostcodeLookup(ctx sirius.Context, postcode string) ([]sirius.PostcodeLookupAddress, error)
 }
 
+// SearchPostcode returns the addresses for a postcode as JSON. If the lookup
+// fails it responds with 503 so the address finder can fall back to manual
+// entry.
 func SearchPostcode(client PostcodeLookupClient) Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		ctx := getContext(r)
 
 		addresses, err := client.PostcodeLookup(ctx, r.FormValue("postcode"))
-		if err != nil {
+		if v, ok := err.(unauthorizedError); ok && v.IsUnauthorized() {
 			return err
+		} else if err != nil {
+			telemetry.LoggerFromContext(r.Context()).Warn("postcode lookup failed", "error", err)
+
+			w.Header().Add("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return json.NewEncoder(w).Encode(ProblemError{
+				Title:  "Postcode lookup is not available",
+				Detail: "Enter the address manually",
+			})
 		}
 
 		return json.NewEncoder(w).Encode(addresses)
@@ -61,5 +61,29 @@ func TestGetPostcodeLookupWhenError(t *testing.T) {
 	w := httptest.NewRecorder()
 	err := SearchPostcode(client)(w, req)
 
-	assert.Equal(t, errExample, err)
+	assert.Nil(t, err)
+	resp := w.Result()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, "application/problem+json", resp.Header.Get("Content-Type"))
+
+	var problem ProblemError
+	_ = json.NewDecoder(resp.Body).Decode(&problem)
+
+	assert.Equal(t, "Postcode lookup is not available", problem.Title)
+	assert.Equal(t, "Enter the address manually", problem.Detail)
+}
+
+func TestGetPostcodeLookupWhenUnauthorized(t *testing.T) {
+	client := &mockPostcodeLookupClient{}
+	client.
+		On("PostcodeLookup", mock.Anything, "SW1A 0AA").
+		Return([]sirius.PostcodeLookupAddress{}, sirius.StatusError{Code: http.StatusUnauthorized})
+
+	req, _ := http.NewRequest(http.MethodGet, "/?postcode=SW1A 0AA", nil)
+
+	w := httptest.NewRecorder()
+	err := SearchPostcode(client)(w, req)
+
+	assert.Equal(t, sirius.StatusError{Code: http.StatusUnauthorized}, err)
 }
@@ -13,6 +13,7 @@ import (
 )
 
 type RemoveAnAttorneyClient interface {
+	AcknowledgeWarningClient
 	CaseSummary(sirius.Context, string) (sirius.CaseSummary, error)
 	ChangeAttorneyStatus(sirius.Context, string, []sirius.AttorneyUpdatedStatus) error
 	RefDataByCategory(sirius.Context, string) ([]sirius.RefDataItem, error)
@@ -52,6 +53,32 @@ type removeAnAttorneyData struct {
 	FormName                     string
 	Decisions                    shared.HowAttorneysMakeDecisions
 	ReplacementAttorneyDecisions shared.HowAttorneysMakeDecisions
+	WarningsToAcknowledge        []sirius.Warning
+}
+
+// Simulation shows what the attorney appointment would look like if the
+// removal on the form were confirmed
+func (d removeAnAttorneyData) Simulation() sirius.AttorneySimulation {
+	changes := updateAttorneyStatus(d.ActiveAttorneys, d.Form.RemovedAttorneyUid, d.Form.RemovedReason, d.InactiveAttorneys, d.Form.EnabledAttorneyUids)
+
+	return sirius.SimulateAttorneyChanges(d.CaseSummary.DigitalLpa.LpaStoreData, changes)
+}
+
+// setConfirmationDetails fills in the summary of the change shown on the
+// confirmation page
+func (d *removeAnAttorneyData) setConfirmationDetails(removedReasons []sirius.RefDataItem) {
+	d.RemovedAttorneysDetails = updateRemovedAttorneysDetails(d.ActiveAttorneys, d.Form.RemovedAttorneyUid)
+	d.EnabledAttorneysDetails = updateEnabledAttorneysDetails(d.Form.EnabledAttorneyUids, d.InactiveAttorneys)
+
+	for _, r := range removedReasons {
+		if r.Handle == d.Form.RemovedReason {
+			d.RemovedReason = r
+		}
+	}
+
+	if len(d.Form.DecisionAttorneysUids) > 0 {
+		d.DecisionAttorneysDetails = updateDecisionAttorneyDetails(d.CaseSummary.DigitalLpa.LpaStoreData.Attorneys, d.Form.DecisionAttorneysUids)
+	}
 }
 
 func RemoveAnAttorney(client RemoveAnAttorneyClient, removeTmpl template.Template, confirmTmpl template.Template, decisionsTmpl template.Template) Handler {
@@ -94,6 +121,7 @@ func RemoveAnAttorney(client RemoveAnAttorneyClient, removeTmpl template.Templat
 			FormName:                     "remove",
 			Decisions:                    caseSummary.DigitalLpa.LpaStoreData.HowAttorneysMakeDecisions,
 			ReplacementAttorneyDecisions: caseSummary.DigitalLpa.LpaStoreData.HowReplacementAttorneysMakeDecisions,
+			WarningsToAcknowledge:        caseSummary.WarningsToAcknowledge(),
 		}
 
 		lpa := data.CaseSummary.DigitalLpa
@@ -150,37 +178,28 @@ func RemoveAnAttorney(client RemoveAnAttorneyClient, removeTmpl template.Templat
 			if !data.Error.Any() {
 				switch submissionStep {
 				case "confirm":
+					validateWarningsAcknowledged(r, data.WarningsToAcknowledge, data.Error.Field)
+					if data.Error.Any() {
+						w.WriteHeader(http.StatusBadRequest)
+						data.setConfirmationDetails(allRemovedReasons)
+						return confirmTmpl(w, data)
+					}
+
 					attorneyUpdatedStatus := updateAttorneyStatus(data.ActiveAttorneys, data.Form.RemovedAttorneyUid, data.Form.RemovedReason, data.InactiveAttorneys, data.Form.EnabledAttorneyUids)
 					attorneyDecisions := updateAttorneyDecision(data.Form.SkipDecisionAttorney, data.ActiveAttorneys, data.DecisionAttorneys, data.CaseSummary.DigitalLpa.LpaStoreData.Attorneys, data.Form.EnabledAttorneyUids, data.Form.RemovedAttorneyUid, data.Form.DecisionAttorneysUids)
-					return confirmStep(ctx, client, data.CaseSummary.DigitalLpa.UID, &data.Error, data.Decisions, w, attorneyUpdatedStatus, attorneyDecisions)
-				case "decision":
-					data.RemovedAttorneysDetails = updateRemovedAttorneysDetails(data.ActiveAttorneys, data.Form.RemovedAttorneyUid)
-					data.EnabledAttorneysDetails = updateEnabledAttorneysDetails(data.Form.EnabledAttorneyUids, data.InactiveAttorneys)
+					err := confirmStep(ctx, client, data.CaseSummary.DigitalLpa.UID, &data.Error, data.Decisions, w, attorneyUpdatedStatus, attorneyDecisions)
 
-					for _, r := range allRemovedReasons {
-						if r.Handle == data.Form.RemovedReason {
-							data.RemovedReason = r
-						}
+					if _, ok := err.(RedirectError); ok && !data.Error.Any() {
+						acknowledgeWarnings(ctx, client, data.WarningsToAcknowledge, "remove-an-attorney")
 					}
 
-					if len(data.Form.DecisionAttorneysUids) > 0 {
-						data.DecisionAttorneysDetails = updateDecisionAttorneyDetails(data.CaseSummary.DigitalLpa.LpaStoreData.Attorneys, data.Form.DecisionAttorneysUids)
-					}
+					return err
+				case "decision":
+					data.setConfirmationDetails(allRemovedReasons)
 
 					return confirmTmpl(w, data)
 				default: //"remove"
-					data.RemovedAttorneysDetails = updateRemovedAttorneysDetails(data.ActiveAttorneys, data.Form.RemovedAttorneyUid)
-					data.EnabledAttorneysDetails = updateEnabledAttorneysDetails(data.Form.EnabledAttorneyUids, data.InactiveAttorneys)
-
-					for _, r := range allRemovedReasons {
-						if r.Handle == data.Form.RemovedReason {
-							data.RemovedReason = r
-						}
-					}
-
-					if len(data.Form.DecisionAttorneysUids) > 0 {
-						data.DecisionAttorneysDetails = updateDecisionAttorneyDetails(data.CaseSummary.DigitalLpa.LpaStoreData.Attorneys, data.Form.DecisionAttorneysUids)
-					}
+					data.setConfirmationDetails(allRemovedReasons)
 
 					if data.Decisions != shared.HowAttorneysMakeDecisionsJointlyForSomeSeverallyForOthers {
 						return confirmTmpl(w, data)
@@ -17,6 +17,11 @@ type mockRemoveAnAttorneyClient struct {
 	mock.Mock
 }
 
+func (m *mockRemoveAnAttorneyClient) AcknowledgeWarning(ctx sirius.Context, warningID int, action string) error {
+	args := m.Called(ctx, warningID, action)
+	return args.Error(0)
+}
+
 func (m *mockRemoveAnAttorneyClient) CaseSummary(ctx sirius.Context, uid string) (sirius.CaseSummary, error) {
 	args := m.Called(ctx, uid)
 	return args.Get(0).(sirius.CaseSummary), args.Error(1)
@@ -628,6 +633,109 @@ func TestPostConfirmAttorneyRemovalWithoutDecisionsRedirects(t *testing.T) {
 	assert.Equal(t, RedirectError("/lpa/M-1111-2222-3333"), err)
 }
 
+func TestPostConfirmAttorneyRemovalWithWarningsToAcknowledge(t *testing.T) {
+	caseSummary := removeAnAttorneyCaseSummary
+	caseSummary.WarningList = []sirius.Warning{{ID: 31, WarningType: "Safeguarding", Severity: sirius.WarningSeverityHigh}}
+
+	form := url.Values{
+		"removedAttorney": {ActiveOriginalAttorneyUid},
+		"enabledAttorney": {InactiveReplacementAttorneyUID},
+		"removedReason":   {"DECEASED"},
+		"step":            {"confirm"},
+	}
+
+	t.Run("not acknowledged", func(t *testing.T) {
+		client := &mockRemoveAnAttorneyClient{}
+		client.
+			On("CaseSummary", mock.Anything, "M-1111-2222-3333").
+			Return(caseSummary, nil)
+		client.
+			On("RefDataByCategory", mock.Anything, sirius.AttorneyRemovedReasonCategory).
+			Return(removeAttorneyReasons, nil)
+
+		removeTemplate := &mockTemplate{}
+		confirmTemplate := &mockTemplate{}
+		confirmTemplate.
+			On("Func", mock.Anything, mock.MatchedBy(func(data removeAnAttorneyData) bool {
+				return assert.Equal(t, sirius.FieldErrors{"acknowledgeWarnings": {"reason": "Confirm you have read the warnings on this case"}}, data.Error.Field) &&
+					assert.Equal(t, "DECEASED", data.RemovedReason.Handle)
+			})).
+			Return(nil)
+		decisionsTemplate := &mockTemplate{}
+
+		server := newMockServer("/lpa/{uid}/remove-an-attorney", RemoveAnAttorney(client, removeTemplate.Func, confirmTemplate.Func, decisionsTemplate.Func))
+
+		req, _ := http.NewRequest(http.MethodPost, "/lpa/M-1111-2222-3333/remove-an-attorney", strings.NewReader(form.Encode()))
+		req.Header.Add("Content-Type", formUrlEncoded)
+		resp, err := server.serve(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		mock.AssertExpectationsForObjects(t, client, confirmTemplate)
+		client.AssertNotCalled(t, "ChangeAttorneyStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("acknowledged", func(t *testing.T) {
+		client := &mockRemoveAnAttorneyClient{}
+		client.
+			On("CaseSummary", mock.Anything, "M-1111-2222-3333").
+			Return(caseSummary, nil)
+		client.
+			On("RefDataByCategory", mock.Anything, sirius.AttorneyRemovedReasonCategory).
+			Return(removeAttorneyReasons, nil)
+		client.
+			On("AcknowledgeWarning", mock.Anything, 31, "remove-an-attorney").
+			Return(nil)
+		client.
+			On("ChangeAttorneyStatus", mock.Anything, "M-1111-2222-3333", []sirius.AttorneyUpdatedStatus{
+				{UID: ActiveOriginalAttorneyUid, Status: "removed", RemovedReason: "DECEASED"},
+				{UID: InactiveReplacementAttorneyUID, Status: "active"},
+			}).
+			Return(nil)
+
+		server := newMockServer("/lpa/{uid}/remove-an-attorney", RemoveAnAttorney(client, nil, nil, nil))
+
+		acknowledgedForm := url.Values{"acknowledgeWarnings": {"yes"}}
+		for k, v := range form {
+			acknowledgedForm[k] = v
+		}
+
+		req, _ := http.NewRequest(http.MethodPost, "/lpa/M-1111-2222-3333/remove-an-attorney", strings.NewReader(acknowledgedForm.Encode()))
+		req.Header.Add("Content-Type", formUrlEncoded)
+		_, err := server.serve(req)
+
+		assert.Equal(t, RedirectError("/lpa/M-1111-2222-3333"), err)
+		mock.AssertExpectationsForObjects(t, client)
+	})
+
+	t.Run("removal fails", func(t *testing.T) {
+		client := &mockRemoveAnAttorneyClient{}
+		client.
+			On("CaseSummary", mock.Anything, "M-1111-2222-3333").
+			Return(caseSummary, nil)
+		client.
+			On("RefDataByCategory", mock.Anything, sirius.AttorneyRemovedReasonCategory).
+			Return(removeAttorneyReasons, nil)
+		client.
+			On("ChangeAttorneyStatus", mock.Anything, "M-1111-2222-3333", mock.Anything).
+			Return(sirius.ValidationError{Detail: "Attorney cannot be removed"})
+
+		server := newMockServer("/lpa/{uid}/remove-an-attorney", RemoveAnAttorney(client, nil, nil, nil))
+
+		acknowledgedForm := url.Values{"acknowledgeWarnings": {"yes"}}
+		for k, v := range form {
+			acknowledgedForm[k] = v
+		}
+
+		req, _ := http.NewRequest(http.MethodPost, "/lpa/M-1111-2222-3333/remove-an-attorney", strings.NewReader(acknowledgedForm.Encode()))
+		req.Header.Add("Content-Type", formUrlEncoded)
+		_, _ = server.serve(req)
+
+		mock.AssertExpectationsForObjects(t, client)
+		client.AssertNotCalled(t, "AcknowledgeWarning", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestPostRemoveAttorneyWithDecisionsOnDecisionsTemplate(t *testing.T) {
 	client := &mockRemoveAnAttorneyClient{}
 	client.
@@ -1374,3 +1482,42 @@ func TestValidateManageAttorneysPage(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveAnAttorneyDataSimulation(t *testing.T) {
+	attorneys := []sirius.LpaStoreAttorney{
+		{
+			LpaStorePerson:  sirius.LpaStorePerson{Uid: "1", FirstNames: "Jack", LastName: "Black"},
+			Status:          shared.ActiveAttorneyStatus.String(),
+			AppointmentType: shared.OriginalAppointmentType.String(),
+		},
+		{
+			LpaStorePerson:  sirius.LpaStorePerson{Uid: "2", FirstNames: "Jill", LastName: "Black"},
+			Status:          shared.ActiveAttorneyStatus.String(),
+			AppointmentType: shared.OriginalAppointmentType.String(),
+		},
+	}
+
+	data := removeAnAttorneyData{
+		CaseSummary: sirius.CaseSummary{
+			DigitalLpa: sirius.DigitalLpa{
+				LpaStoreData: sirius.LpaStoreData{
+					Attorneys:                 attorneys,
+					HowAttorneysMakeDecisions: shared.HowAttorneysMakeDecisionsJointly,
+				},
+			},
+		},
+		ActiveAttorneys: attorneys,
+		Form: formRemoveAttorney{
+			RemovedAttorneyUid: "1",
+			RemovedReason:      "DECEASED",
+			SkipEnableAttorney: "yes",
+		},
+	}
+
+	simulation := data.Simulation()
+
+	assert.Equal(t, 1, simulation.ActiveCount)
+	assert.False(t, simulation.Operable)
+	assert.Equal(t, shared.RemovedAttorneyStatus.String(), simulation.Attorneys[0].Status)
+	assert.Equal(t, []string{"Attorneys must act jointly but only 1 attorney would remain, so the LPA could not be used"}, simulation.Warnings)
+}
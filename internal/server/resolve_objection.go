@@ -2,9 +2,12 @@ package server
 
 import (
 	"fmt"
+	"net/http"
+
+	"golang.org/x/sync/errgroup"
+
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
-	"net/http"
 )
 
 type ResolveObjectionClient interface {
@@ -13,20 +16,27 @@ type ResolveObjectionClient interface {
 }
 
 type resolveObjectionData struct {
-	XSRFToken   string
-	Success     bool
+	XSRFToken    string
+	Success      bool
 	Error        sirius.ValidationError
 	ErrorsByCase map[string]sirius.ValidationError
 	CaseUID      string
-	ObjectionId string
-	Objection   sirius.Objection
-	Form        []formResolveObjection
+	ObjectionId  string
+	Objection    sirius.Objection
+	Form         []formResolveObjection
+	SharedNotes  string
 }
 
+// formResolveObjection is the outcome for one of the LPAs the objection was
+// made against. Resolved LPAs already have an outcome recorded, which is filled
+// in so that it can be corrected; Result explains why an outcome could not be
+// saved.
 type formResolveObjection struct {
 	UID             string `form:"uid"`
 	Resolution      string `form:"resolution"`
 	ResolutionNotes string `form:"resolutionNotes"`
+	Resolved        bool
+	Result          string
 }
 
 func ResolveObjection(client ResolveObjectionClient, formTmpl template.Template) Handler {
@@ -52,6 +62,14 @@ func ResolveObjection(client ResolveObjectionClient, formTmpl template.Template)
 
 		for i, uid := range obj.LpaUids {
 			data.Form[i].UID = uid
+
+			for _, resolution := range obj.Resolutions {
+				if resolution.Uid == uid && resolution.Resolution != "" {
+					data.Form[i].Resolution = resolution.Resolution
+					data.Form[i].ResolutionNotes = resolution.ResolutionNotes
+					data.Form[i].Resolved = true
+				}
+			}
 		}
 
 		Errors := make(map[string]sirius.ValidationError)
@@ -61,29 +79,60 @@ func ResolveObjection(client ResolveObjectionClient, formTmpl template.Template)
 				return err
 			}
 
-			var validationErrors sirius.ValidationError
+			data.SharedNotes = r.PostForm.Get("sharedNotes")
 
-			for i, uid := range obj.LpaUids {
-				resolution := r.PostForm.Get(fmt.Sprintf("resolution-%s", uid))
-				notes := r.PostForm.Get(fmt.Sprintf("resolutionNotes-%s", uid))
+			// each LPA is resolved separately so that one failing does not stop
+			// the others from being saved
+			var group errgroup.Group
+			results := make([]error, len(data.Form))
 
-				data.Form[i].Resolution = resolution
-				data.Form[i].ResolutionNotes = notes
+			for i := range data.Form {
+				uid := data.Form[i].UID
+				data.Form[i].Resolution = r.PostForm.Get(fmt.Sprintf("resolution-%s", uid))
+				data.Form[i].ResolutionNotes = r.PostForm.Get(fmt.Sprintf("resolutionNotes-%s", uid))
 
-				err := client.ResolveObjection(ctx, objectionID, uid, sirius.ResolutionRequest{
-					Resolution: resolution,
-					Notes:      notes,
+				request := sirius.ResolutionRequest{
+					Resolution: data.Form[i].Resolution,
+					Notes:      data.Form[i].ResolutionNotes,
+				}
+				if request.Notes == "" {
+					request.Notes = data.SharedNotes
+				}
+
+				group.Go(func() error {
+					results[i] = client.ResolveObjection(ctx, objectionID, uid, request)
+					return nil
 				})
+			}
+
+			_ = group.Wait()
 
+			var validationErrors sirius.ValidationError
+			var firstErr error
+			saved, failed := 0, 0
+
+			for i, err := range results {
 				if ve, ok := err.(sirius.ValidationError); ok {
-					Errors[uid] = ve
+					Errors[data.Form[i].UID] = ve
 					validationErrors = ve
+					failed++
 				} else if err != nil {
-					return err
+					data.Form[i].Result = "The outcome could not be saved, try again"
+					if firstErr == nil {
+						firstErr = err
+					}
+					failed++
+				} else {
+					data.Form[i].Resolved = true
+					saved++
 				}
 			}
 
-			if len(Errors) > 0 {
+			if failed > 0 {
+				if saved == 0 && len(Errors) == 0 {
+					return firstErr
+				}
+
 				w.WriteHeader(http.StatusBadRequest)
 				data.Error = validationErrors
 				data.ErrorsByCase = Errors
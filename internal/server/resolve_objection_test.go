@@ -183,3 +183,144 @@ func TestPostResolveObjectionWhenValidationError(t *testing.T) {
 	assert.Nil(t, err)
 	mock.AssertExpectationsForObjects(t, client, template)
 }
+
+var testObjectionMultipleLpas = sirius.Objection{
+	ID:            7,
+	ObjectionType: "factual",
+	ReceivedDate:  "2025-03-12",
+	LpaUids:       []string{"M-1111-1111-1111", "M-2222-2222-2222", "M-3333-3333-3333"},
+	Resolutions: []sirius.ObjectionResolution{
+		{Uid: "M-1111-1111-1111", Resolution: "notUpheld", ResolutionNotes: "Already decided"},
+	},
+}
+
+func TestGetResolveObjectionWithMultipleLpas(t *testing.T) {
+	client := &mockResolveObjectionClient{}
+	client.
+		On("GetObjection", mock.Anything, "7").
+		Return(testObjectionMultipleLpas, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, resolveObjectionData{
+			CaseUID:     "M-2222-2222-2222",
+			ObjectionId: "7",
+			Objection:   testObjectionMultipleLpas,
+			Form: []formResolveObjection{
+				{UID: "M-1111-1111-1111", Resolution: "notUpheld", ResolutionNotes: "Already decided", Resolved: true},
+				{UID: "M-2222-2222-2222"},
+				{UID: "M-3333-3333-3333"},
+			},
+		}).
+		Return(nil)
+
+	server := newMockServer("/lpa/{uid}/objection/{id}/resolve", ResolveObjection(client, template.Func))
+
+	r, _ := http.NewRequest(http.MethodGet, "/lpa/M-2222-2222-2222/objection/7/resolve", nil)
+	_, err := server.serve(r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestPostResolveObjectionWithMultipleLpas(t *testing.T) {
+	client := &mockResolveObjectionClient{}
+	client.
+		On("GetObjection", mock.Anything, "7").
+		Return(testObjectionMultipleLpas, nil)
+	client.
+		On("ResolveObjection", mock.Anything, "7", "M-1111-1111-1111", sirius.ResolutionRequest{
+			Resolution: "upheld",
+			Notes:      "Corrected",
+		}).
+		Return(nil)
+	client.
+		On("ResolveObjection", mock.Anything, "7", "M-2222-2222-2222", sirius.ResolutionRequest{
+			Resolution: "upheld",
+			Notes:      "Shared",
+		}).
+		Return(nil)
+	client.
+		On("ResolveObjection", mock.Anything, "7", "M-3333-3333-3333", sirius.ResolutionRequest{
+			Resolution: "notUpheld",
+			Notes:      "Own notes",
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"resolution-M-1111-1111-1111":      {"upheld"},
+		"resolutionNotes-M-1111-1111-1111": {"Corrected"},
+		"resolution-M-2222-2222-2222":      {"upheld"},
+		"resolution-M-3333-3333-3333":      {"notUpheld"},
+		"resolutionNotes-M-3333-3333-3333": {"Own notes"},
+		"sharedNotes":                      {"Shared"},
+	}
+
+	server := newMockServer("/lpa/{uid}/objection/{id}/resolve", ResolveObjection(client, nil))
+
+	r, _ := http.NewRequest(http.MethodPost, "/lpa/M-2222-2222-2222/objection/7/resolve", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	_, err := server.serve(r)
+
+	assert.Equal(t, RedirectError("/lpa/M-2222-2222-2222"), err)
+	mock.AssertExpectationsForObjects(t, client)
+}
+
+func TestPostResolveObjectionWithMultipleLpasWhenSomeFail(t *testing.T) {
+	client := &mockResolveObjectionClient{}
+	client.
+		On("GetObjection", mock.Anything, "7").
+		Return(testObjectionMultipleLpas, nil)
+	client.
+		On("ResolveObjection", mock.Anything, "7", "M-1111-1111-1111", sirius.ResolutionRequest{
+			Resolution: "notUpheld",
+			Notes:      "Already decided",
+		}).
+		Return(nil)
+	client.
+		On("ResolveObjection", mock.Anything, "7", "M-2222-2222-2222", sirius.ResolutionRequest{
+			Resolution: "upheld",
+			Notes:      "Shared",
+		}).
+		Return(nil)
+	client.
+		On("ResolveObjection", mock.Anything, "7", "M-3333-3333-3333", sirius.ResolutionRequest{
+			Resolution: "notUpheld",
+			Notes:      "Shared",
+		}).
+		Return(errExample)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, resolveObjectionData{
+			CaseUID:      "M-2222-2222-2222",
+			ObjectionId:  "7",
+			Objection:    testObjectionMultipleLpas,
+			ErrorsByCase: map[string]sirius.ValidationError{},
+			SharedNotes:  "Shared",
+			Form: []formResolveObjection{
+				{UID: "M-1111-1111-1111", Resolution: "notUpheld", ResolutionNotes: "Already decided", Resolved: true},
+				{UID: "M-2222-2222-2222", Resolution: "upheld", Resolved: true},
+				{UID: "M-3333-3333-3333", Resolution: "notUpheld", Result: "The outcome could not be saved, try again"},
+			},
+		}).
+		Return(nil)
+
+	form := url.Values{
+		"resolution-M-1111-1111-1111":      {"notUpheld"},
+		"resolutionNotes-M-1111-1111-1111": {"Already decided"},
+		"resolution-M-2222-2222-2222":      {"upheld"},
+		"resolution-M-3333-3333-3333":      {"notUpheld"},
+		"sharedNotes":                      {"Shared"},
+	}
+
+	server := newMockServer("/lpa/{uid}/objection/{id}/resolve", ResolveObjection(client, template.Func))
+
+	r, _ := http.NewRequest(http.MethodPost, "/lpa/M-2222-2222-2222/objection/7/resolve", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", formUrlEncoded)
+	resp, err := server.serve(r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
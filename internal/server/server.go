@@ -15,6 +15,7 @@ import (
 	"github.com/ministryofjustice/opg-go-common/securityheaders"
 	"github.com/ministryofjustice/opg-go-common/telemetry"
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/companies"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -45,10 +46,12 @@ type Client interface {
 	AddFeeDecisionClient
 	AddObjectionClient
 	AddPaymentClient
+	AddRefundClient
 	AllocateCasesClient
 	ApplyFeeReductionClient
 	AssignTaskClient
 	AttorneyDecisionsClient
+	BulkTasksClient
 	ChangeAttorneyDetailsClient
 	ChangeCaseStatusClient
 	ChangeCertificateProviderDetailsClient
@@ -58,6 +61,8 @@ type Client interface {
 	ChangeTrustCorporationDetailsClient
 	ClearTaskClient
 	CompareDocsClient
+	CompensationReportClient
+	ComplaintsDashboardClient
 	CreateAdditionalDraftClient
 	CreateAttorneyClient
 	CreateCertificateProviderClient
@@ -86,6 +91,7 @@ type Client interface {
 	EditFeeReductionClient
 	EditInvestigationClient
 	EditPaymentClient
+	EditRefundStatusClient
 	EventClient
 	GetApplicationProgressClient
 	GetDocumentsClient
@@ -93,13 +99,18 @@ type Client interface {
 	GetLpaDetailsClient
 	GetLpaHistoryClient
 	GetPaymentsClient
+	ImportPaymentsClient
 	InvestigationHoldClient
+	InvestigationsOnHoldClient
 	LinkPersonClient
 	ManageAttorneysClient
 	ManageFeesClient
 	ManageRestrictionsClient
+	MergePersonClient
 	MiReportingClient
 	ObjectionOutcomeClient
+	ObjectionsDashboardClient
+	PersonNetworkClient
 	PostcodeLookupClient
 	RelationshipClient
 	RemoveAnAttorneyClient
@@ -121,23 +132,28 @@ type Client interface {
 
 var decoder = form.NewDecoder()
 
-func New(logger *slog.Logger, client Client, templates template.Templates, prefix, siriusPublicURL, webDir string) http.Handler {
+func New(logger *slog.Logger, client Client, companyRegistry companies.CompanyRegistry, templates Templates, prefix, siriusPublicURL, webDir string) http.Handler {
 	wrap := errorHandler(templates.Get("error.gohtml"), prefix, siriusPublicURL)
 	mux := http.NewServeMux()
+	postcodeLookup := newPostcodeCache(client, postcodeCacheSize, postcodeCacheTTL)
 
 	mux.Handle("/", http.NotFoundHandler())
 	mux.HandleFunc("/health-check", func(w http.ResponseWriter, r *http.Request) {})
+	mux.Handle("/language", wrap(LanguageToggle(siriusPublicURL)))
 
 	//search
 	mux.Handle("/search-users", wrap(SearchUsers(client)))
 	mux.Handle("/search-persons", wrap(SearchDonors(client)))
-	mux.Handle("/search-postcode", wrap(SearchPostcode(client)))
+	mux.Handle("/search-postcode", wrap(SearchPostcode(postcodeLookup)))
 	mux.Handle("/search", wrap(Search(client, templates.Get("search.gohtml"))))
 
 	//shared templates (Used in both modernise and LPA)
 	mux.Handle("/add-payment", wrap(AddPayment(client, templates.Get("add-payment.gohtml"))))
+	mux.Handle("/add-refund", wrap(AddRefund(client, templates.Get("add-refund.gohtml"))))
+	mux.Handle("/import-payments", wrap(ImportPayments(client, templates.Get("import-payments.gohtml"))))
 	mux.Handle("/apply-fee-reduction", wrap(ApplyFeeReduction(client, templates.Get("apply-fee-reduction.gohtml"))))
 	mux.Handle("/assign-task", wrap(AssignTask(client, templates.Get("assign-task.gohtml"))))
+	mux.Handle("/bulk-tasks", wrap(BulkTasks(client, templates.Get("bulk-tasks.gohtml"))))
 	mux.Handle("/create-event", wrap(Event(client, templates.Get("event.gohtml"), templates.Get("event-partial.gohtml"))))
 	mux.Handle("/create-task", wrap(Task(client, templates.Get("create-task.gohtml"))))
 	mux.Handle("/create-warning", wrap(Warning(client, templates.Get("warning.gohtml"))))
@@ -167,9 +183,12 @@ func New(logger *slog.Logger, client Client, templates template.Templates, prefi
 	mux.Handle("/lpa/{uid}/manage-restrictions", wrap(ManageRestrictions(client, templates.Get("manage-restrictions.gohtml"), templates.Get("confirm-restrictions.gohtml"))))
 	mux.Handle("/lpa/{uid}/payments", wrap(GetPayments(client, templates.Get("mlpa-payments.gohtml"))))
 	mux.Handle("/lpa/{uid}/remove-an-attorney", wrap(RemoveAnAttorney(client, templates.Get("mlpa-remove-attorney.gohtml"), templates.Get("mlpa-confirm-attorney-removal.gohtml"), templates.Get("mlpa-attorney-decisions.gohtml"))))
-	mux.Handle("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", wrap(ChangeTrustCorporationDetails(client, templates.Get("change-trust-corporation-details.gohtml"))))
+	mux.Handle("/lpa/{uid}/trust-corporation/{trustCorporationUID}/change-details", wrap(ChangeTrustCorporationDetails(client, companyRegistry, templates.Get("change-trust-corporation-details.gohtml"))))
 	mux.Handle("/lpa/{uid}/update-decisions", wrap(UpdateDecisions(client, templates.Get("mlpa-update-decisions.gohtml"))))
 	mux.Handle("/manage-fees", wrap(AddFeeDecision(client, templates.Get("manage_fees.gohtml"))))
+	mux.Handle("/objections", wrap(ObjectionsDashboard(client, templates.Get("objections-dashboard.gohtml"))))
+	mux.Handle("/complaints", wrap(ComplaintsDashboard(client, templates.Get("complaints-dashboard.gohtml"))))
+	mux.Handle("/compensation-report", wrap(CompensationReport(client, templates.Get("compensation-report.gohtml"))))
 
 	//LPA
 	mux.Handle("/action-panel", wrap(ActionPanel(client, templates.Get("action-panel-wrapper.gohtml"))))
@@ -187,7 +206,7 @@ func New(logger *slog.Logger, client Client, templates template.Templates, prefi
 	mux.Handle("/create-relationship", wrap(Relationship(client, templates.Get("create-relationship.gohtml"))))
 	mux.Handle("/create-notified-person", wrap(CreateNotifiedPerson(client, templates.Get("create-notified-person.gohtml"))))
 	mux.Handle("/create-replacement-attorney", wrap(CreateReplacementAttorney(client, templates.Get("create-replacement-attorney-wrapper.gohtml"), templates.Get("create-replacement-attorney-partial-wrapper.gohtml"))))
-	mux.Handle("/create-trust-corporation", wrap(CreateTrustCorporation(client, templates.Get("create-trust-corporation.gohtml"))))
+	mux.Handle("/create-trust-corporation", wrap(CreateTrustCorporation(client, companyRegistry, templates.Get("create-trust-corporation.gohtml"))))
 	mux.Handle("/compare/{id}/{caseUid}", wrap(CompareDocs(client, templates.Get("compare-docs.gohtml"))))
 	mux.Handle("/delete-fee-reduction", wrap(DeletePayment(client, templates.Get("delete-fee-reduction.gohtml"))))
 	mux.Handle("/delete-note", wrap(DeleteNote(client, templates.Get("delete-note.gohtml"))))
@@ -195,6 +214,7 @@ func New(logger *slog.Logger, client Client, templates template.Templates, prefi
 	mux.Handle("/delete-relationship", wrap(DeleteRelationship(client, templates.Get("delete-relationship.gohtml"))))
 	mux.Handle("/donor/{donorId}/details", wrap(DonorDetails(client, templates.Get("donor_details.gohtml"))))
 	mux.Handle("/donor/{id}/documents", wrap(DocumentList(client, templates.Get("documents.gohtml"))))
+	mux.Handle("/donor/{id}/network", wrap(PersonNetwork(client, templates.Get("person-network.gohtml"))))
 	mux.Handle("/donor/{donorId}/history", wrap(GetLpaHistory(client, templates.Get("lpa-history.gohtml"))))
 	mux.Handle("/view-document/{uuid}/{id}", wrap(ViewDocument(client, templates.Get("view-document.gohtml"))))
 	mux.Handle("/delete-document/{uuid}", wrap(DeleteDocument(client, templates.Get("delete-document.gohtml"))))
@@ -205,8 +225,11 @@ func New(logger *slog.Logger, client Client, templates template.Templates, prefi
 	mux.Handle("/edit-fee-reduction", wrap(EditFeeReduction(client, templates.Get("edit-fee-reduction-wrapper.gohtml"), templates.Get("edit-fee-reduction-partial-wrapper.gohtml"))))
 	mux.Handle("/edit-investigation", wrap(EditInvestigation(client, templates.Get("edit_investigation.gohtml"))))
 	mux.Handle("/edit-payment", wrap(EditPayment(client, templates.Get("edit-payment-wrapper.gohtml"), templates.Get("edit-payment-partial-wrapper.gohtml"))))
+	mux.Handle("/edit-refund-status", wrap(EditRefundStatus(client, templates.Get("edit-refund-status.gohtml"))))
 	mux.Handle("/investigation-hold", wrap(InvestigationHold(client, templates.Get("investigation_hold.gohtml"))))
+	mux.Handle("/investigations/on-hold", wrap(InvestigationsOnHold(client, templates.Get("investigations-on-hold.gohtml"))))
 	mux.Handle("/link-person", wrap(LinkPerson(client, templates.Get("link-person-wrapper.gohtml"), templates.Get("link-person-partial-wrapper.gohtml"))))
+	mux.Handle("/merge-person", wrap(MergePerson(client, templates.Get("merge-person-wrapper.gohtml"), templates.Get("merge-person-partial-wrapper.gohtml"))))
 	mux.Handle("/mi-reporting", wrap(MiReporting(client, templates.Get("mi-reporting.gohtml"), templates.Get("mi-reporting-partial.gohtml"))))
 	mux.Handle("/payments/{id}", wrap(GetPayments(client, templates.Get("payments.gohtml"))))
 	mux.Handle("/select-or-create-correspondent", wrap(SelectOrCreateCorrespondent(client, templates.Get("select-or-create-correspondent-wrapper.gohtml"), templates.Get("select-or-create-correspondent-partial-wrapper.gohtml"))))
@@ -228,7 +251,7 @@ func New(logger *slog.Logger, client Client, templates template.Templates, prefi
 	loggerMiddleware := telemetry.Middleware(logger)
 	xsrfMiddleware := xsrfHandler(logger, templates.Get("error.gohtml"), siriusPublicURL)
 
-	return otelhttp.NewHandler(http.StripPrefix(prefix, xsrfMiddleware(loggerMiddleware(muxWithHeaders))), "lpa-frontend")
+	return otelhttp.NewHandler(http.StripPrefix(prefix, languageHandler(xsrfMiddleware(loggerMiddleware(muxWithHeaders)))), "lpa-frontend")
 }
 
 type Handler func(w http.ResponseWriter, r *http.Request) error
@@ -44,7 +44,7 @@ func (e anUnauthorizedError) IsUnauthorized() bool {
 }
 
 func TestNew(t *testing.T) {
-	assert.Implements(t, (*http.Handler)(nil), New(nil, nil, nil, "", "", ""))
+	assert.Implements(t, (*http.Handler)(nil), New(nil, nil, nil, LocalisedTemplates{}, "", "", ""))
 }
 
 func TestErrorHandlerError(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ministryofjustice/opg-go-common/telemetry"
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 )
@@ -15,6 +16,19 @@ type SiriusHeaderCalendarClient interface {
 	BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error)
 }
 
+// bankHolidaysOrWarn fetches the bank holidays used to count working days. A
+// failure is logged and reported by the second return value, so that pages
+// can show deadlines counted without bank holidays rather than failing.
+func bankHolidaysOrWarn(ctx sirius.Context, client SiriusHeaderCalendarClient) (sirius.BankHolidays, bool) {
+	bankHolidays, err := client.BankHolidays(ctx)
+	if err != nil {
+		telemetry.LoggerFromContext(ctx.Context).Warn("bank holidays lookup failed", "error", err)
+		return nil, true
+	}
+
+	return bankHolidays, false
+}
+
 type WorkingDaysMode string
 
 const (
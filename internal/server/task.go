@@ -5,15 +5,19 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/ministryofjustice/opg-go-common/telemetry"
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"golang.org/x/sync/errgroup"
 )
 
 type TaskClient interface {
+	BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error)
 	CreateTask(ctx sirius.Context, caseID int, task sirius.TaskRequest) error
 	GetUserDetails(ctx sirius.Context) (sirius.User, error)
+	TaskTemplates(ctx sirius.Context) ([]sirius.TaskTemplate, error)
 	TaskTypes(ctx sirius.Context) ([]string, error)
 	Teams(ctx sirius.Context) ([]sirius.Team, error)
 	Case(ctx sirius.Context, id int) (sirius.Case, error)
@@ -35,9 +39,18 @@ type taskData struct {
 	DonorID          int
 	EntityType       string
 	CaseUIDs         string
+	Templates        []sirius.TaskTemplate
+	TemplateID       int
+	FollowUp         sirius.FollowUpTrigger
+
+	WithoutBankHolidays bool
 }
 
 func Task(client TaskClient, tmpl template.Template) Handler {
+	return taskWithNow(client, tmpl, time.Now)
+}
+
+func taskWithNow(client TaskClient, tmpl template.Template, now func() time.Time) Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		if err := r.ParseForm(); err != nil {
 			return err
@@ -77,6 +90,18 @@ func Task(client TaskClient, tmpl template.Template) Handler {
 			return nil
 		})
 
+		// templates only save typing, so the page still works without them
+		group.Go(func() error {
+			templates, err := client.TaskTemplates(ctx.With(groupCtx))
+			if err != nil {
+				telemetry.LoggerFromContext(ctx.Context).Warn("task templates lookup failed", "error", err)
+				return nil
+			}
+
+			data.Templates = templates
+			return nil
+		})
+
 		var caseitem sirius.Case
 		group.Go(func() error {
 			caseitem, err = client.Case(ctx.With(groupCtx), caseID)
@@ -102,6 +127,25 @@ func Task(client TaskClient, tmpl template.Template) Handler {
 			data.EntityType = string(entityType)
 		}
 
+		if trigger := sirius.FollowUpTrigger(r.FormValue("followUp")); trigger.Translation() != "" {
+			data.FollowUp = trigger
+		}
+
+		if r.Method != http.MethodPost {
+			if templateID, err := strconv.Atoi(r.FormValue("template")); err == nil {
+				if template, ok := sirius.FindTaskTemplate(data.Templates, templateID); ok {
+					var bankHolidays sirius.BankHolidays
+					bankHolidays, data.WithoutBankHolidays = bankHolidaysOrWarn(ctx, client)
+
+					data.TemplateID = template.ID
+					data.Task = template.Task(now(), bankHolidays)
+					if template.TeamID != 0 {
+						data.AssignTo = "team"
+					}
+				}
+			}
+		}
+
 		if r.Method == http.MethodPost {
 			task := sirius.TaskRequest{
 				Type:        postFormString(r, "taskType"),
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,16 @@ type mockTaskClient struct {
 	mock.Mock
 }
 
+func (m *mockTaskClient) BankHolidays(ctx sirius.Context) (sirius.BankHolidays, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(sirius.BankHolidays), args.Error(1)
+}
+
+func (m *mockTaskClient) TaskTemplates(ctx sirius.Context) ([]sirius.TaskTemplate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]sirius.TaskTemplate), args.Error(1)
+}
+
 func (m *mockTaskClient) CreateTask(ctx sirius.Context, caseID int, task sirius.TaskRequest) error {
 	args := m.Called(ctx, caseID, task)
 	return args.Error(0)
@@ -46,6 +57,9 @@ func TestGetTask(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
@@ -79,6 +93,9 @@ func TestGetTaskWithHXRequest(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
@@ -149,6 +166,9 @@ func TestGetTaskWhenTaskTypeErrors(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{}, errExample)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{}, nil)
@@ -169,6 +189,9 @@ func TestGetTaskWhenTeamsErrors(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{}, errExample)
@@ -189,6 +212,9 @@ func TestGetTaskWhenCaseErrors(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{}, nil)
@@ -209,6 +235,9 @@ func TestGetTaskWhenTemplateErrors(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{}, nil)
@@ -234,6 +263,9 @@ func TestPostTask(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
@@ -290,6 +322,9 @@ func TestPostTaskWhenAssignToMe(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
@@ -348,6 +383,9 @@ func TestPostTaskWhenAssignToCaseOwner(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
@@ -404,6 +442,9 @@ func TestGetTaskWhenGetUserDetailsErrors(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{}, nil)
@@ -458,6 +499,9 @@ func TestPostTaskWhenCreateTaskFails(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
@@ -500,6 +544,9 @@ func TestPostTaskWhenAssignToNotSet(t *testing.T) {
 	client.
 		On("TaskTypes", mock.Anything).
 		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate(nil), nil)
 	client.
 		On("Teams", mock.Anything).
 		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
@@ -591,6 +638,9 @@ func TestPostTaskWhenValidationError(t *testing.T) {
 			client.
 				On("TaskTypes", mock.Anything).
 				Return([]string{"a", "b"}, nil)
+			client.
+				On("TaskTemplates", mock.Anything).
+				Return([]sirius.TaskTemplate(nil), nil)
 			client.
 				On("Teams", mock.Anything).
 				Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
@@ -659,3 +709,132 @@ func TestPostTaskWhenValidationError(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTaskFromTemplate(t *testing.T) {
+	templates := []sirius.TaskTemplate{
+		{ID: 3, Name: "Chase evidence", Type: "b", DueInWorkingDays: 10},
+		{ID: 4, Name: "Review objection", Type: "a", Description: "Check the evidence", DueInWorkingDays: 2, TeamID: 1, Trigger: sirius.FollowUpObjectionAdded},
+	}
+
+	client := &mockTaskClient{}
+	client.
+		On("TaskTypes", mock.Anything).
+		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return(templates, nil)
+	client.
+		On("Teams", mock.Anything).
+		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
+	client.
+		On("Case", mock.Anything, 123).
+		Return(sirius.Case{UID: "M-0000-0000-0000", CaseType: "DIGITAL_LPA"}, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays{}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, taskData{
+			TaskTypes: []string{"a", "b"},
+			Teams:     []sirius.Team{{ID: 1, DisplayName: "A Team"}},
+			Entity:    "DIGITAL_LPA M-0000-0000-0000",
+			CaseID:    123,
+			CaseUID:   "M-0000-0000-0000",
+			Templates: templates,
+			Task: sirius.TaskRequest{
+				AssigneeID:  1,
+				Type:        "a",
+				Name:        "Review objection",
+				Description: "Check the evidence",
+				DueDate:     "2024-05-07",
+			},
+			AssignTo:   "team",
+			TemplateID: 4,
+			FollowUp:   sirius.FollowUpObjectionAdded,
+		}).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123&template=4&followUp=objection-added", nil)
+	w := httptest.NewRecorder()
+
+	// a Friday
+	today := time.Date(2024, time.May, 3, 9, 0, 0, 0, time.UTC)
+	err := taskWithNow(client, template.Func, func() time.Time { return today })(w, r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetTaskFromTemplateWhenBankHolidaysError(t *testing.T) {
+	templates := []sirius.TaskTemplate{
+		{ID: 4, Name: "Review objection", Type: "a", DueInWorkingDays: 2},
+	}
+
+	client := &mockTaskClient{}
+	client.
+		On("TaskTypes", mock.Anything).
+		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return(templates, nil)
+	client.
+		On("Teams", mock.Anything).
+		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
+	client.
+		On("Case", mock.Anything, 123).
+		Return(sirius.Case{UID: "M-0000-0000-0000", CaseType: "DIGITAL_LPA"}, nil)
+	client.
+		On("BankHolidays", mock.Anything).
+		Return(sirius.BankHolidays(nil), errExample)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data taskData) bool {
+			return assert.Equal(t, sirius.DateString("2024-05-07"), data.Task.DueDate) &&
+				assert.True(t, data.WithoutBankHolidays)
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123&template=4", nil)
+	w := httptest.NewRecorder()
+
+	today := time.Date(2024, time.May, 3, 9, 0, 0, 0, time.UTC)
+	err := taskWithNow(client, template.Func, func() time.Time { return today })(w, r)
+
+	assert.Nil(t, err)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
+
+func TestGetTaskWhenTaskTemplatesError(t *testing.T) {
+	client := &mockTaskClient{}
+	client.
+		On("TaskTypes", mock.Anything).
+		Return([]string{"a", "b"}, nil)
+	client.
+		On("TaskTemplates", mock.Anything).
+		Return([]sirius.TaskTemplate{}, errExample)
+	client.
+		On("Teams", mock.Anything).
+		Return([]sirius.Team{{ID: 1, DisplayName: "A Team"}}, nil)
+	client.
+		On("Case", mock.Anything, 123).
+		Return(sirius.Case{UID: "7000-0000-0000", CaseType: "LPA"}, nil)
+
+	template := &mockTemplate{}
+	template.
+		On("Func", mock.Anything, mock.MatchedBy(func(data taskData) bool {
+			return data.Templates == nil && data.TemplateID == 0 && data.Task == sirius.TaskRequest{}
+		})).
+		Return(nil)
+
+	r, _ := http.NewRequest(http.MethodGet, "/?id=123&template=4", nil)
+	w := httptest.NewRecorder()
+
+	err := Task(client, template.Func)(w, r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	mock.AssertExpectationsForObjects(t, client, template)
+}
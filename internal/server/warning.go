@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
@@ -12,7 +13,7 @@ import (
 
 type WarningClient interface {
 	RefDataByCategory(ctx sirius.Context, category string) ([]sirius.RefDataItem, error)
-	CreateWarning(ctx sirius.Context, personId int, warningType, warningNote string, caseIDs []int) error
+	CreateWarning(ctx sirius.Context, personId int, warningType, warningNote string, caseIDs []int, details sirius.WarningDetails) error
 	CasesByDonor(ctx sirius.Context, id int) ([]sirius.Case, error)
 }
 
@@ -24,6 +25,7 @@ type warningData struct {
 
 	WarningType string
 	WarningText string
+	Details     sirius.WarningDetails
 	Cases       []sirius.Case
 	DonorId     int
 	CaseUids    string
@@ -32,6 +34,10 @@ type warningData struct {
 }
 
 func Warning(client WarningClient, tmpl template.Template) Handler {
+	return warningWithNow(client, tmpl, time.Now)
+}
+
+func warningWithNow(client WarningClient, tmpl template.Template, now func() time.Time) Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		personId, err := strToIntOrStatusError(r.FormValue("id"))
 		if err != nil {
@@ -70,6 +76,11 @@ func Warning(client WarningClient, tmpl template.Template) Handler {
 		if r.Method == http.MethodPost {
 			warningType := postFormString(r, "warningType")
 			warningText := postFormString(r, "warningText")
+			details := sirius.WarningDetails{
+				Severity:   postFormString(r, "severity"),
+				ReviewDate: postFormDateString(r, "reviewDate"),
+				ExpiryDate: postFormDateString(r, "expiryDate"),
+			}
 
 			var caseIDs = []int{}
 
@@ -85,13 +96,19 @@ func Warning(client WarningClient, tmpl template.Template) Handler {
 				caseIDs = []int{data.Cases[0].ID}
 			}
 
-			err := client.CreateWarning(ctx, personId, warningType, warningText, caseIDs)
+			var err error
+			if fieldErrors := validateWarningDetails(details, now()); len(fieldErrors) > 0 {
+				err = sirius.ValidationError{Field: fieldErrors}
+			} else {
+				err = client.CreateWarning(ctx, personId, warningType, warningText, caseIDs, details)
+			}
 
 			if ve, ok := err.(sirius.ValidationError); ok {
 				w.WriteHeader(http.StatusBadRequest)
 				data.Error = ve
 				data.WarningType = warningType
 				data.WarningText = warningText
+				data.Details = details
 			} else if err != nil {
 				return err
 			} else {
@@ -111,3 +128,22 @@ func Warning(client WarningClient, tmpl template.Template) Handler {
 		return tmpl(w, data)
 	}
 }
+
+// validateWarningDetails checks the optional dates on a warning, which Sirius
+// would otherwise accept in any order
+func validateWarningDetails(details sirius.WarningDetails, today time.Time) sirius.FieldErrors {
+	fieldErrors := sirius.FieldErrors{}
+
+	expiry, err := details.ExpiryDate.Time()
+	hasExpiry := err == nil
+	if hasExpiry && !expiry.After(today) {
+		fieldErrors["expiryDate"] = map[string]string{"reason": "Expiry date must be in the future"}
+	}
+
+	review, err := details.ReviewDate.Time()
+	if err == nil && hasExpiry && !review.Before(expiry) {
+		fieldErrors["reviewDate"] = map[string]string{"reason": "Review date must be before the expiry date"}
+	}
+
+	return fieldErrors
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"github.com/stretchr/testify/assert"
@@ -17,8 +18,8 @@ type mockWarningClient struct {
 	mock.Mock
 }
 
-func (s *mockWarningClient) CreateWarning(ctx sirius.Context, personId int, warningType, warningNote string, caseIDs []int) error {
-	args := s.Called(ctx, personId, warningType, warningNote, caseIDs)
+func (s *mockWarningClient) CreateWarning(ctx sirius.Context, personId int, warningType, warningNote string, caseIDs []int, details sirius.WarningDetails) error {
+	args := s.Called(ctx, personId, warningType, warningNote, caseIDs, details)
 	return args.Error(0)
 }
 
@@ -123,7 +124,7 @@ func TestPostWarningWithOneCase(t *testing.T) {
 		DonorId: 89,
 	}).Return(nil)
 
-	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Recieved", "Some random warning notes", []int{0}).Return(nil)
+	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Recieved", "Some random warning notes", []int{0}, sirius.WarningDetails{}).Return(nil)
 
 	req, _ := http.NewRequest(http.MethodPost, "/?id=89&entity=lpa", strings.NewReader(url.Values{
 		"warningType": {"Complaint Recieved"},
@@ -180,7 +181,7 @@ func TestPostWarningWithMultipleCases(t *testing.T) {
 		DonorId: 89,
 	}).Return(nil)
 
-	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Recieved", "Some random warning notes", []int{1, 2}).Return(nil)
+	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Recieved", "Some random warning notes", []int{1, 2}, sirius.WarningDetails{}).Return(nil)
 
 	req, _ := http.NewRequest(http.MethodPost, "/?id=89&entity=lpa", strings.NewReader(url.Values{
 		"case-id":     {"1", "2"},
@@ -227,7 +228,7 @@ func TestPostWarningWithNoCases(t *testing.T) {
 		DonorId: 89,
 	}).Return(nil)
 
-	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Recieved", "Some random warning notes", []int{}).Return(nil)
+	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Recieved", "Some random warning notes", []int{}, sirius.WarningDetails{}).Return(nil)
 
 	req, _ := http.NewRequest(http.MethodPost, "/?id=89&entity=lpa", strings.NewReader(url.Values{
 		"warningType": {"Complaint Recieved"},
@@ -265,7 +266,7 @@ func TestPostWarningValidationErrors(t *testing.T) {
 		},
 	}
 
-	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Received", "", []int{}).Return(ve)
+	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Received", "", []int{}, sirius.WarningDetails{}).Return(ve)
 
 	template := &mockTemplate{}
 	template.On("Func", mock.Anything, warningData{
@@ -297,6 +298,90 @@ func TestPostWarningValidationErrors(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, result.StatusCode)
 }
 
+func TestPostWarningWithDetails(t *testing.T) {
+	cases := []sirius.Case{}
+	details := sirius.WarningDetails{
+		Severity:   sirius.WarningSeverityHigh,
+		ReviewDate: "2024-06-01",
+		ExpiryDate: "2024-12-01",
+	}
+
+	siriusClient := &mockWarningClient{}
+	siriusClient.On("RefDataByCategory", mock.Anything, sirius.WarningTypeCategory).Return([]sirius.RefDataItem{}, nil)
+	siriusClient.On("CasesByDonor", mock.Anything, 89).Return(cases, nil)
+	siriusClient.On("CreateWarning", mock.Anything, 89, "Safeguarding", "Some notes", []int{}, details).Return(nil)
+
+	template := &mockTemplate{}
+	template.On("Func", mock.Anything, mock.MatchedBy(func(data warningData) bool {
+		return data.Success
+	})).Return(nil)
+
+	req, _ := http.NewRequest(http.MethodPost, "/?id=89&entity=lpa", strings.NewReader(url.Values{
+		"warningType": {"Safeguarding"},
+		"warningText": {"Some notes"},
+		"severity":    {"high"},
+		"reviewDate":  {"2024-06-01"},
+		"expiryDate":  {"2024-12-01"},
+	}.Encode()))
+	req.Header.Add("content-type", formUrlEncoded)
+
+	w := httptest.NewRecorder()
+	err := warningWithNow(siriusClient, template.Func, func() time.Time { return time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC) })(w, req)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	mock.AssertExpectationsForObjects(t, siriusClient, template)
+}
+
+func TestPostWarningWithInvalidDates(t *testing.T) {
+	today := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		reviewDate string
+		expiryDate string
+		expected   sirius.FieldErrors
+	}{
+		"expiry today": {
+			expiryDate: "2024-03-01",
+			expected:   sirius.FieldErrors{"expiryDate": {"reason": "Expiry date must be in the future"}},
+		},
+		"review after expiry": {
+			reviewDate: "2024-07-01",
+			expiryDate: "2024-06-01",
+			expected:   sirius.FieldErrors{"reviewDate": {"reason": "Review date must be before the expiry date"}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			siriusClient := &mockWarningClient{}
+			siriusClient.On("RefDataByCategory", mock.Anything, sirius.WarningTypeCategory).Return([]sirius.RefDataItem{}, nil)
+			siriusClient.On("CasesByDonor", mock.Anything, 89).Return([]sirius.Case{}, nil)
+
+			template := &mockTemplate{}
+			template.On("Func", mock.Anything, mock.MatchedBy(func(data warningData) bool {
+				return assert.Equal(t, tc.expected, data.Error.Field) &&
+					assert.Equal(t, sirius.DateString(tc.expiryDate), data.Details.ExpiryDate)
+			})).Return(nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/?id=89&entity=lpa", strings.NewReader(url.Values{
+				"warningType": {"Safeguarding"},
+				"warningText": {"Some notes"},
+				"reviewDate":  {tc.reviewDate},
+				"expiryDate":  {tc.expiryDate},
+			}.Encode()))
+			req.Header.Add("content-type", formUrlEncoded)
+
+			w := httptest.NewRecorder()
+			err := warningWithNow(siriusClient, template.Func, func() time.Time { return today })(w, req)
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			siriusClient.AssertNotCalled(t, "CreateWarning", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestCreateWarningReturnsError(t *testing.T) {
 	siriusClient := &mockWarningClient{}
 	siriusClient.On("RefDataByCategory", mock.Anything, sirius.WarningTypeCategory).Return(
@@ -312,7 +397,7 @@ func TestCreateWarningReturnsError(t *testing.T) {
 
 	e := errors.New("Some error")
 
-	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Recieved", "Some notes", []int{}).Return(e)
+	siriusClient.On("CreateWarning", mock.Anything, 89, "Complaint Recieved", "Some notes", []int{}, sirius.WarningDetails{}).Return(e)
 
 	req, _ := http.NewRequest(http.MethodPost, "/?id=89&entity=lpa", strings.NewReader(url.Values{
 		"warningType": {"Complaint Recieved"},
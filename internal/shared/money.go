@@ -1,10 +1,151 @@
 package shared
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidMoney = errors.New("invalid amount of money")
+
+// Money is an amount in pence; it is sent to and received from Sirius as a
+// whole number of pence
+type Money int64
+
+// ParseMoney reads an amount entered by a user in pounds, such as "82",
+// "82.5" or "£1,234.50", without going through a float
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "£")
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return 0, ErrInvalidMoney
+	}
+
+	pounds, pence, hasPence := strings.Cut(s, ".")
+
+	if strings.Contains(pounds, ",") {
+		groups := strings.Split(pounds, ",")
+		for i, group := range groups {
+			if (i == 0 && (len(group) < 1 || len(group) > 3)) || (i > 0 && len(group) != 3) {
+				return 0, ErrInvalidMoney
+			}
+		}
+		pounds = strings.Join(groups, "")
+	}
+
+	if pounds == "" {
+		pounds = "0"
+	}
+
+	if !isDigits(pounds) || len(pounds) > 15 {
+		return 0, ErrInvalidMoney
+	}
+
+	if hasPence {
+		if len(pence) < 1 || len(pence) > 2 || !isDigits(pence) {
+			return 0, ErrInvalidMoney
+		}
+		if len(pence) == 1 {
+			pence += "0"
+		}
+	} else {
+		pence = "00"
+	}
+
+	amount, err := strconv.ParseInt(pounds+pence, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidMoney
+	}
+
+	return Money(amount), nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+func (m Money) Pence() int {
+	return int(m)
+}
+
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+
+	return m
+}
+
+// Decimal formats the amount in pounds with two decimal places and no symbol,
+// e.g. "1234.50", as used for form values and the Sirius complaints API
+func (m Money) Decimal() string {
+	sign := ""
+	if m < 0 {
+		sign = "-"
+	}
+
+	abs := m.Abs()
+	return fmt.Sprintf("%s%d.%02d", sign, abs/100, abs%100)
+}
+
+// String formats the amount for display, e.g. "£1,234.50" or "-£20.00"
+func (m Money) String() string {
+	sign := ""
+	if m < 0 {
+		sign = "-"
+	}
+
+	abs := m.Abs()
+	pounds := strconv.FormatInt(int64(abs/100), 10)
+
+	var grouped strings.Builder
+	for i, r := range pounds {
+		if i > 0 && (len(pounds)-i)%3 == 0 {
+			grouped.WriteRune(',')
+		}
+		grouped.WriteRune(r)
+	}
+
+	return fmt.Sprintf("%s£%s.%02d", sign, grouped.String(), abs%100)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(m))
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var pence int64
+	if err := json.Unmarshal(data, &pence); err == nil {
+		*m = Money(pence)
+		return nil
+	}
+
+	var f float64
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+
+	*m = Money(math.Round(f))
+	return nil
+}
 
 func FormatMonetaryValue(amount int) string {
-	float := float64(amount)
-	return FormatMonetaryFloat(float)
+	return Money(amount).Decimal()
 }
 
 func FormatMonetaryFloat(amount float64) string {
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -19,3 +20,89 @@ func TestFormatMonetaryFloat(t *testing.T) {
 	val := FormatMonetaryFloat(float64(8200))
 	assert.Equal(t, expected, val)
 }
+
+func TestParseMoney(t *testing.T) {
+	tests := map[string]Money{
+		"82":           8200,
+		"82.00":        8200,
+		"82.5":         8250,
+		"19.99":        1999,
+		"0.29":         29,
+		".45":          45,
+		"£1,234.50":    123450,
+		" £ 1234.50 ":  123450,
+		"1,000,000":    100000000,
+		"999,999.99":   99999999,
+		"0":            0,
+		"000012.30":    1230,
+		"123456789012": 12345678901200,
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			val, err := ParseMoney(input)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, val)
+		})
+	}
+}
+
+func TestParseMoneyInvalid(t *testing.T) {
+	for _, input := range []string{"", "£", "abc", "41.555", "41.", "-5.00", "1,23.45", "1234,567", ",123", "12.3.4", "1e3", "12.a", "£12 50", "1234567890123456"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseMoney(input)
+			assert.Equal(t, ErrInvalidMoney, err)
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := map[Money]string{
+		0:         "£0.00",
+		5:         "£0.05",
+		8200:      "£82.00",
+		123450:    "£1,234.50",
+		100000000: "£1,000,000.00",
+		-2050:     "-£20.50",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, input.String())
+	}
+}
+
+func TestMoneyDecimal(t *testing.T) {
+	assert.Equal(t, "0.00", Money(0).Decimal())
+	assert.Equal(t, "1234.50", Money(123450).Decimal())
+	assert.Equal(t, "-0.05", Money(-5).Decimal())
+}
+
+func TestMoneyPenceAndAbs(t *testing.T) {
+	assert.Equal(t, 1999, Money(1999).Pence())
+	assert.Equal(t, Money(1999), Money(-1999).Abs())
+	assert.Equal(t, Money(1999), Money(1999).Abs())
+}
+
+func TestMoneyJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Amount Money `json:"amount"`
+	}{Amount: 1999})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"amount":1999}`, string(data))
+
+	var v struct {
+		Amount Money `json:"amount"`
+	}
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"amount":-4100}`), &v))
+	assert.Equal(t, Money(-4100), v.Amount)
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"amount":4100.0}`), &v))
+	assert.Equal(t, Money(4100), v.Amount)
+
+	v.Amount = 0
+	assert.Nil(t, json.Unmarshal([]byte(`{"amount":null}`), &v))
+	assert.Equal(t, Money(0), v.Amount)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"amount":"41.00"}`), &v))
+}
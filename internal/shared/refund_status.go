@@ -0,0 +1,41 @@
+package shared
+
+// RefundStatus is where a refund is in its lifecycle. Refunds recorded before
+// statuses were introduced have no status and are treated as issued.
+type RefundStatus string
+
+const (
+	RefundStatusRequested RefundStatus = "REQUESTED"
+	RefundStatusApproved  RefundStatus = "APPROVED"
+	RefundStatusIssued    RefundStatus = "ISSUED"
+)
+
+func (s RefundStatus) Translation() string {
+	switch s {
+	case RefundStatusRequested:
+		return "Requested"
+	case RefundStatusApproved:
+		return "Approved"
+	case RefundStatusIssued, "":
+		return "Issued"
+	default:
+		return "refund status NOT RECOGNISED"
+	}
+}
+
+// Next gives the status a refund can move to from s, or false if the refund
+// has been issued
+func (s RefundStatus) Next() (RefundStatus, bool) {
+	switch s {
+	case RefundStatusRequested:
+		return RefundStatusApproved, true
+	case RefundStatusApproved:
+		return RefundStatusIssued, true
+	default:
+		return "", false
+	}
+}
+
+func (s RefundStatus) IsIssued() bool {
+	return s == RefundStatusIssued || s == ""
+}
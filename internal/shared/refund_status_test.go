@@ -0,0 +1,46 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefundStatusTranslation(t *testing.T) {
+	tests := map[RefundStatus]string{
+		RefundStatusRequested: "Requested",
+		RefundStatusApproved:  "Approved",
+		RefundStatusIssued:    "Issued",
+		"":                    "Issued",
+		"UNKNOWN":             "refund status NOT RECOGNISED",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, input.Translation(), input)
+	}
+}
+
+func TestRefundStatusNext(t *testing.T) {
+	tests := map[RefundStatus]struct {
+		next RefundStatus
+		ok   bool
+	}{
+		RefundStatusRequested: {RefundStatusApproved, true},
+		RefundStatusApproved:  {RefundStatusIssued, true},
+		RefundStatusIssued:    {"", false},
+		"":                    {"", false},
+	}
+
+	for input, expected := range tests {
+		next, ok := input.Next()
+		assert.Equal(t, expected.next, next, input)
+		assert.Equal(t, expected.ok, ok, input)
+	}
+}
+
+func TestRefundStatusIsIssued(t *testing.T) {
+	assert.False(t, RefundStatusRequested.IsIssued())
+	assert.False(t, RefundStatusApproved.IsIssued())
+	assert.True(t, RefundStatusIssued.IsIssued())
+	assert.True(t, RefundStatus("").IsIssued())
+}
@@ -0,0 +1,127 @@
+package shared
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	richTextTag  = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9]*)([^<>]*)>`)
+	richTextHref = regexp.MustCompile(`(?i)\bhref\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+
+	// richTextElements are the elements a note may contain, and whether they
+	// need closing
+	richTextElements = map[string]bool{
+		"p":      true,
+		"br":     false,
+		"strong": true,
+		"b":      true,
+		"em":     true,
+		"i":      true,
+		"ul":     true,
+		"ol":     true,
+		"li":     true,
+		"a":      true,
+	}
+)
+
+// SanitiseRichText makes note text safe to show as HTML. Only simple
+// formatting (bold, italics, lists, paragraphs and line breaks) and links to
+// web or email addresses are kept, without any other attributes. Other tags
+// are removed but their text is kept, and the result is always well-formed so
+// it cannot affect the page around it.
+func SanitiseRichText(s string) string {
+	var (
+		sb   strings.Builder
+		open []string
+	)
+
+	writeText := func(text string) {
+		sb.WriteString(html.EscapeString(html.UnescapeString(text)))
+	}
+
+	last := 0
+	for _, m := range richTextTag.FindAllStringSubmatchIndex(s, -1) {
+		writeText(s[last:m[0]])
+		last = m[1]
+
+		closing := m[3] > m[2]
+		name := strings.ToLower(s[m[4]:m[5]])
+		attrs := s[m[6]:m[7]]
+
+		needsClosing, ok := richTextElements[name]
+		if !ok {
+			continue
+		}
+
+		if !needsClosing {
+			if !closing {
+				sb.WriteString("<" + name + ">")
+			}
+			continue
+		}
+
+		if closing {
+			// close anything left open inside the element, and ignore closing
+			// tags that were never opened
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == name {
+					for j := len(open) - 1; j >= i; j-- {
+						sb.WriteString("</" + open[j] + ">")
+					}
+					open = open[:i]
+					break
+				}
+			}
+			continue
+		}
+
+		if name == "a" {
+			href, ok := richTextLink(attrs)
+			if !ok {
+				continue
+			}
+
+			sb.WriteString(`<a class="govuk-link" href="` + html.EscapeString(href) + `" rel="noreferrer noopener" target="_blank">`)
+		} else {
+			sb.WriteString("<" + name + ">")
+		}
+
+		open = append(open, name)
+	}
+
+	writeText(s[last:])
+
+	for i := len(open) - 1; i >= 0; i-- {
+		sb.WriteString("</" + open[i] + ">")
+	}
+
+	return sb.String()
+}
+
+// richTextLink finds the address of a link, if it is to a web page or an email
+// address
+func richTextLink(attrs string) (string, bool) {
+	m := richTextHref.FindStringSubmatch(attrs)
+	if m == nil {
+		return "", false
+	}
+
+	href := strings.TrimSpace(html.UnescapeString(m[1] + m[2]))
+
+	u, err := url.Parse(href)
+	if err != nil {
+		return "", false
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return href, u.Host != ""
+	case "mailto":
+		return href, u.Opaque != ""
+	default:
+		return "", false
+	}
+}
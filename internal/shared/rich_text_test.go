@@ -0,0 +1,89 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitiseRichText(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected string
+	}{
+		"plain text": {
+			in:       "Called donor & confirmed address",
+			expected: "Called donor &amp; confirmed address",
+		},
+		"existing entities": {
+			in:       "Fish &amp; chips&nbsp;",
+			expected: "Fish &amp; chips\u00a0",
+		},
+		"formatting": {
+			in:       "<p><strong>Caller:</strong> Jo<br/>and <EM>Sam</EM></p>",
+			expected: "<p><strong>Caller:</strong> Jo<br>and <em>Sam</em></p>",
+		},
+		"lists": {
+			in:       "<ul><li>One</li><li>Two</li></ul><ol><li>Three</li></ol>",
+			expected: "<ul><li>One</li><li>Two</li></ul><ol><li>Three</li></ol>",
+		},
+		"attributes removed": {
+			in:       `<p class="x" onclick="alert(1)">Hi</p>`,
+			expected: "<p>Hi</p>",
+		},
+		"script removed": {
+			in:       "<script>alert('x')</script>done",
+			expected: "alert(&#39;x&#39;)done",
+		},
+		"other tags removed": {
+			in:       `<div><img src="x" onerror="alert(1)">Text</div>`,
+			expected: "Text",
+		},
+		"web link": {
+			in:       `See <a href="https://www.gov.uk/?a=1&amp;b=2" onclick="x()">guidance</a>`,
+			expected: `See <a class="govuk-link" href="https://www.gov.uk/?a=1&amp;b=2" rel="noreferrer noopener" target="_blank">guidance</a>`,
+		},
+		"email link": {
+			in:       `<a href='mailto:someone@example.com'>Email</a>`,
+			expected: `<a class="govuk-link" href="mailto:someone@example.com" rel="noreferrer noopener" target="_blank">Email</a>`,
+		},
+		"javascript link": {
+			in:       `<a href="javascript:alert(1)">Click</a>`,
+			expected: "Click",
+		},
+		"encoded javascript link": {
+			in:       `<a href="&#106;avascript:alert(1)">Click</a>`,
+			expected: "Click",
+		},
+		"relative link": {
+			in:       `<a href="/lpa/M-1">Case</a>`,
+			expected: "Case",
+		},
+		"unclosed tags": {
+			in:       "<ul><li><strong>One",
+			expected: "<ul><li><strong>One</strong></li></ul>",
+		},
+		"stray closing tags": {
+			in:       "</p>One</ul>",
+			expected: "One",
+		},
+		"overlapping tags": {
+			in:       "<strong><em>One</strong> two</em>",
+			expected: "<strong><em>One</em></strong> two",
+		},
+		"angle brackets in text": {
+			in:       "3 < 5 and 6 > 4",
+			expected: "3 &lt; 5 and 6 &gt; 4",
+		},
+		"broken tag": {
+			in:       `<a href="https://x.com" <script>`,
+			expected: `&lt;a href=&#34;https://x.com&#34; `,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SanitiseRichText(tc.in))
+		})
+	}
+}
@@ -168,6 +168,9 @@ func (afo *AnomaliesForObject) GetHintTextForAnomalyField(anomalies []Anomaly, w
 		case NoCountry:
 			return "Review address as there is no country"
 		case InvalidAddress:
+			if whoHasTheAnomaly == "" {
+				return "Review address"
+			}
 			return "Review " + whoHasTheAnomaly + " address"
 		case DonorIdAndSignedDateFarApart:
 			return "Review signature date - check this is within 6 months either side of the donor’s ID check"
@@ -364,6 +364,13 @@ func TestGetHintTextForAnomalyField(t *testing.T) {
 			whoHasTheAnomaly: "certificate provider's",
 			want:             "Review certificate provider's address",
 		},
+		{
+			name: "Bad address for donor",
+			anomalies: []Anomaly{
+				{RuleType: InvalidAddress},
+			},
+			want: "Review address",
+		},
 		{
 			name: "Donor SignedAt and IdCheck dates too dates too far apart",
 			anomalies: []Anomaly{
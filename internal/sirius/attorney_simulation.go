@@ -0,0 +1,150 @@
+package sirius
+
+import (
+	"fmt"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+)
+
+// SimulatedAttorney is an attorney or trust corporation as it would be after a
+// set of proposed status changes
+type SimulatedAttorney struct {
+	Uid             string
+	Name            string
+	AppointmentType string
+	PreviousStatus  string
+	Status          string
+}
+
+func (a SimulatedAttorney) Changed() bool {
+	return a.PreviousStatus != a.Status
+}
+
+// AttorneySimulation is the appointment state of an LPA after a set of
+// proposed status changes, with any warnings about whether it can still be
+// used
+type AttorneySimulation struct {
+	Attorneys        []SimulatedAttorney
+	ActiveCount      int
+	ReplacementCount int
+	Decisions        shared.HowAttorneysMakeDecisions
+	Operable         bool
+	Warnings         []string
+}
+
+// SimulateAttorneyChanges applies changes to the attorneys and trust
+// corporations on lpa without saving them, so the effect can be checked before
+// a removal is confirmed
+func SimulateAttorneyChanges(lpa LpaStoreData, changes []AttorneyUpdatedStatus) AttorneySimulation {
+	proposed := map[string]string{}
+	for _, change := range changes {
+		proposed[change.UID] = change.Status
+	}
+
+	s := AttorneySimulation{
+		Decisions: lpa.HowAttorneysMakeDecisions,
+	}
+
+	removedCount, enabledCount, activeOriginalCount := 0, 0, 0
+
+	add := func(attorney LpaStoreAttorney, name string) {
+		simulated := SimulatedAttorney{
+			Uid:             attorney.Uid,
+			Name:            name,
+			AppointmentType: attorney.AppointmentType,
+			PreviousStatus:  attorney.Status,
+			Status:          attorney.Status,
+		}
+
+		if status, ok := proposed[attorney.Uid]; ok {
+			simulated.Status = status
+		}
+
+		switch simulated.Status {
+		case shared.ActiveAttorneyStatus.String():
+			s.ActiveCount++
+			if simulated.AppointmentType != shared.ReplacementAppointmentType.String() {
+				activeOriginalCount++
+			}
+		case shared.InactiveAttorneyStatus.String():
+			if simulated.AppointmentType == shared.ReplacementAppointmentType.String() {
+				s.ReplacementCount++
+			}
+		}
+
+		if simulated.Changed() {
+			switch simulated.Status {
+			case shared.RemovedAttorneyStatus.String():
+				removedCount++
+			case shared.ActiveAttorneyStatus.String():
+				enabledCount++
+			}
+		}
+
+		s.Attorneys = append(s.Attorneys, simulated)
+	}
+
+	for _, attorney := range lpa.Attorneys {
+		add(attorney, attorney.FirstNames+" "+attorney.LastName)
+	}
+
+	for _, trustCorporation := range lpa.TrustCorporations {
+		add(trustCorporation.LpaStoreAttorney, trustCorporation.Name)
+	}
+
+	s.Operable = s.ActiveCount > 0
+
+	if s.ActiveCount == 0 {
+		s.warn("There would be no active attorneys, so the LPA could not be used")
+	} else if s.ActiveCount > 1 {
+		switch s.Decisions {
+		case shared.HowAttorneysMakeDecisionsEmpty, shared.HowAttorneysMakeDecisionsNotRecognised:
+			s.warn("How the %d remaining attorneys make decisions is not known", s.ActiveCount)
+		}
+	}
+
+	if s.ActiveCount > 0 {
+		switch s.Decisions {
+		case shared.HowAttorneysMakeDecisionsJointly:
+			if s.ActiveCount < 2 {
+				s.Operable = false
+				s.warn("Attorneys must act jointly but only 1 attorney would remain, so the LPA could not be used")
+			}
+		case shared.HowAttorneysMakeDecisionsJointlyForSomeSeverallyForOthers:
+			if s.ActiveCount < 2 {
+				s.Operable = false
+				s.warn("Only 1 attorney would remain, so decisions that must be made jointly could not be made")
+			}
+		case shared.HowAttorneysMakeDecisionsJointlyAndSeverally:
+			if s.ActiveCount == 1 {
+				s.warn("Only 1 attorney would remain, who could continue to act alone")
+			}
+		}
+	}
+
+	if removedCount > 0 && enabledCount == 0 && s.ReplacementCount > 0 {
+		switch lpa.HowReplacementAttorneysStepIn {
+		case shared.HowReplacementAttorneysStepInOneCanNoLongerAct:
+			s.warn("Replacement attorneys step in when one attorney can no longer act, but none would be made active")
+		case shared.HowReplacementAttorneysStepInAllCanNoLongerAct:
+			if activeOriginalCount == 0 {
+				s.warn("None of the original attorneys would remain, but no replacement attorneys would be made active")
+			}
+		}
+	}
+
+	if enabledCount > 0 && activeOriginalCount > 0 &&
+		lpa.HowReplacementAttorneysStepIn == shared.HowReplacementAttorneysStepInAllCanNoLongerAct {
+		s.warn("Replacement attorneys only step in when all attorneys can no longer act, but %d of the original attorneys would remain active", activeOriginalCount)
+	}
+
+	if enabledCount > 0 && lpa.HowReplacementAttorneysStepIn == shared.HowReplacementAttorneysStepInAnotherWay {
+		s.warn("Check the donor's instructions for when replacement attorneys step in: %s", lpa.HowReplacementAttorneysStepInDetails)
+	}
+
+	return s
+}
+
+func (s *AttorneySimulation) warn(format string, a ...any) {
+	s.Warnings = append(s.Warnings, fmt.Sprintf(format, a...))
+}
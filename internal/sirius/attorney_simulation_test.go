@@ -0,0 +1,215 @@
+package sirius
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulateAttorneyChanges(t *testing.T) {
+	attorney := func(uid, appointmentType, status string) LpaStoreAttorney {
+		return LpaStoreAttorney{
+			LpaStorePerson:  LpaStorePerson{Uid: uid, FirstNames: "Attorney", LastName: uid},
+			AppointmentType: appointmentType,
+			Status:          status,
+		}
+	}
+
+	original := shared.OriginalAppointmentType.String()
+	replacement := shared.ReplacementAppointmentType.String()
+	active := shared.ActiveAttorneyStatus.String()
+	inactive := shared.InactiveAttorneyStatus.String()
+	removed := shared.RemovedAttorneyStatus.String()
+
+	twoAndReplacement := []LpaStoreAttorney{
+		attorney("a", original, active),
+		attorney("b", original, active),
+		attorney("r", replacement, inactive),
+	}
+
+	threeOriginals := []LpaStoreAttorney{
+		attorney("a", original, active),
+		attorney("b", original, active),
+		attorney("c", original, active),
+	}
+
+	removeA := AttorneyUpdatedStatus{UID: "a", Status: removed}
+	removeB := AttorneyUpdatedStatus{UID: "b", Status: removed}
+	enableR := AttorneyUpdatedStatus{UID: "r", Status: active}
+
+	testCases := map[string]struct {
+		attorneys        []LpaStoreAttorney
+		decisions        shared.HowAttorneysMakeDecisions
+		stepIn           shared.HowReplacementAttorneysStepIn
+		changes          []AttorneyUpdatedStatus
+		activeCount      int
+		replacementCount int
+		operable         bool
+		warnings         []string
+	}{
+		"jointly below two": {
+			attorneys:        twoAndReplacement,
+			decisions:        shared.HowAttorneysMakeDecisionsJointly,
+			changes:          []AttorneyUpdatedStatus{removeA},
+			activeCount:      1,
+			replacementCount: 1,
+			operable:         false,
+			warnings:         []string{"Attorneys must act jointly but only 1 attorney would remain, so the LPA could not be used"},
+		},
+		"jointly with replacement stepping in": {
+			attorneys:   twoAndReplacement,
+			decisions:   shared.HowAttorneysMakeDecisionsJointly,
+			changes:     []AttorneyUpdatedStatus{removeA, enableR},
+			activeCount: 2,
+			operable:    true,
+		},
+		"jointly and severally with one remaining": {
+			attorneys:        twoAndReplacement,
+			decisions:        shared.HowAttorneysMakeDecisionsJointlyAndSeverally,
+			changes:          []AttorneyUpdatedStatus{removeA},
+			activeCount:      1,
+			replacementCount: 1,
+			operable:         true,
+			warnings:         []string{"Only 1 attorney would remain, who could continue to act alone"},
+		},
+		"jointly and severally with replacement stepping in": {
+			attorneys:   twoAndReplacement,
+			decisions:   shared.HowAttorneysMakeDecisionsJointlyAndSeverally,
+			changes:     []AttorneyUpdatedStatus{removeA, enableR},
+			activeCount: 2,
+			operable:    true,
+		},
+		"jointly for some severally for others below two": {
+			attorneys:        twoAndReplacement,
+			decisions:        shared.HowAttorneysMakeDecisionsJointlyForSomeSeverallyForOthers,
+			changes:          []AttorneyUpdatedStatus{removeA},
+			activeCount:      1,
+			replacementCount: 1,
+			operable:         false,
+			warnings:         []string{"Only 1 attorney would remain, so decisions that must be made jointly could not be made"},
+		},
+		"jointly for some severally for others with two remaining": {
+			attorneys:   threeOriginals,
+			decisions:   shared.HowAttorneysMakeDecisionsJointlyForSomeSeverallyForOthers,
+			changes:     []AttorneyUpdatedStatus{removeA},
+			activeCount: 2,
+			operable:    true,
+		},
+		"decisions not specified": {
+			attorneys:   threeOriginals,
+			decisions:   shared.HowAttorneysMakeDecisionsEmpty,
+			changes:     []AttorneyUpdatedStatus{removeA},
+			activeCount: 2,
+			operable:    true,
+			warnings:    []string{"How the 2 remaining attorneys make decisions is not known"},
+		},
+		"decisions not recognised": {
+			attorneys:   threeOriginals,
+			decisions:   shared.HowAttorneysMakeDecisionsNotRecognised,
+			changes:     []AttorneyUpdatedStatus{removeA},
+			activeCount: 2,
+			operable:    true,
+			warnings:    []string{"How the 2 remaining attorneys make decisions is not known"},
+		},
+		"no attorneys remaining": {
+			attorneys:        twoAndReplacement,
+			decisions:        shared.HowAttorneysMakeDecisionsJointlyAndSeverally,
+			stepIn:           shared.HowReplacementAttorneysStepInAllCanNoLongerAct,
+			changes:          []AttorneyUpdatedStatus{removeA, removeB},
+			activeCount:      0,
+			replacementCount: 1,
+			operable:         false,
+			warnings: []string{
+				"There would be no active attorneys, so the LPA could not be used",
+				"None of the original attorneys would remain, but no replacement attorneys would be made active",
+			},
+		},
+		"replacement expected when one can no longer act": {
+			attorneys:        twoAndReplacement,
+			decisions:        shared.HowAttorneysMakeDecisionsJointlyAndSeverally,
+			stepIn:           shared.HowReplacementAttorneysStepInOneCanNoLongerAct,
+			changes:          []AttorneyUpdatedStatus{removeA},
+			activeCount:      1,
+			replacementCount: 1,
+			operable:         true,
+			warnings: []string{
+				"Only 1 attorney would remain, who could continue to act alone",
+				"Replacement attorneys step in when one attorney can no longer act, but none would be made active",
+			},
+		},
+		"replacement enabled before all can no longer act": {
+			attorneys:   twoAndReplacement,
+			decisions:   shared.HowAttorneysMakeDecisionsJointlyAndSeverally,
+			stepIn:      shared.HowReplacementAttorneysStepInAllCanNoLongerAct,
+			changes:     []AttorneyUpdatedStatus{removeA, enableR},
+			activeCount: 2,
+			operable:    true,
+			warnings:    []string{"Replacement attorneys only step in when all attorneys can no longer act, but 1 of the original attorneys would remain active"},
+		},
+		"replacement enabled another way": {
+			attorneys:   twoAndReplacement,
+			decisions:   shared.HowAttorneysMakeDecisionsJointlyAndSeverally,
+			stepIn:      shared.HowReplacementAttorneysStepInAnotherWay,
+			changes:     []AttorneyUpdatedStatus{removeA, enableR},
+			activeCount: 2,
+			operable:    true,
+			warnings:    []string{"Check the donor's instructions for when replacement attorneys step in: if one is unwell"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			lpa := LpaStoreData{
+				Attorneys:                            tc.attorneys,
+				HowAttorneysMakeDecisions:            tc.decisions,
+				HowReplacementAttorneysStepIn:        tc.stepIn,
+				HowReplacementAttorneysStepInDetails: "if one is unwell",
+			}
+
+			s := SimulateAttorneyChanges(lpa, tc.changes)
+
+			assert.Equal(t, tc.activeCount, s.ActiveCount)
+			assert.Equal(t, tc.replacementCount, s.ReplacementCount)
+			assert.Equal(t, tc.operable, s.Operable)
+			assert.Equal(t, tc.warnings, s.Warnings)
+			assert.Equal(t, tc.decisions, s.Decisions)
+		})
+	}
+}
+
+func TestSimulateAttorneyChangesStatuses(t *testing.T) {
+	lpa := LpaStoreData{
+		Attorneys: []LpaStoreAttorney{
+			{
+				LpaStorePerson:  LpaStorePerson{Uid: "a", FirstNames: "Amy", LastName: "Attorney"},
+				AppointmentType: shared.OriginalAppointmentType.String(),
+				Status:          shared.ActiveAttorneyStatus.String(),
+			},
+		},
+		TrustCorporations: []LpaStoreTrustCorporation{
+			{
+				LpaStoreAttorney: LpaStoreAttorney{
+					LpaStorePerson:  LpaStorePerson{Uid: "t"},
+					AppointmentType: shared.ReplacementAppointmentType.String(),
+					Status:          shared.InactiveAttorneyStatus.String(),
+				},
+				Name: "Trusty Ltd",
+			},
+		},
+		HowAttorneysMakeDecisions: shared.HowAttorneysMakeDecisionsJointly,
+	}
+
+	s := SimulateAttorneyChanges(lpa, []AttorneyUpdatedStatus{
+		{UID: "a", Status: shared.RemovedAttorneyStatus.String()},
+		{UID: "t", Status: shared.ActiveAttorneyStatus.String()},
+	})
+
+	assert.Equal(t, []SimulatedAttorney{
+		{Uid: "a", Name: "Amy Attorney", AppointmentType: "original", PreviousStatus: "active", Status: "removed"},
+		{Uid: "t", Name: "Trusty Ltd", AppointmentType: "replacement", PreviousStatus: "inactive", Status: "active"},
+	}, s.Attorneys)
+	assert.True(t, s.Attorneys[0].Changed())
+	assert.Equal(t, 1, s.ActiveCount)
+	assert.False(t, s.Operable)
+}
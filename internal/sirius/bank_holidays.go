@@ -1,5 +1,9 @@
 package sirius
 
+import (
+	"time"
+)
+
 type BankHolidays map[string]map[string]string
 
 func (c *Client) BankHolidays(ctx Context) (BankHolidays, error) {
@@ -9,9 +13,63 @@ func (c *Client) BankHolidays(ctx Context) (BankHolidays, error) {
 		return cached.(BankHolidays), nil
 	}
 
-	err := c.get(ctx, "/lpa-api/v1/dates/bank-holidays", &b)
+	if err := c.get(ctx, "/lpa-api/v1/dates/bank-holidays", &b); err != nil {
+		return nil, err
+	}
 
 	setCached("bank-holidays", b)
 
-	return b, err
+	return b, nil
+}
+
+func (b BankHolidays) isBankHoliday(day time.Time) bool {
+	for _, dates := range b {
+		for _, date := range dates {
+			if parsed, err := time.Parse(time.RFC3339, date); err == nil && parsed.Format(time.DateOnly) == day.Format(time.DateOnly) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsWorkingDay reports whether day is a weekday that is not a bank holiday
+func (b BankHolidays) IsWorkingDay(day time.Time) bool {
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return false
+	}
+
+	return !b.isBankHoliday(day)
+}
+
+// WorkingDaysBetween counts the working days after start, up to and including
+// end. It is zero when end is not after start.
+func (b BankHolidays) WorkingDaysBetween(start, end time.Time) int {
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+
+	count := 0
+	for d := start.AddDate(0, 0, 1); !d.After(end); d = d.AddDate(0, 0, 1) {
+		if b.IsWorkingDay(d) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// AddWorkingDays finds the date that is the given number of working days after
+// start
+func (b BankHolidays) AddWorkingDays(start time.Time, days int) time.Time {
+	d := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	for days > 0 {
+		d = d.AddDate(0, 0, 1)
+		if b.IsWorkingDay(d) {
+			days--
+		}
+	}
+
+	return d
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/pact-foundation/pact-go/v2/consumer"
 	"github.com/pact-foundation/pact-go/v2/matchers"
@@ -56,3 +57,49 @@ func TestBankHolidays(t *testing.T) {
 	})
 
 }
+
+func TestBankHolidaysWorkingDays(t *testing.T) {
+	bankHolidays := BankHolidays{
+		"england-and-wales": {
+			"Christmas Day": "2024-12-25T00:00:00+00:00",
+			"Boxing Day":    "2024-12-26T00:00:00+00:00",
+		},
+	}
+
+	testCases := map[string]struct {
+		start    string
+		end      string
+		expected int
+	}{
+		"same day":              {start: "2024-12-02", end: "2024-12-02", expected: 0},
+		"end before start":      {start: "2024-12-03", end: "2024-12-02", expected: 0},
+		"next day":              {start: "2024-12-02", end: "2024-12-03", expected: 1},
+		"over a weekend":        {start: "2024-12-06", end: "2024-12-09", expected: 1},
+		"over bank holidays":    {start: "2024-12-24", end: "2024-12-27", expected: 1},
+		"two weeks":             {start: "2024-12-02", end: "2024-12-16", expected: 10},
+		"ignores time of day":   {start: "2024-12-02T17:00:00Z", end: "2024-12-03T09:00:00Z", expected: 1},
+		"starting on a weekend": {start: "2024-12-07", end: "2024-12-09", expected: 1},
+	}
+
+	parse := func(s string) time.Time {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t
+		}
+		t, _ := time.Parse(time.DateOnly, s)
+		return t
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, bankHolidays.WorkingDaysBetween(parse(tc.start), parse(tc.end)))
+		})
+	}
+
+	assert.False(t, bankHolidays.IsWorkingDay(parse("2024-12-25")))
+	assert.False(t, bankHolidays.IsWorkingDay(parse("2024-12-28")))
+	assert.True(t, bankHolidays.IsWorkingDay(parse("2024-12-27")))
+
+	assert.Equal(t, parse("2024-12-02"), bankHolidays.AddWorkingDays(parse("2024-12-02"), 0))
+	assert.Equal(t, parse("2024-12-09"), bankHolidays.AddWorkingDays(parse("2024-12-06"), 1))
+	assert.Equal(t, parse("2024-12-30"), bankHolidays.AddWorkingDays(parse("2024-12-23"), 3))
+}
@@ -28,7 +28,7 @@ type Case struct {
 	Documents                                 []interface{}      `json:"documents,omitempty"`
 	Donor                                     *Person            `json:"donor,omitempty"`
 	DueDate                                   DateString         `json:"dueDate,omitempty"`
-	ExpectedPaymentTotal                      int                `json:"expectedPaymentTotal"`
+	ExpectedPaymentTotal                      shared.Money       `json:"expectedPaymentTotal"`
 	FilingDate                                DateString         `json:"filingDate,omitempty"`
 	ID                                        int                `json:"id,omitempty"`
 	InvalidDate                               DateString         `json:"invalidDate,omitempty"`
@@ -0,0 +1,13 @@
+package sirius
+
+import (
+	"fmt"
+	"net/url"
+)
+
+func (c *Client) CaseByUID(ctx Context, uid string) (Case, error) {
+	var v Case
+	err := c.get(ctx, fmt.Sprintf("/lpa-api/v1/cases/by-uid/%s", url.PathEscape(uid)), &v)
+
+	return v, err
+}
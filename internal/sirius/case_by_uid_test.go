@@ -0,0 +1,101 @@
+package sirius
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pact-foundation/pact-go/v2/consumer"
+	"github.com/pact-foundation/pact-go/v2/matchers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaseByUID(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		setup            func()
+		expectedResponse Case
+		expectedError    func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("I have a pending case assigned").
+					UponReceiving("A request for the case by UID").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodGet,
+						Path:   matchers.String("/lpa-api/v1/cases/by-uid/7000-0000-0000"),
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusOK,
+						Body: matchers.Like(map[string]interface{}{
+							"id":                   matchers.Like(800),
+							"uId":                  matchers.Term("7000-0000-0000", `\d{4}-\d{4}-\d{4}`),
+							"caseType":             matchers.Like("LPA"),
+							"caseSubtype":          matchers.Like("hw"),
+							"expectedPaymentTotal": matchers.Like(8200),
+						}),
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
+					})
+			},
+			expectedResponse: Case{
+				ID:                   800,
+				UID:                  "7000-0000-0000",
+				CaseType:             "LPA",
+				SubType:              "hw",
+				ExpectedPaymentTotal: 8200,
+			},
+		},
+		{
+			name: "404",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("There is no case with the UID").
+					UponReceiving("A request for an unknown case by UID").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodGet,
+						Path:   matchers.String("/lpa-api/v1/cases/by-uid/7000-0000-0000"),
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusNotFound,
+					})
+			},
+			expectedError: func(port int) error {
+				return StatusError{
+					Code:   http.StatusNotFound,
+					URL:    fmt.Sprintf("http://127.0.0.1:%d/lpa-api/v1/cases/by-uid/7000-0000-0000", port),
+					Method: http.MethodGet,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				caseitem, err := client.CaseByUID(Context{Context: context.Background()}, "7000-0000-0000")
+
+				assert.Equal(t, tc.expectedResponse, caseitem)
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
@@ -12,6 +12,19 @@ type CaseSummary struct {
 	Resolution  ObjectionResolution
 }
 
+// WarningsToAcknowledge lists the high severity warnings that must be read
+// before the case can be changed
+func (cs CaseSummary) WarningsToAcknowledge() []Warning {
+	var warnings []Warning
+	for _, warning := range cs.WarningList {
+		if warning.NeedsAcknowledgement() {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return warnings
+}
+
 /**
  * Get data for the case summary area (digital LPA record, tasks, and warnings for that LPA)
  */
@@ -65,5 +78,17 @@ func (c *Client) getCaseSummary(ctx Context, uid string, presignImages bool) (Ca
 		return cs, err
 	}
 
+	if len(cs.Objections) > 0 {
+		// deadlines are still counted if bank holidays can't be fetched, but
+		// are marked so that they are not relied on
+		bankHolidays, err := c.BankHolidays(ctx)
+
+		today := c.now()
+		for i, objection := range cs.Objections {
+			cs.Objections[i].Deadline = NewObjectionDeadline(objection, cs.DigitalLpa.SiriusData.NoticeGivenDate, today, bankHolidays)
+			cs.Objections[i].Deadline.WithoutBankHolidays = err != nil
+		}
+	}
+
 	return cs, nil
 }
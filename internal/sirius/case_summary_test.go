@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -194,3 +195,85 @@ func TestCaseSummaryWithImages(t *testing.T) {
 		assert.Equal(t, testCase.ExpectedError, err)
 	}
 }
+
+func TestCaseSummaryObjectionDeadlines(t *testing.T) {
+	testCases := map[string]struct {
+		bankHolidaysResponse *http.Response
+		bankHolidaysError    error
+		workingDaysOpen      int
+		withoutBankHolidays  bool
+	}{
+		"with bank holidays": {
+			bankHolidaysResponse: &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"england-and-wales":{"0":"2025-03-14T00:00:00+00:00"}}`)),
+			},
+			workingDaysOpen: 4,
+		},
+		"when bank holidays fail": {
+			bankHolidaysResponse: &http.Response{},
+			bankHolidaysError:    errors.New("Unable to fetch bank holidays"),
+			workingDaysOpen:      5,
+			withoutBankHolidays:  true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cache = nil
+
+			mockHttpClient := mockCaseSummaryHttpClient{}
+			client := NewClient(&mockHttpClient, "http://localhost:8888")
+			client.now = func() time.Time { return time.Date(2025, time.March, 19, 0, 0, 0, 0, time.UTC) }
+
+			mockHttpClient.
+				On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.URL.Path == "/lpa-api/v1/digital-lpas/M-QWER-TY34-3434" })).
+				Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(`{"opg.poas.sirius":{"id":1}}`))}, nil)
+			mockHttpClient.
+				On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.URL.Path == "/lpa-api/v1/cases/1/tasks" })).
+				Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(`{"tasks":[]}`))}, nil)
+			mockHttpClient.
+				On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.URL.Path == "/lpa-api/v1/cases/1/warnings" })).
+				Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(`[]`))}, nil)
+			mockHttpClient.
+				On("Do", mock.MatchedBy(func(r *http.Request) bool {
+					return r.URL.Path == "/lpa-api/v1/digital-lpas/M-QWER-TY34-3434/objections"
+				})).
+				Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(`[{"id":1,"receivedDate":"2025-03-12"}]`))}, nil)
+			mockHttpClient.
+				On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.URL.Path == "/lpa-api/v1/dates/bank-holidays" })).
+				Return(tc.bankHolidaysResponse, tc.bankHolidaysError)
+
+			cs, err := client.CaseSummary(Context{Context: context.Background()}, "M-QWER-TY34-3434")
+			assert.Nil(t, err)
+			if assert.Len(t, cs.Objections, 1) {
+				assert.Equal(t, tc.workingDaysOpen, cs.Objections[0].Deadline.WorkingDaysOpen)
+				assert.Equal(t, tc.withoutBankHolidays, cs.Objections[0].Deadline.WithoutBankHolidays)
+			}
+		})
+	}
+}
+
+func TestCaseSummaryWithoutObjectionsDoesNotFetchBankHolidays(t *testing.T) {
+	mockHttpClient := mockCaseSummaryHttpClient{}
+	client := NewClient(&mockHttpClient, "http://localhost:8888")
+
+	mockHttpClient.
+		On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.URL.Path == "/lpa-api/v1/digital-lpas/M-QWER-TY34-3434" })).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(`{"opg.poas.sirius":{"id":1}}`))}, nil)
+	mockHttpClient.
+		On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.URL.Path == "/lpa-api/v1/cases/1/tasks" })).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(`{"tasks":[]}`))}, nil)
+	mockHttpClient.
+		On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.URL.Path == "/lpa-api/v1/cases/1/warnings" })).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(`[]`))}, nil)
+	mockHttpClient.
+		On("Do", mock.MatchedBy(func(r *http.Request) bool {
+			return r.URL.Path == "/lpa-api/v1/digital-lpas/M-QWER-TY34-3434/objections"
+		})).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(`[]`))}, nil)
+
+	_, err := client.CaseSummary(Context{Context: context.Background()}, "M-QWER-TY34-3434")
+	assert.Nil(t, err)
+	mockHttpClient.AssertExpectations(t)
+}
@@ -0,0 +1,15 @@
+package sirius
+
+import (
+	"fmt"
+)
+
+type changeTaskDueDateRequest struct {
+	DueDate DateString `json:"dueDate"`
+}
+
+// ChangeTaskDueDate moves a task's due date without changing anything else
+// about the task
+func (c *Client) ChangeTaskDueDate(ctx Context, taskID int, dueDate DateString) error {
+	return c.put(ctx, fmt.Sprintf("/lpa-api/v1/tasks/%d/due-date", taskID), changeTaskDueDateRequest{DueDate: dueDate}, nil)
+}
@@ -0,0 +1,136 @@
+package sirius
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pact-foundation/pact-go/v2/consumer"
+	"github.com/pact-foundation/pact-go/v2/matchers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeTaskDueDate(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		setup         func()
+		expectedError func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("I have a case with an open task assigned").
+					UponReceiving("A request to change the task due date").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodPut,
+						Path:   matchers.String("/lpa-api/v1/tasks/990/due-date"),
+						Headers: matchers.MapMatcher{
+							"Content-Type": matchers.String("application/json"),
+						},
+						Body: map[string]interface{}{
+							"dueDate": "18/04/2024",
+						},
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusOK,
+					})
+			},
+		},
+		{
+			name: "400",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("I have a case with an open task assigned").
+					UponReceiving("A request to change the task due date to a date in the past").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodPut,
+						Path:   matchers.String("/lpa-api/v1/tasks/990/due-date"),
+						Headers: matchers.MapMatcher{
+							"Content-Type": matchers.String("application/json"),
+						},
+						Body: map[string]interface{}{
+							"dueDate": "18/04/2024",
+						},
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status:  http.StatusBadRequest,
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/problem+json")},
+						Body: matchers.Like(map[string]interface{}{
+							"detail": matchers.String("Payload failed validation"),
+							"validation_errors": matchers.Like(map[string]interface{}{
+								"dueDate": matchers.Like(map[string]interface{}{
+									"dateInPast": matchers.String("Due date must be today or in the future"),
+								}),
+							}),
+						}),
+					})
+			},
+			expectedError: func(port int) error {
+				return ValidationError{
+					Detail: "Payload failed validation",
+					Field: FieldErrors{
+						"dueDate": {"dateInPast": "Due date must be today or in the future"},
+					},
+				}
+			},
+		},
+		{
+			name: "404",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("There is no tasks with the specified ID").
+					UponReceiving("A request to change the due date of a non-existent task").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodPut,
+						Path:   matchers.String("/lpa-api/v1/tasks/990/due-date"),
+						Headers: matchers.MapMatcher{
+							"Content-Type": matchers.String("application/json"),
+						},
+						Body: map[string]interface{}{
+							"dueDate": "18/04/2024",
+						},
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusNotFound,
+					})
+			},
+			expectedError: func(port int) error {
+				return StatusError{
+					Code:          404,
+					URL:           fmt.Sprintf("http://127.0.0.1:%d/lpa-api/v1/tasks/990/due-date", port),
+					Method:        "PUT",
+					CorrelationId: "",
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				err := client.ChangeTaskDueDate(Context{Context: context.Background()}, 990, DateString("2024-04-18"))
+
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
@@ -4,9 +4,19 @@ import (
 	"fmt"
 )
 
+type clearTaskRequest struct {
+	CompletionNote string `json:"completionNote"`
+}
+
 func (c *Client) ClearTask(ctx Context, taskID int) error {
 	var v interface{}
 	err := c.put(ctx, fmt.Sprintf("/lpa-api/v1/tasks/%d/mark-as-completed", taskID), nil, &v)
 
 	return err
 }
+
+// ClearTaskWithNote marks the task as completed, recording a note on why it
+// was cleared
+func (c *Client) ClearTaskWithNote(ctx Context, taskID int, note string) error {
+	return c.put(ctx, fmt.Sprintf("/lpa-api/v1/tasks/%d/mark-as-completed", taskID), clearTaskRequest{CompletionNote: note}, nil)
+}
@@ -109,3 +109,36 @@ func TestClearTask(t *testing.T) {
 		})
 	}
 }
+
+func TestClearTaskWithNote(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	pact.
+		AddInteraction().
+		Given("I have a case with an open task assigned").
+		UponReceiving("A request to clear the task with a completion note").
+		WithCompleteRequest(consumer.Request{
+			Method: http.MethodPut,
+			Path:   matchers.String("/lpa-api/v1/tasks/990/mark-as-completed"),
+			Headers: matchers.MapMatcher{
+				"Content-Type": matchers.String("application/json"),
+			},
+			Body: map[string]interface{}{
+				"completionNote": "Handled as part of the donor's complaint",
+			},
+		}).
+		WithCompleteResponse(consumer.Response{
+			Status: http.StatusOK,
+		})
+
+	assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+		client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+		err := client.ClearTaskWithNote(Context{Context: context.Background()}, 990, "Handled as part of the donor's complaint")
+		assert.Nil(t, err)
+		return nil
+	}))
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 type Context struct {
@@ -35,12 +36,14 @@ func NewClient(httpClient HttpClient, baseURL string) *Client {
 	return &Client{
 		http:    httpClient,
 		baseURL: baseURL,
+		now:     time.Now,
 	}
 }
 
 type Client struct {
 	http    HttpClient
 	baseURL string
+	now     func() time.Time
 }
 
 func (c *Client) newRequest(ctx Context, method, path string, body io.Reader) (*http.Request, error) {
@@ -109,7 +112,13 @@ func (c *Client) post(ctx Context, path string, body interface{}, response inter
 		}
 	}
 
-	req, err := c.newRequest(ctx, http.MethodPost, path, bytes.NewReader(data))
+	return c.postReader(ctx, path, bytes.NewReader(data), response)
+}
+
+// postReader is post for a body that has already been encoded, so that large
+// requests can be streamed
+func (c *Client) postReader(ctx Context, path string, body io.Reader, response interface{}) error {
+	req, err := c.newRequest(ctx, http.MethodPost, path, body)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,114 @@
+package sirius
+
+import (
+	"sort"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+)
+
+// compensationNotApplicable is the compensation type handle for a complaint
+// with no compensation
+const compensationNotApplicable = "NOT_APPLICABLE"
+
+// Compensation parses the compensation awarded for the complaint. It is false
+// when none was awarded or the amount cannot be read.
+func (c Complaint) Compensation() (shared.Money, bool) {
+	if c.CompensationType == "" || c.CompensationType == compensationNotApplicable || c.CompensationAmount == "" {
+		return 0, false
+	}
+
+	amount, err := shared.ParseMoney(c.CompensationAmount)
+	if err != nil {
+		return 0, false
+	}
+
+	return amount, true
+}
+
+// CompensationTotal is the compensation awarded of a single type
+type CompensationTotal struct {
+	Type  string
+	Count int
+	Total shared.Money
+}
+
+// CompensationSummary totals the compensation awarded for a set of
+// complaints. Unreadable counts complaints with a compensation type but an
+// amount that could not be read, so they are not silently left out.
+type CompensationSummary struct {
+	Types      []CompensationTotal
+	Count      int
+	Total      shared.Money
+	Unreadable int
+}
+
+func NewCompensationSummary(complaints []Complaint) CompensationSummary {
+	var summary CompensationSummary
+	totals := map[string]*CompensationTotal{}
+
+	for _, complaint := range complaints {
+		amount, ok := complaint.Compensation()
+		if !ok {
+			if complaint.CompensationType != "" && complaint.CompensationType != compensationNotApplicable && complaint.CompensationAmount != "" {
+				summary.Unreadable++
+			}
+			continue
+		}
+
+		total, found := totals[complaint.CompensationType]
+		if !found {
+			total = &CompensationTotal{Type: complaint.CompensationType}
+			totals[complaint.CompensationType] = total
+		}
+
+		total.Count++
+		total.Total += amount
+		summary.Count++
+		summary.Total += amount
+	}
+
+	for _, total := range totals {
+		summary.Types = append(summary.Types, *total)
+	}
+
+	sort.Slice(summary.Types, func(i, j int) bool {
+		return summary.Types[i].Type < summary.Types[j].Type
+	})
+
+	return summary
+}
+
+// CompensationPeriod is the compensation for complaints resolved in a month,
+// given as "2006-01"
+type CompensationPeriod struct {
+	Period  string
+	Summary CompensationSummary
+}
+
+// NewCompensationReport groups complaints by the month they were resolved in,
+// oldest first. Complaints without a resolution date have not been paid and
+// are left out.
+func NewCompensationReport(complaints []Complaint) []CompensationPeriod {
+	byPeriod := map[string][]Complaint{}
+
+	for _, complaint := range complaints {
+		resolved, err := complaint.ResolutionDate.Time()
+		if err != nil {
+			continue
+		}
+
+		period := resolved.Format("2006-01")
+		byPeriod[period] = append(byPeriod[period], complaint)
+	}
+
+	var report []CompensationPeriod
+	for period, periodComplaints := range byPeriod {
+		report = append(report, CompensationPeriod{Period: period, Summary: NewCompensationSummary(periodComplaints)})
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Period < report[j].Period
+	})
+
+	return report
+}
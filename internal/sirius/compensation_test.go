@@ -0,0 +1,88 @@
+package sirius
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplaintCompensation(t *testing.T) {
+	testCases := map[string]struct {
+		complaint Complaint
+		amount    shared.Money
+		ok        bool
+	}{
+		"none":           {},
+		"not applicable": {complaint: Complaint{CompensationType: "NOT_APPLICABLE", CompensationAmount: "10.00"}},
+		"no amount":      {complaint: Complaint{CompensationType: "COMPENSATORY"}},
+		"unreadable":     {complaint: Complaint{CompensationType: "COMPENSATORY", CompensationAmount: "ten"}},
+		"amount": {
+			complaint: Complaint{CompensationType: "COMPENSATORY", CompensationAmount: "150.50"},
+			amount:    15050,
+			ok:        true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			amount, ok := tc.complaint.Compensation()
+			assert.Equal(t, tc.amount, amount)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+}
+
+func TestNewCompensationSummary(t *testing.T) {
+	complaints := []Complaint{
+		{CompensationType: "COMPENSATORY", CompensationAmount: "150.00"},
+		{CompensationType: "EX_GRATIA", CompensationAmount: "20.50"},
+		{CompensationType: "COMPENSATORY", CompensationAmount: "1,000.00"},
+		{CompensationType: "COMPENSATORY", CompensationAmount: "abc"},
+		{CompensationType: "NOT_APPLICABLE"},
+		{},
+	}
+
+	assert.Equal(t, CompensationSummary{
+		Types: []CompensationTotal{
+			{Type: "COMPENSATORY", Count: 2, Total: 115000},
+			{Type: "EX_GRATIA", Count: 1, Total: 2050},
+		},
+		Count:      3,
+		Total:      117050,
+		Unreadable: 1,
+	}, NewCompensationSummary(complaints))
+
+	assert.Equal(t, CompensationSummary{}, NewCompensationSummary(nil))
+}
+
+func TestNewCompensationReport(t *testing.T) {
+	complaints := []Complaint{
+		{CompensationType: "COMPENSATORY", CompensationAmount: "150.00", ResolutionDate: "2024-02-10"},
+		{CompensationType: "COMPENSATORY", CompensationAmount: "50.00", ResolutionDate: "2024-01-31"},
+		{CompensationType: "EX_GRATIA", CompensationAmount: "20.00", ResolutionDate: "2024-02-01"},
+		{CompensationType: "COMPENSATORY", CompensationAmount: "75.00"},
+	}
+
+	assert.Equal(t, []CompensationPeriod{
+		{
+			Period: "2024-01",
+			Summary: CompensationSummary{
+				Types: []CompensationTotal{{Type: "COMPENSATORY", Count: 1, Total: 5000}},
+				Count: 1,
+				Total: 5000,
+			},
+		},
+		{
+			Period: "2024-02",
+			Summary: CompensationSummary{
+				Types: []CompensationTotal{
+					{Type: "COMPENSATORY", Count: 1, Total: 15000},
+					{Type: "EX_GRATIA", Count: 1, Total: 2000},
+				},
+				Count: 2,
+				Total: 17000,
+			},
+		},
+	}, NewCompensationReport(complaints))
+}
@@ -7,6 +7,7 @@ import (
 )
 
 type Complaint struct {
+	ID                   int                      `json:"id,omitempty"`
 	Category             string                   `json:"category"`
 	Description          string                   `json:"description"`
 	ReceivedDate         DateString               `json:"receivedDate"`
@@ -22,6 +23,7 @@ type Complaint struct {
 	Resolution           string                   `json:"resolution,omitempty"`
 	ResolutionInfo       string                   `json:"resolutionInfo,omitempty"`
 	ResolutionDate       DateString               `json:"resolutionDate,omitempty"`
+	CaseItems            []Case                   `json:"caseItems,omitempty"`
 }
 
 func (c *Client) Complaint(ctx Context, id int) (Complaint, error) {
@@ -30,3 +32,26 @@ func (c *Client) Complaint(ctx Context, id int) (Complaint, error) {
 
 	return v, err
 }
+
+func (c *Client) OpenComplaints(ctx Context) ([]Complaint, error) {
+	var v []Complaint
+	err := c.get(ctx, "/lpa-api/v1/complaints?filter=status:open", &v)
+
+	return v, err
+}
+
+func (c *Client) ComplaintsForDonor(ctx Context, donorID int) ([]Complaint, error) {
+	var v []Complaint
+	err := c.get(ctx, fmt.Sprintf("/lpa-api/v1/persons/%d/complaints", donorID), &v)
+
+	return v, err
+}
+
+// CompensatedComplaints lists the complaints with compensation that were
+// resolved between from and to, inclusive
+func (c *Client) CompensatedComplaints(ctx Context, from, to DateString) ([]Complaint, error) {
+	var v []Complaint
+	err := c.get(ctx, fmt.Sprintf("/lpa-api/v1/complaints?filter=compensated:true,resolutionDateFrom:%s,resolutionDateTo:%s", from, to), &v)
+
+	return v, err
+}
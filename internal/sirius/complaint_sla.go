@@ -0,0 +1,69 @@
+package sirius
+
+import (
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+)
+
+// complaintResponseTargets are the number of working days, after a complaint
+// is received, in which it should be responded to
+var complaintResponseTargets = map[shared.ComplaintSeverity]int{
+	shared.ComplaintSeverityComplaint:                10,
+	shared.ComplaintSeverityComplaintsCorrespondence: 10,
+	shared.ComplaintSeverityMinor:                    10,
+	shared.ComplaintSeverityMajor:                    20,
+	shared.ComplaintSeverityTier1:                    20,
+	shared.ComplaintSeveritySecurityBreach:           5,
+}
+
+// ComplaintSLA is how a complaint is doing against its response target. Only
+// one of WorkingDaysRemaining and WorkingDaysOverdue is set. For a resolved
+// complaint WorkingDaysOverdue is how late the resolution was.
+type ComplaintSLA struct {
+	TargetWorkingDays    int
+	DueDate              DateString
+	WorkingDaysRemaining int
+	WorkingDaysOverdue   int
+	IsResolved           bool
+}
+
+// IsMeasured is false when the complaint has no received date or a severity
+// without a target
+func (s ComplaintSLA) IsMeasured() bool {
+	return s.DueDate != ""
+}
+
+func (s ComplaintSLA) IsOverdue() bool {
+	return s.WorkingDaysOverdue > 0
+}
+
+func NewComplaintSLA(complaint Complaint, today time.Time, bankHolidays BankHolidays) ComplaintSLA {
+	var sla ComplaintSLA
+
+	target, ok := complaintResponseTargets[complaint.Severity]
+	if !ok {
+		return sla
+	}
+
+	received, err := complaint.ReceivedDate.Time()
+	if err != nil {
+		return sla
+	}
+
+	due := bankHolidays.AddWorkingDays(received, target)
+
+	sla.TargetWorkingDays = target
+	sla.DueDate = DateString(due.Format(time.DateOnly))
+
+	if resolved, err := complaint.ResolutionDate.Time(); err == nil {
+		sla.IsResolved = true
+		sla.WorkingDaysOverdue = bankHolidays.WorkingDaysBetween(due, resolved)
+		return sla
+	}
+
+	sla.WorkingDaysRemaining = bankHolidays.WorkingDaysBetween(today, due)
+	sla.WorkingDaysOverdue = bankHolidays.WorkingDaysBetween(due, today)
+
+	return sla
+}
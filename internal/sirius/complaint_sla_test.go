@@ -0,0 +1,73 @@
+package sirius
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewComplaintSLA(t *testing.T) {
+	bankHolidays := BankHolidays{
+		"england-and-wales": {
+			"Early May bank holiday": "2024-05-06T00:00:00+01:00",
+		},
+	}
+
+	testCases := map[string]struct {
+		complaint Complaint
+		today     string
+		expected  ComplaintSLA
+	}{
+		"no received date": {
+			complaint: Complaint{Severity: shared.ComplaintSeverityMinor},
+			today:     "2024-05-10",
+		},
+		"severity not recognised": {
+			complaint: Complaint{Severity: shared.ComplaintSeverityNotRecognised, ReceivedDate: "2024-05-01"},
+			today:     "2024-05-10",
+		},
+		"open and due": {
+			complaint: Complaint{Severity: shared.ComplaintSeverityMinor, ReceivedDate: "2024-05-01"},
+			today:     "2024-05-10",
+			expected:  ComplaintSLA{TargetWorkingDays: 10, DueDate: "2024-05-16", WorkingDaysRemaining: 4},
+		},
+		"open and due today": {
+			complaint: Complaint{Severity: shared.ComplaintSeverityMinor, ReceivedDate: "2024-05-01"},
+			today:     "2024-05-16",
+			expected:  ComplaintSLA{TargetWorkingDays: 10, DueDate: "2024-05-16"},
+		},
+		"open and overdue": {
+			complaint: Complaint{Severity: shared.ComplaintSeverityMinor, ReceivedDate: "2024-05-01"},
+			today:     "2024-05-20",
+			expected:  ComplaintSLA{TargetWorkingDays: 10, DueDate: "2024-05-16", WorkingDaysOverdue: 2},
+		},
+		"security breach": {
+			complaint: Complaint{Severity: shared.ComplaintSeveritySecurityBreach, ReceivedDate: "2024-05-01"},
+			today:     "2024-05-02",
+			expected:  ComplaintSLA{TargetWorkingDays: 5, DueDate: "2024-05-09", WorkingDaysRemaining: 4},
+		},
+		"resolved in time": {
+			complaint: Complaint{Severity: shared.ComplaintSeverityMinor, ReceivedDate: "2024-05-01", ResolutionDate: "2024-05-15"},
+			today:     "2024-06-28",
+			expected:  ComplaintSLA{TargetWorkingDays: 10, DueDate: "2024-05-16", IsResolved: true},
+		},
+		"resolved late": {
+			complaint: Complaint{Severity: shared.ComplaintSeverityMinor, ReceivedDate: "2024-05-01", ResolutionDate: "2024-05-17"},
+			today:     "2024-06-28",
+			expected:  ComplaintSLA{TargetWorkingDays: 10, DueDate: "2024-05-16", WorkingDaysOverdue: 1, IsResolved: true},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			today, _ := time.Parse(time.DateOnly, tc.today)
+			sla := NewComplaintSLA(tc.complaint, today, bankHolidays)
+
+			assert.Equal(t, tc.expected, sla)
+			assert.Equal(t, tc.expected.DueDate != "", sla.IsMeasured())
+			assert.Equal(t, tc.expected.WorkingDaysOverdue > 0, sla.IsOverdue())
+		})
+	}
+}
@@ -81,3 +81,255 @@ func TestComplaint(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenComplaints(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		setup            func()
+		expectedResponse []Complaint
+		expectedError    func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("I have a case assigned which has an open complaint").
+					UponReceiving("A request for the open complaints").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodGet,
+						Path:   matchers.String("/lpa-api/v1/complaints"),
+						Query: matchers.MapMatcher{
+							"filter": matchers.String("status:open"),
+						},
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusOK,
+						Body: matchers.EachLike(map[string]interface{}{
+							"id":                   matchers.Like(986),
+							"category":             matchers.String("01"),
+							"description":          matchers.String("This is seriously bad"),
+							"receivedDate":         matchers.String("05/04/2022"),
+							"severity":             matchers.String("Major"),
+							"investigatingOfficer": matchers.String("Test Officer"),
+							"subCategory":          matchers.String("07"),
+							"origin":               matchers.String("PHONE"),
+							"title":                matchers.String("This and that"),
+							"caseItems": matchers.EachLike(map[string]interface{}{
+								"id":       matchers.Like(800),
+								"uId":      matchers.String("7000-0000-0001"),
+								"caseType": matchers.String("LPA"),
+								"donor": matchers.Like(map[string]interface{}{
+									"id":        matchers.Like(189),
+									"firstname": matchers.String("John"),
+									"surname":   matchers.String("Doe"),
+								}),
+							}, 1),
+						}, 1),
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
+					})
+			},
+			expectedResponse: []Complaint{
+				{
+					ID:                   986,
+					Category:             "01",
+					Description:          "This is seriously bad",
+					ReceivedDate:         DateString("2022-04-05"),
+					Severity:             shared.ComplaintSeverityMajor,
+					InvestigatingOfficer: "Test Officer",
+					SubCategory:          "07",
+					Origin:               "PHONE",
+					Title:                "This and that",
+					CaseItems: []Case{
+						{
+							ID:       800,
+							UID:      "7000-0000-0001",
+							CaseType: "LPA",
+							Donor: &Person{
+								ID:        189,
+								Firstname: "John",
+								Surname:   "Doe",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				complaints, err := client.OpenComplaints(Context{Context: context.Background()})
+
+				assert.Equal(t, tc.expectedResponse, complaints)
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
+
+func TestComplaintsForDonor(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		setup            func()
+		expectedResponse []Complaint
+		expectedError    func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("A donor exists with a resolved complaint").
+					UponReceiving("A request for the donor's complaints").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodGet,
+						Path:   matchers.String("/lpa-api/v1/persons/189/complaints"),
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusOK,
+						Body: matchers.EachLike(map[string]interface{}{
+							"id":                 matchers.Like(986),
+							"category":           matchers.String("01"),
+							"description":        matchers.String("This is seriously bad"),
+							"receivedDate":       matchers.String("05/04/2022"),
+							"severity":           matchers.String("Major"),
+							"compensationType":   matchers.String("COMPENSATORY"),
+							"compensationAmount": matchers.String("150.00"),
+							"resolutionDate":     matchers.String("06/05/2022"),
+						}, 1),
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
+					})
+			},
+			expectedResponse: []Complaint{
+				{
+					ID:                 986,
+					Category:           "01",
+					Description:        "This is seriously bad",
+					ReceivedDate:       DateString("2022-04-05"),
+					Severity:           shared.ComplaintSeverityMajor,
+					CompensationType:   "COMPENSATORY",
+					CompensationAmount: "150.00",
+					ResolutionDate:     DateString("2022-05-06"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				complaints, err := client.ComplaintsForDonor(Context{Context: context.Background()}, 189)
+
+				assert.Equal(t, tc.expectedResponse, complaints)
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
+
+func TestCompensatedComplaints(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		setup            func()
+		expectedResponse []Complaint
+		expectedError    func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("A complaint with compensation was resolved in May 2022").
+					UponReceiving("A request for the complaints with compensation resolved in May 2022").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodGet,
+						Path:   matchers.String("/lpa-api/v1/complaints"),
+						Query: matchers.MapMatcher{
+							"filter": matchers.String("compensated:true,resolutionDateFrom:2022-05-01,resolutionDateTo:2022-05-31"),
+						},
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusOK,
+						Body: matchers.EachLike(map[string]interface{}{
+							"id":                 matchers.Like(986),
+							"category":           matchers.String("01"),
+							"description":        matchers.String("This is seriously bad"),
+							"receivedDate":       matchers.String("05/04/2022"),
+							"severity":           matchers.String("Major"),
+							"compensationType":   matchers.String("COMPENSATORY"),
+							"compensationAmount": matchers.String("150.00"),
+							"resolutionDate":     matchers.String("06/05/2022"),
+						}, 1),
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
+					})
+			},
+			expectedResponse: []Complaint{
+				{
+					ID:                 986,
+					Category:           "01",
+					Description:        "This is seriously bad",
+					ReceivedDate:       DateString("2022-04-05"),
+					Severity:           shared.ComplaintSeverityMajor,
+					CompensationType:   "COMPENSATORY",
+					CompensationAmount: "150.00",
+					ResolutionDate:     DateString("2022-05-06"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				complaints, err := client.CompensatedComplaints(Context{Context: context.Background()}, DateString("2022-05-01"), DateString("2022-05-31"))
+
+				assert.Equal(t, tc.expectedResponse, complaints)
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
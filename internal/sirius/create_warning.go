@@ -1,11 +1,22 @@
 package sirius
 
-func (c *Client) CreateWarning(ctx Context, personId int, warningType string, warningNote string, caseIDs []int) error {
+// WarningDetails are the optional parts of a warning. Without an expiry date
+// the warning is permanent.
+type WarningDetails struct {
+	Severity   string
+	ReviewDate DateString
+	ExpiryDate DateString
+}
+
+func (c *Client) CreateWarning(ctx Context, personId int, warningType string, warningNote string, caseIDs []int, details WarningDetails) error {
 	type WarningRequest struct {
-		PersonID    int    `json:"personId,omitempty"`
-		CaseIDs     []int  `json:"caseIds,omitempty"`
-		WarningType string `json:"warningType"`
-		WarningText string `json:"warningText"`
+		PersonID    int        `json:"personId,omitempty"`
+		CaseIDs     []int      `json:"caseIds,omitempty"`
+		WarningType string     `json:"warningType"`
+		WarningText string     `json:"warningText"`
+		Severity    string     `json:"severity,omitempty"`
+		ReviewDate  DateString `json:"reviewDate,omitempty"`
+		ExpiryDate  DateString `json:"expiryDate,omitempty"`
 	}
 
 	data := WarningRequest{
@@ -13,6 +24,9 @@ func (c *Client) CreateWarning(ctx Context, personId int, warningType string, wa
 		CaseIDs:     caseIDs,
 		WarningType: warningType,
 		WarningText: warningNote,
+		Severity:    details.Severity,
+		ReviewDate:  details.ReviewDate,
+		ExpiryDate:  details.ExpiryDate,
 	}
 
 	return c.post(ctx, "/lpa-api/v1/warnings", data, nil)
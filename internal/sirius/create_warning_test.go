@@ -56,7 +56,7 @@ func TestCreateWarning(t *testing.T) {
 			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
 				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
 
-				err := client.CreateWarning(Context{Context: context.Background()}, 189, "Complaint Received", "Some warning notes", []int{})
+				err := client.CreateWarning(Context{Context: context.Background()}, 189, "Complaint Received", "Some warning notes", []int{}, WarningDetails{})
 				if (tc.expectedError) == nil {
 					assert.Nil(t, err)
 				} else {
@@ -114,7 +114,71 @@ func TestCreateWarningOnMultipleCases(t *testing.T) {
 			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
 				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
 
-				err := client.CreateWarning(Context{Context: context.Background()}, 400, "Complaint Received", "Some warning notes for multiple cases", []int{405, 406})
+				err := client.CreateWarning(Context{Context: context.Background()}, 400, "Complaint Received", "Some warning notes for multiple cases", []int{405, 406}, WarningDetails{})
+				if (tc.expectedError) == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
+
+func TestCreateWarningWithExpiry(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		setup         func()
+		expectedError func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("A donor exists").
+					UponReceiving("A request to create a high severity warning with an expiry").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodPost,
+						Path:   matchers.String("/lpa-api/v1/warnings"),
+						Headers: matchers.MapMatcher{
+							"Content-Type": matchers.String("application/json"),
+						},
+						Body: matchers.Like(map[string]interface{}{
+							"personId":    matchers.Like(189),
+							"warningType": matchers.Like("Safeguarding"),
+							"warningText": matchers.Like("Some warning notes"),
+							"severity":    matchers.Like("high"),
+							"reviewDate":  matchers.Term("01/06/2024", `^\d{1,2}/\d{1,2}/\d{4}$`),
+							"expiryDate":  matchers.Term("01/12/2024", `^\d{1,2}/\d{1,2}/\d{4}$`),
+						}),
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status:  http.StatusCreated,
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
+					})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				err := client.CreateWarning(Context{Context: context.Background()}, 189, "Safeguarding", "Some warning notes", []int{}, WarningDetails{
+					Severity:   WarningSeverityHigh,
+					ReviewDate: "2024-06-01",
+					ExpiryDate: "2024-12-01",
+				})
 				if (tc.expectedError) == nil {
 					assert.Nil(t, err)
 				} else {
@@ -28,6 +28,7 @@ type SiriusData struct {
 	LinkedCases        []SiriusData      `json:"linkedDigitalLpas"`
 	Donor              Donor             `json:"donor"`
 	DueDate            DateString        `json:"dueDate"`
+	NoticeGivenDate    DateString        `json:"noticeGivenDate"`
 }
 
 type Donor struct {
@@ -0,0 +1,62 @@
+package sirius
+
+import "time"
+
+// HoldPeriodLength is a hold period with the number of working days it lasted,
+// or has lasted so far when it is still open
+type HoldPeriodLength struct {
+	HoldPeriod
+	WorkingDays int
+	IsOpen      bool
+}
+
+// HoldSummary is how long an investigation has spent on hold. CurrentWorkingDays
+// is the age of the open hold, and is only set when the investigation is on
+// hold.
+type HoldSummary struct {
+	Periods            []HoldPeriodLength
+	TotalWorkingDays   int
+	CurrentWorkingDays int
+	CurrentStartDate   DateString
+	IsOnHold           bool
+}
+
+// IsOverdue reports whether the open hold has lasted at least threshold working
+// days
+func (s HoldSummary) IsOverdue(threshold int) bool {
+	return s.IsOnHold && threshold > 0 && s.CurrentWorkingDays >= threshold
+}
+
+// NewHoldSummary measures hold periods in working days, counting an open period
+// up to today. Periods without a valid start date are listed but not counted.
+func NewHoldSummary(periods []HoldPeriod, today time.Time, bankHolidays BankHolidays) HoldSummary {
+	var summary HoldSummary
+
+	for _, period := range periods {
+		length := HoldPeriodLength{HoldPeriod: period, IsOpen: period.EndDate == ""}
+
+		start, err := period.StartDate.Time()
+		if err == nil {
+			end := today
+			if !length.IsOpen {
+				end, err = period.EndDate.Time()
+			}
+
+			if err == nil {
+				length.WorkingDays = bankHolidays.WorkingDaysBetween(start, end)
+			}
+		}
+
+		summary.TotalWorkingDays += length.WorkingDays
+
+		if length.IsOpen {
+			summary.IsOnHold = true
+			summary.CurrentWorkingDays = length.WorkingDays
+			summary.CurrentStartDate = period.StartDate
+		}
+
+		summary.Periods = append(summary.Periods, length)
+	}
+
+	return summary
+}
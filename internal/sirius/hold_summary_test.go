@@ -0,0 +1,78 @@
+package sirius
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHoldSummary(t *testing.T) {
+	bankHolidays := BankHolidays{
+		"england-and-wales": {
+			"Early May bank holiday": "2024-05-06T00:00:00+01:00",
+		},
+	}
+	today := time.Date(2024, time.May, 10, 14, 30, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		periods  []HoldPeriod
+		expected HoldSummary
+	}{
+		"never on hold": {},
+		"closed holds": {
+			periods: []HoldPeriod{
+				{ID: 1, StartDate: "2024-04-01", EndDate: "2024-04-05"},
+				{ID: 2, StartDate: "2024-05-03", EndDate: "2024-05-07"},
+			},
+			expected: HoldSummary{
+				Periods: []HoldPeriodLength{
+					{HoldPeriod: HoldPeriod{ID: 1, StartDate: "2024-04-01", EndDate: "2024-04-05"}, WorkingDays: 4},
+					{HoldPeriod: HoldPeriod{ID: 2, StartDate: "2024-05-03", EndDate: "2024-05-07"}, WorkingDays: 1},
+				},
+				TotalWorkingDays: 5,
+			},
+		},
+		"open hold": {
+			periods: []HoldPeriod{
+				{ID: 1, StartDate: "2024-04-01", EndDate: "2024-04-05"},
+				{ID: 2, StartDate: "2024-05-02"},
+			},
+			expected: HoldSummary{
+				Periods: []HoldPeriodLength{
+					{HoldPeriod: HoldPeriod{ID: 1, StartDate: "2024-04-01", EndDate: "2024-04-05"}, WorkingDays: 4},
+					{HoldPeriod: HoldPeriod{ID: 2, StartDate: "2024-05-02"}, WorkingDays: 5, IsOpen: true},
+				},
+				TotalWorkingDays:   9,
+				CurrentWorkingDays: 5,
+				CurrentStartDate:   "2024-05-02",
+				IsOnHold:           true,
+			},
+		},
+		"invalid dates": {
+			periods: []HoldPeriod{
+				{ID: 1, StartDate: "", EndDate: "2024-04-05"},
+				{ID: 2, StartDate: "2024-04-01", EndDate: "not a date"},
+			},
+			expected: HoldSummary{
+				Periods: []HoldPeriodLength{
+					{HoldPeriod: HoldPeriod{ID: 1, StartDate: "", EndDate: "2024-04-05"}},
+					{HoldPeriod: HoldPeriod{ID: 2, StartDate: "2024-04-01", EndDate: "not a date"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewHoldSummary(tc.periods, today, bankHolidays))
+		})
+	}
+}
+
+func TestHoldSummaryIsOverdue(t *testing.T) {
+	assert.True(t, HoldSummary{IsOnHold: true, CurrentWorkingDays: 20}.IsOverdue(20))
+	assert.False(t, HoldSummary{IsOnHold: true, CurrentWorkingDays: 19}.IsOverdue(20))
+	assert.False(t, HoldSummary{TotalWorkingDays: 30}.IsOverdue(20))
+	assert.False(t, HoldSummary{IsOnHold: true, CurrentWorkingDays: 19}.IsOverdue(0))
+}
@@ -14,6 +14,7 @@ type Investigation struct {
 	RiskAssessmentDate       DateString   `json:"riskAssessmentDate,omitempty"`
 	ApprovalOutcome          string       `json:"reportApprovalOutcome,omitempty"`
 	InvestigationClosureDate DateString   `json:"investigationClosureDate,omitempty"`
+	CaseItems                []Case       `json:"caseItems,omitempty"`
 }
 
 func (c *Client) Investigation(ctx Context, id int) (Investigation, error) {
@@ -22,3 +23,10 @@ func (c *Client) Investigation(ctx Context, id int) (Investigation, error) {
 
 	return v, err
 }
+
+func (c *Client) OnHoldInvestigations(ctx Context) ([]Investigation, error) {
+	var v []Investigation
+	err := c.get(ctx, "/lpa-api/v1/investigations?filter=isOnHold:true", &v)
+
+	return v, err
+}
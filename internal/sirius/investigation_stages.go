@@ -0,0 +1,129 @@
+package sirius
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// InvestigationStage is a step an investigation goes through. Field is the
+// name Sirius uses for the stage's date, so errors can be shown against the
+// matching input.
+type InvestigationStage struct {
+	Name  string
+	Field string
+	Label string
+	Date  DateString
+}
+
+// Stages lists the stages of an investigation in the order they must happen
+func (i Investigation) Stages() []InvestigationStage {
+	return []InvestigationStage{
+		{Name: "Received", Field: "investigationReceivedDate", Label: "Date received", Date: i.DateReceived},
+		{Name: "Risk assessed", Field: "riskAssessmentDate", Label: "Date of risk assessment", Date: i.RiskAssessmentDate},
+		{Name: "Report approved", Field: "reportApprovalDate", Label: "Approval date", Date: i.ApprovalDate},
+		{Name: "Closed", Field: "investigationClosureDate", Label: "Investigation closure date", Date: i.InvestigationClosureDate},
+	}
+}
+
+// Stage is the name of the latest stage the investigation has reached
+func (i Investigation) Stage() string {
+	var stage string
+	for _, s := range i.Stages() {
+		if s.Date != "" {
+			stage = s.Name
+		}
+	}
+
+	return stage
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// ValidateStages checks that each stage's date is not in the future, that no
+// stage has been skipped, and that each date is on or after the date of the
+// stage before it. Dates that cannot be read are left for Sirius to reject.
+func (i Investigation) ValidateStages(today time.Time) FieldErrors {
+	errs := FieldErrors{}
+	todayString := today.Format("2006-01-02")
+	stages := i.Stages()
+
+	var previous *InvestigationStage
+	for n, stage := range stages {
+		if stage.Date == "" {
+			continue
+		}
+
+		if _, err := stage.Date.Time(); err != nil {
+			continue
+		}
+
+		if string(stage.Date) > todayString {
+			errs[stage.Field] = map[string]string{
+				"dateInFuture": stage.Label + " must not be in the future",
+			}
+			continue
+		}
+
+		for _, earlier := range stages[:n] {
+			if earlier.Date == "" {
+				errs[stage.Field] = map[string]string{
+					"stageSkipped": "Enter the " + lowerFirst(earlier.Label) + " before the " + lowerFirst(stage.Label),
+				}
+				break
+			}
+		}
+
+		if _, ok := errs[stage.Field]; !ok && previous != nil && stage.Date < previous.Date {
+			errs[stage.Field] = map[string]string{
+				"dateOutOfOrder": stage.Label + " must be on or after the " + lowerFirst(previous.Label),
+			}
+		}
+
+		previous = &stages[n]
+	}
+
+	return errs
+}
+
+// InvestigationTimelineEvent is a stage reached or a hold period, for showing
+// the history of an investigation
+type InvestigationTimelineEvent struct {
+	Label   string
+	Date    DateString
+	EndDate DateString
+	Reason  string
+	IsHold  bool
+	IsOpen  bool
+}
+
+// Timeline lists the stages reached and the hold periods of an investigation,
+// oldest first
+func (i Investigation) Timeline() []InvestigationTimelineEvent {
+	var events []InvestigationTimelineEvent
+
+	for _, stage := range i.Stages() {
+		if stage.Date != "" {
+			events = append(events, InvestigationTimelineEvent{Label: stage.Name, Date: stage.Date})
+		}
+	}
+
+	for _, hold := range i.HoldPeriods {
+		events = append(events, InvestigationTimelineEvent{
+			Label:   "On hold",
+			Date:    hold.StartDate,
+			EndDate: hold.EndDate,
+			Reason:  hold.Reason,
+			IsHold:  true,
+			IsOpen:  hold.EndDate == "",
+		})
+	}
+
+	sort.SliceStable(events, func(a, b int) bool {
+		return events[a].Date < events[b].Date
+	})
+
+	return events
+}
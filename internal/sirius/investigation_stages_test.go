@@ -0,0 +1,101 @@
+package sirius
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvestigationStage(t *testing.T) {
+	assert.Equal(t, "", Investigation{}.Stage())
+	assert.Equal(t, "Received", Investigation{DateReceived: "2024-01-02"}.Stage())
+	assert.Equal(t, "Report approved", Investigation{
+		DateReceived:       "2024-01-02",
+		RiskAssessmentDate: "2024-01-10",
+		ApprovalDate:       "2024-02-01",
+	}.Stage())
+}
+
+func TestInvestigationValidateStages(t *testing.T) {
+	today := time.Date(2024, time.May, 10, 14, 30, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		investigation Investigation
+		expected      FieldErrors
+	}{
+		"no dates": {
+			expected: FieldErrors{},
+		},
+		"in order": {
+			investigation: Investigation{
+				DateReceived:             "2024-01-02",
+				RiskAssessmentDate:       "2024-01-02",
+				ApprovalDate:             "2024-03-01",
+				InvestigationClosureDate: "2024-05-10",
+			},
+			expected: FieldErrors{},
+		},
+		"in the future": {
+			investigation: Investigation{
+				DateReceived:       "2024-01-02",
+				RiskAssessmentDate: "2024-05-11",
+			},
+			expected: FieldErrors{
+				"riskAssessmentDate": {"dateInFuture": "Date of risk assessment must not be in the future"},
+			},
+		},
+		"out of order": {
+			investigation: Investigation{
+				DateReceived:       "2024-02-01",
+				RiskAssessmentDate: "2024-01-31",
+				ApprovalDate:       "2024-03-01",
+			},
+			expected: FieldErrors{
+				"riskAssessmentDate": {"dateOutOfOrder": "Date of risk assessment must be on or after the date received"},
+			},
+		},
+		"skipped stage": {
+			investigation: Investigation{
+				DateReceived:             "2024-01-02",
+				ApprovalDate:             "2024-03-01",
+				InvestigationClosureDate: "2024-02-01",
+			},
+			expected: FieldErrors{
+				"reportApprovalDate":       {"stageSkipped": "Enter the date of risk assessment before the approval date"},
+				"investigationClosureDate": {"stageSkipped": "Enter the date of risk assessment before the investigation closure date"},
+			},
+		},
+		"unreadable date": {
+			investigation: Investigation{
+				DateReceived:       "2024-02-01",
+				RiskAssessmentDate: "not-a-date",
+			},
+			expected: FieldErrors{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.investigation.ValidateStages(today))
+		})
+	}
+}
+
+func TestInvestigationTimeline(t *testing.T) {
+	investigation := Investigation{
+		DateReceived:       "2024-01-02",
+		RiskAssessmentDate: "2024-01-10",
+		HoldPeriods: []HoldPeriod{
+			{ID: 2, Reason: "LA Investigation", StartDate: "2024-03-01"},
+			{ID: 1, Reason: "Police Investigation", StartDate: "2024-01-05", EndDate: "2024-01-08"},
+		},
+	}
+
+	assert.Equal(t, []InvestigationTimelineEvent{
+		{Label: "Received", Date: "2024-01-02"},
+		{Label: "On hold", Date: "2024-01-05", EndDate: "2024-01-08", Reason: "Police Investigation", IsHold: true},
+		{Label: "Risk assessed", Date: "2024-01-10"},
+		{Label: "On hold", Date: "2024-03-01", Reason: "LA Investigation", IsHold: true, IsOpen: true},
+	}, investigation.Timeline())
+}
@@ -157,3 +157,110 @@ func TestInvestigationOnHold(t *testing.T) {
 		})
 	}
 }
+
+func TestOnHoldInvestigations(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		setup            func()
+		expectedResponse []Investigation
+		expectedError    func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("I have a case assigned which has an investigation on hold").
+					UponReceiving("A request for the investigations which are on hold").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodGet,
+						Path:   matchers.String("/lpa-api/v1/investigations"),
+						Query: matchers.MapMatcher{
+							"filter": matchers.String("isOnHold:true"),
+						},
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusOK,
+						Body: matchers.EachLike(map[string]interface{}{
+							"id":                        matchers.Like(301),
+							"investigationTitle":        matchers.String("Test title"),
+							"additionalInformation":     matchers.String("Some test info"),
+							"type":                      matchers.String("Normal"),
+							"investigationReceivedDate": matchers.String("23/01/2022"),
+							"isOnHold":                  true,
+							"holdPeriods": matchers.EachLike(map[string]interface{}{
+								"id":        matchers.Like(175),
+								"reason":    matchers.String("Police Investigation"),
+								"startDate": matchers.String("25/01/2022"),
+							}, 1),
+							"caseItems": matchers.EachLike(map[string]interface{}{
+								"id":       matchers.Like(800),
+								"uId":      matchers.String("7000-0000-0001"),
+								"caseType": matchers.String("LPA"),
+								"donor": matchers.Like(map[string]interface{}{
+									"id":        matchers.Like(189),
+									"firstname": matchers.String("John"),
+									"surname":   matchers.String("Doe"),
+								}),
+							}, 1),
+						}, 1),
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
+					})
+			},
+			expectedResponse: []Investigation{
+				{
+					ID:           301,
+					Title:        "Test title",
+					Information:  "Some test info",
+					Type:         "Normal",
+					DateReceived: DateString("2022-01-23"),
+					IsOnHold:     true,
+					HoldPeriods: []HoldPeriod{
+						{
+							ID:        175,
+							Reason:    "Police Investigation",
+							StartDate: DateString("2022-01-25"),
+						},
+					},
+					CaseItems: []Case{
+						{
+							ID:       800,
+							UID:      "7000-0000-0001",
+							CaseType: "LPA",
+							Donor: &Person{
+								ID:        189,
+								Firstname: "John",
+								Surname:   "Doe",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				investigations, err := client.OnHoldInvestigations(Context{Context: context.Background()})
+
+				assert.Equal(t, tc.expectedResponse, investigations)
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package sirius
+
+// PersonMerge is a request to merge one person record into another. Person is
+// how the surviving record should look once merged, and the remaining fields
+// are recorded by Sirius in the audit event for the merge.
+type PersonMerge struct {
+	SurvivorID   int            `json:"survivorId"`
+	MergedID     int            `json:"mergedId"`
+	Person       Person         `json:"person"`
+	FieldSources map[string]int `json:"fieldSources"`
+	CaseIDs      []int          `json:"caseIds"`
+	DocumentIDs  []int          `json:"documentIds"`
+	WarningIDs   []int          `json:"warningIds"`
+}
+
+func (c *Client) MergePeople(ctx Context, merge PersonMerge) error {
+	return c.post(ctx, "/lpa-api/v1/person-merges", merge, nil)
+}
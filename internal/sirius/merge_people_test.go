@@ -0,0 +1,82 @@
+package sirius
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pact-foundation/pact-go/v2/consumer"
+	"github.com/pact-foundation/pact-go/v2/matchers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergePeople(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		setup         func()
+		expectedError func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("2 donors exist").
+					UponReceiving("A request to merge two people").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodPost,
+						Path:   matchers.String("/lpa-api/v1/person-merges"),
+						Body: matchers.Like(map[string]interface{}{
+							"survivorId": matchers.Like(189),
+							"mergedId":   matchers.Like(190),
+							"person": matchers.Like(map[string]interface{}{
+								"firstname": matchers.String("John"),
+								"surname":   matchers.String("Doe"),
+							}),
+							"fieldSources": matchers.Like(map[string]interface{}{
+								"firstname": matchers.Like(190),
+							}),
+							"caseIds":     matchers.EachLike(800, 1),
+							"documentIds": matchers.EachLike(900, 1),
+							"warningIds":  matchers.EachLike(700, 1),
+						}),
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusNoContent,
+					})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				err := client.MergePeople(Context{Context: context.Background()}, PersonMerge{
+					SurvivorID:   189,
+					MergedID:     190,
+					Person:       Person{Firstname: "John", Surname: "Doe"},
+					FieldSources: map[string]int{"firstname": 190},
+					CaseIDs:      []int{800},
+					DocumentIDs:  []int{900},
+					WarningIDs:   []int{700},
+				})
+				if (tc.expectedError) == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
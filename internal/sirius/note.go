@@ -1,31 +1,112 @@
 package sirius
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 )
 
+// NoteFile is an attachment to a note. Open is only called while the note is
+// being sent, so the file is streamed to Sirius rather than held in memory.
 type NoteFile struct {
-	Name   string `json:"name"`
-	Source string `json:"source"`
-	Type   string `json:"type"`
+	Name string
+	Type string
+	Open func() (io.ReadCloser, error)
 }
 
 type noteRequest struct {
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Type        string    `json:"type"`
-	File        *NoteFile `json:"file,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
 }
 
-func (c *Client) CreateNote(ctx Context, entityID int, entityType EntityType, noteType, name, description string, file *NoteFile) error {
+func (c *Client) CreateNote(ctx Context, entityID int, entityType EntityType, noteType, name, description string, files []NoteFile) error {
 	data := noteRequest{
 		Name:        name,
 		Description: description,
 		Type:        noteType,
-		File:        file,
 	}
 
-	return c.post(ctx, fmt.Sprintf("/lpa-api/v1/%ss/%d/notes", entityType, entityID), data, nil)
+	body, w := io.Pipe()
+	defer body.Close() //nolint:errcheck // closing only stops the writer if the request failed early
+
+	go func() {
+		w.CloseWithError(writeNoteRequest(w, data, files))
+	}()
+
+	return c.postReader(ctx, fmt.Sprintf("/lpa-api/v1/%ss/%d/notes", entityType, entityID), body, nil)
+}
+
+// writeNoteRequest writes the JSON for a note, base64 encoding each file's
+// contents as it is read
+func writeNoteRequest(w io.Writer, data noteRequest, files []NoteFile) error {
+	fields, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if len(files) == 0 {
+		_, err := w.Write(fields)
+		return err
+	}
+
+	if _, err := w.Write(bytes.TrimSuffix(fields, []byte("}"))); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, `,"files":[`); err != nil {
+		return err
+	}
+
+	for i, file := range files {
+		if err := writeNoteFile(w, file, i > 0); err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "]}")
+	return err
+}
+
+func writeNoteFile(w io.Writer, file NoteFile, separate bool) error {
+	name, err := json.Marshal(file.Name)
+	if err != nil {
+		return err
+	}
+
+	fileType, err := json.Marshal(file.Type)
+	if err != nil {
+		return err
+	}
+
+	prefix := ""
+	if separate {
+		prefix = ","
+	}
+
+	if _, err := fmt.Fprintf(w, `%s{"name":%s,"type":%s,"source":"`, prefix, name, fileType); err != nil {
+		return err
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck // no need to check error when closing file
+
+	enc := base64.NewEncoder(base64.StdEncoding, w)
+	if _, err := io.Copy(enc, f); err != nil {
+		return err
+	}
+
+	if err := enc.Close(); err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, `"}`)
+	return err
 }
 
 func (c *Client) DeleteNote(ctx Context, noteID int) error {
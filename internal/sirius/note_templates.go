@@ -0,0 +1,37 @@
+package sirius
+
+// NoteTemplate sets out what to record for a kind of note, such as the
+// caller and actions for a phone call. Each field is filled in separately and
+// the answers are written into the note's description.
+type NoteTemplate struct {
+	ID       int                 `json:"id"`
+	Name     string              `json:"name"`
+	NoteType string              `json:"noteType"`
+	Subject  string              `json:"subject"`
+	Fields   []NoteTemplateField `json:"fields"`
+}
+
+type NoteTemplateField struct {
+	Name      string `json:"name"`
+	Label     string `json:"label"`
+	Value     string `json:"value,omitempty"`
+	Multiline bool   `json:"multiline,omitempty"`
+}
+
+func (c *Client) NoteTemplates(ctx Context) ([]NoteTemplate, error) {
+	var v []NoteTemplate
+	err := c.get(ctx, "/lpa-api/v1/note-templates", &v)
+
+	return v, err
+}
+
+// FindNoteTemplate returns the template with the given ID
+func FindNoteTemplate(templates []NoteTemplate, id int) (NoteTemplate, bool) {
+	for _, template := range templates {
+		if template.ID == id {
+			return template, true
+		}
+	}
+
+	return NoteTemplate{}, false
+}
@@ -0,0 +1,101 @@
+package sirius
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pact-foundation/pact-go/v2/consumer"
+	"github.com/pact-foundation/pact-go/v2/matchers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoteTemplates(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		setup            func()
+		expectedResponse []NoteTemplate
+		expectedError    func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("Some note templates exist").
+					UponReceiving("A request for note templates").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodGet,
+						Path:   matchers.String("/lpa-api/v1/note-templates"),
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusOK,
+						Body: matchers.EachLike(map[string]interface{}{
+							"id":       matchers.Like(2),
+							"name":     matchers.String("Phone call"),
+							"noteType": matchers.String("Phone call"),
+							"subject":  matchers.String("Call from donor"),
+							"fields": matchers.EachLike(map[string]interface{}{
+								"name":      matchers.String("summary"),
+								"label":     matchers.String("Summary"),
+								"value":     matchers.String("Caller asked about"),
+								"multiline": matchers.Like(true),
+							}, 1),
+						}, 1),
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
+					})
+			},
+			expectedResponse: []NoteTemplate{
+				{
+					ID:       2,
+					Name:     "Phone call",
+					NoteType: "Phone call",
+					Subject:  "Call from donor",
+					Fields: []NoteTemplateField{
+						{Name: "summary", Label: "Summary", Value: "Caller asked about", Multiline: true},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				templates, err := client.NoteTemplates(Context{Context: context.Background()})
+
+				assert.Equal(t, tc.expectedResponse, templates)
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
+
+func TestFindNoteTemplate(t *testing.T) {
+	templates := []NoteTemplate{
+		{ID: 1, Name: "Phone call"},
+		{ID: 2, Name: "Email"},
+	}
+
+	template, ok := FindNoteTemplate(templates, 2)
+	assert.True(t, ok)
+	assert.Equal(t, "Email", template.Name)
+
+	_, ok = FindNoteTemplate(templates, 9)
+	assert.False(t, ok)
+}
@@ -1,9 +1,13 @@
 package sirius
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/pact-foundation/pact-go/v2/consumer"
@@ -22,7 +26,7 @@ func TestCreateNote(t *testing.T) {
 		name          string
 		setup         func()
 		expectedError func(int) error
-		file          *NoteFile
+		files         []NoteFile
 	}{
 		{
 			name: "OK",
@@ -50,12 +54,12 @@ func TestCreateNote(t *testing.T) {
 			},
 		},
 		{
-			name: "OK with file",
+			name: "OK with files",
 			setup: func() {
 				pact.
 					AddInteraction().
 					Given("I have a pending case assigned").
-					UponReceiving("A request to create a note with a file").
+					UponReceiving("A request to create a note with files").
 					WithCompleteRequest(consumer.Request{
 						Method: http.MethodPost,
 						Path:   matchers.String("/lpa-api/v1/lpas/800/notes"),
@@ -66,11 +70,11 @@ func TestCreateNote(t *testing.T) {
 							"name":        "Something",
 							"description": "More words",
 							"type":        "Application processing",
-							"file": matchers.Like(map[string]interface{}{
-								"name":   "words.txt",
-								"type":   "plain/text",
-								"source": "SGVsbG8gdGhlcmUK",
-							}),
+							"files": matchers.EachLike(map[string]interface{}{
+								"name":   matchers.String("words.txt"),
+								"type":   matchers.String("text/plain"),
+								"source": matchers.String("SGVsbG8gdGhlcmUK"),
+							}, 2),
 						}),
 					}).
 					WithCompleteResponse(consumer.Response{
@@ -78,10 +82,9 @@ func TestCreateNote(t *testing.T) {
 						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
 					})
 			},
-			file: &NoteFile{
-				Name:   "words.txt",
-				Type:   "plain/text",
-				Source: "SGVsbG8gdGhlcmUK",
+			files: []NoteFile{
+				testNoteFile("words.txt", "text/plain", "Hello there\n"),
+				testNoteFile("more-words.txt", "text/plain", "Hello again\n"),
 			},
 		},
 	}
@@ -93,7 +96,7 @@ func TestCreateNote(t *testing.T) {
 			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
 				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
 
-				err := client.CreateNote(Context{Context: context.Background()}, 800, "lpa", "Application processing", "Something", "More words", tc.file)
+				err := client.CreateNote(Context{Context: context.Background()}, 800, "lpa", "Application processing", "Something", "More words", tc.files)
 				if (tc.expectedError) == nil {
 					assert.Nil(t, err)
 				} else {
@@ -105,6 +108,59 @@ func TestCreateNote(t *testing.T) {
 	}
 }
 
+func TestWriteNoteRequest(t *testing.T) {
+	data := noteRequest{Name: "Something", Description: "More <b>words</b>", Type: "Application processing"}
+
+	testCases := map[string]struct {
+		files    []NoteFile
+		expected string
+	}{
+		"no files": {
+			expected: `{"name":"Something","description":"More \u003cb\u003ewords\u003c/b\u003e","type":"Application processing"}`,
+		},
+		"files": {
+			files: []NoteFile{
+				testNoteFile("words.txt", "text/plain", "Hello there\n"),
+				testNoteFile(`"quoted".pdf`, "application/pdf", "%PDF-"),
+			},
+			expected: `{"name":"Something","description":"More \u003cb\u003ewords\u003c/b\u003e","type":"Application processing",` +
+				`"files":[{"name":"words.txt","type":"text/plain","source":"SGVsbG8gdGhlcmUK"},` +
+				`{"name":"\"quoted\".pdf","type":"application/pdf","source":"JVBERi0="}]}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := writeNoteRequest(&buf, data, tc.files)
+
+			assert.Nil(t, err)
+			assert.JSONEq(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestWriteNoteRequestWhenFileCannotBeOpened(t *testing.T) {
+	files := []NoteFile{{
+		Name: "words.txt",
+		Type: "text/plain",
+		Open: func() (io.ReadCloser, error) { return nil, errors.New("err") },
+	}}
+
+	err := writeNoteRequest(io.Discard, noteRequest{}, files)
+	assert.Equal(t, errors.New("err"), err)
+}
+
+func testNoteFile(name, fileType, contents string) NoteFile {
+	return NoteFile{
+		Name: name,
+		Type: fileType,
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(contents)), nil
+		},
+	}
+}
+
 func TestDeleteNote(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,65 @@
+package sirius
+
+import (
+	"errors"
+	"time"
+)
+
+// objectionPeriod is the number of days, beginning with the day notice of the
+// application was given, in which an objection can be made
+const objectionPeriod = 28
+
+// ObjectionDeadline is how long an objection has been open and whether it was
+// received in time. InTime is nil when the LPA has no notice date to measure
+// against. WithoutBankHolidays is set when bank holidays could not be fetched,
+// so WorkingDaysOpen may count some of them.
+type ObjectionDeadline struct {
+	WorkingDaysOpen     int
+	Deadline            DateString
+	InTime              *bool
+	WithoutBankHolidays bool
+}
+
+// ReceivedOn parses ReceivedDate, which may be given as "2006-01-02" or in the
+// Sirius format of "02/01/2006"
+func (o Objection) ReceivedOn() (time.Time, error) {
+	for _, layout := range []string{time.DateOnly, "02/01/2006"} {
+		if t, err := time.Parse(layout, o.ReceivedDate); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.New("objection has no valid received date")
+}
+
+// IsResolvedFor reports whether an outcome has been recorded for the LPA
+func (o Objection) IsResolvedFor(uid string) bool {
+	for _, resolution := range o.Resolutions {
+		if resolution.Uid == uid && resolution.Resolution != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func NewObjectionDeadline(objection Objection, noticeGivenDate DateString, today time.Time, bankHolidays BankHolidays) ObjectionDeadline {
+	var deadline ObjectionDeadline
+
+	received, err := objection.ReceivedOn()
+	if err != nil {
+		return deadline
+	}
+
+	deadline.WorkingDaysOpen = bankHolidays.WorkingDaysBetween(received, today)
+
+	if notice, err := noticeGivenDate.Time(); err == nil {
+		last := notice.AddDate(0, 0, objectionPeriod-1)
+		inTime := !received.After(last)
+
+		deadline.Deadline = DateString(last.Format(time.DateOnly))
+		deadline.InTime = &inTime
+	}
+
+	return deadline
+}
@@ -0,0 +1,84 @@
+package sirius
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectionReceivedOn(t *testing.T) {
+	for _, received := range []string{"2024-09-05", "05/09/2024"} {
+		date, err := Objection{ReceivedDate: received}.ReceivedOn()
+
+		assert.Nil(t, err)
+		assert.Equal(t, time.Date(2024, time.September, 5, 0, 0, 0, 0, time.UTC), date)
+	}
+
+	_, err := Objection{}.ReceivedOn()
+	assert.NotNil(t, err)
+}
+
+func TestObjectionIsResolvedFor(t *testing.T) {
+	objection := Objection{
+		LpaUids: []string{"M-1111-1111-1111", "M-2222-2222-2222", "M-3333-3333-3333"},
+		Resolutions: []ObjectionResolution{
+			{Uid: "M-1111-1111-1111", Resolution: "upheld"},
+			{Uid: "M-2222-2222-2222"},
+		},
+	}
+
+	assert.True(t, objection.IsResolvedFor("M-1111-1111-1111"))
+	assert.False(t, objection.IsResolvedFor("M-2222-2222-2222"))
+	assert.False(t, objection.IsResolvedFor("M-3333-3333-3333"))
+}
+
+func TestNewObjectionDeadline(t *testing.T) {
+	inTime := true
+	outOfTime := false
+
+	bankHolidays := BankHolidays{
+		"england-and-wales": {
+			"Early May bank holiday": "2024-05-06T00:00:00+01:00",
+		},
+	}
+	today := time.Date(2024, time.May, 10, 14, 30, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		receivedDate    string
+		noticeGivenDate DateString
+		expected        ObjectionDeadline
+	}{
+		"no notice date": {
+			receivedDate: "2024-05-01",
+			expected:     ObjectionDeadline{WorkingDaysOpen: 6},
+		},
+		"received on last day": {
+			receivedDate:    "2024-04-28",
+			noticeGivenDate: "2024-04-01",
+			expected:        ObjectionDeadline{WorkingDaysOpen: 9, Deadline: "2024-04-28", InTime: &inTime},
+		},
+		"received after last day": {
+			receivedDate:    "2024-04-29",
+			noticeGivenDate: "2024-04-01",
+			expected:        ObjectionDeadline{WorkingDaysOpen: 8, Deadline: "2024-04-28", InTime: &outOfTime},
+		},
+		"received today": {
+			receivedDate:    "10/05/2024",
+			noticeGivenDate: "2024-05-01",
+			expected:        ObjectionDeadline{WorkingDaysOpen: 0, Deadline: "2024-05-28", InTime: &inTime},
+		},
+		"no received date": {
+			noticeGivenDate: "2024-05-01",
+			expected:        ObjectionDeadline{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			deadline := NewObjectionDeadline(Objection{ReceivedDate: tc.receivedDate}, tc.noticeGivenDate, today, bankHolidays)
+
+			assert.Equal(t, tc.expected, deadline)
+		})
+	}
+}
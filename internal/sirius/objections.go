@@ -11,6 +11,7 @@ type Objection struct {
 	ReceivedDate  string                `json:"receivedDate"`
 	LpaUids       []string              `json:"lpaUids"`
 	Resolutions   []ObjectionResolution `json:"objectionLpas"`
+	Deadline      ObjectionDeadline     `json:"-"`
 }
 
 type ObjectionResolution struct {
@@ -61,3 +62,11 @@ func (c *Client) GetObjection(ctx Context, Id string) (Objection, error) {
 func (c *Client) ResolveObjection(ctx Context, objectionId string, lpaUid string, resolutionDetails ResolutionRequest) error {
 	return c.put(ctx, fmt.Sprintf("/lpa-api/v1/objections/%s/resolution/%s", objectionId, lpaUid), resolutionDetails, nil)
 }
+
+func (c *Client) UnresolvedObjections(ctx Context) ([]Objection, error) {
+	var objectionList []Objection
+
+	err := c.get(ctx, "/lpa-api/v1/objections?filter=resolved:false", &objectionList)
+
+	return objectionList, err
+}
@@ -444,3 +444,75 @@ func TestResolveObjection(t *testing.T) {
 		})
 	}
 }
+
+func TestUnresolvedObjections(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		setup            func()
+		expectedResponse []Objection
+		expectedError    func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("There is an unresolved objection").
+					UponReceiving("A request for unresolved objections").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodGet,
+						Path:   matchers.String("/lpa-api/v1/objections"),
+						Query: matchers.MapMatcher{
+							"filter": matchers.String("resolved:false"),
+						},
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusOK,
+						Body: matchers.EachLike(map[string]interface{}{
+							"id":            matchers.Like(105),
+							"notes":         matchers.String("Test"),
+							"objectionType": matchers.String("factual"),
+							"receivedDate":  matchers.String("2024-09-05"),
+							"lpaUids":       []string{"M-9999-9999-9999"},
+						}, 1),
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
+					})
+			},
+			expectedResponse: []Objection{
+				{
+					ID:            105,
+					Notes:         "Test",
+					ObjectionType: "factual",
+					ReceivedDate:  "2024-09-05",
+					LpaUids:       []string{"M-9999-9999-9999"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				objectionList, err := client.UnresolvedObjections(Context{Context: context.Background()})
+
+				assert.Equal(t, tc.expectedResponse, objectionList)
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+
+				return nil
+			}))
+		})
+	}
+}
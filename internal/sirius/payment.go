@@ -2,7 +2,8 @@ package sirius
 
 import (
 	"fmt"
-	"regexp"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 )
 
 type PaymentReference struct {
@@ -11,22 +12,24 @@ type PaymentReference struct {
 }
 
 type Payment struct {
-	ID               int                `json:"id,omitempty"`
-	Source           string             `json:"source,omitempty"`
-	Amount           int                `json:"amount,omitempty"`
-	PaymentDate      DateString         `json:"paymentDate,omitempty"`
-	PaymentEvidence  string             `json:"paymentEvidence,omitempty"`
-	FeeReductionType string             `json:"feeReductionType,omitempty"`
-	Case             *Case              `json:"case,omitempty"`
-	Locked           bool               `json:"locked,omitempty"`
-	References       []PaymentReference `json:"references,omitempty"`
+	ID               int                 `json:"id,omitempty"`
+	Source           string              `json:"source,omitempty"`
+	Amount           shared.Money        `json:"amount,omitempty"`
+	PaymentDate      DateString          `json:"paymentDate,omitempty"`
+	PaymentEvidence  string              `json:"paymentEvidence,omitempty"`
+	FeeReductionType string              `json:"feeReductionType,omitempty"`
+	Case             *Case               `json:"case,omitempty"`
+	Locked           bool                `json:"locked,omitempty"`
+	References       []PaymentReference  `json:"references,omitempty"`
+	RefundReason     string              `json:"refundReason,omitempty"`
+	RefundStatus     shared.RefundStatus `json:"refundStatus,omitempty"`
 }
 
-func (c *Client) AddPayment(ctx Context, caseID int, amount int, source string, paymentDate DateString) error {
+func (c *Client) AddPayment(ctx Context, caseID int, amount shared.Money, source string, paymentDate DateString) error {
 	data := struct {
-		Amount      int        `json:"amount"`
-		Source      string     `json:"source"`
-		PaymentDate DateString `json:"paymentDate"`
+		Amount      shared.Money `json:"amount"`
+		Source      string       `json:"source"`
+		PaymentDate DateString   `json:"paymentDate"`
 	}{
 		Amount:      amount,
 		Source:      source,
@@ -65,19 +68,3 @@ func (c *Client) PaymentByID(ctx Context, id int) (Payment, error) {
 
 	return p, err
 }
-
-func PoundsToPence(pounds float64) int {
-	return int(pounds * 100)
-}
-
-func PenceToPounds(pence int) float64 {
-	return float64(pence) / 100
-}
-
-func IsAmountValid(amount string) bool {
-	m, err := regexp.Match(`^\d+\.\d{2}$`, []byte(amount))
-	if err != nil {
-		return false
-	}
-	return m
-}
@@ -0,0 +1,139 @@
+package sirius
+
+import (
+	"sort"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+)
+
+const (
+	LedgerReductionWithFullFeePaid  = "A fee reduction has been applied but the full fee has also been paid"
+	LedgerReductionExceedsFee       = "Fee reductions are more than the expected fee"
+	LedgerRefundsExceedPaid         = "Refunds are more than the total paid"
+	LedgerRefundWhileAmountIsDue    = "A refund has been made while an amount is still due"
+	LedgerPaymentWithoutExpectedFee = "Payments have been recorded but no fee is expected"
+)
+
+// PaymentLedgerEntry is a payment, fee reduction or refund along with the
+// amount left to pay once it has been applied
+type PaymentLedgerEntry struct {
+	Payment Payment
+	Balance shared.Money
+}
+
+type PaymentSourceTotal struct {
+	Source string
+	Total  shared.Money
+}
+
+// PaymentLedger summarises the payments on a case against the fee expected for
+// it. A positive Balance is an amount due, a negative Balance is an overpayment.
+// Refunds only count towards the balance once they have been issued.
+type PaymentLedger struct {
+	ExpectedTotal   shared.Money
+	Entries         []PaymentLedgerEntry
+	Sources         []PaymentSourceTotal
+	Payments        []Payment
+	FeeReductions   []Payment
+	Refunds         []Payment
+	TotalPaid       shared.Money
+	TotalReductions shared.Money
+	TotalRefunds    shared.Money
+	PendingRefunds  shared.Money
+	Balance         shared.Money
+	Inconsistencies []string
+}
+
+func NewPaymentLedger(expectedTotal shared.Money, payments []Payment) PaymentLedger {
+	ledger := PaymentLedger{
+		ExpectedTotal: expectedTotal,
+		Balance:       expectedTotal,
+	}
+
+	sorted := make([]Payment, len(payments))
+	copy(sorted, payments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].PaymentDate, sorted[j].PaymentDate
+		if a == "" || b == "" {
+			return a != "" && b == ""
+		}
+		return a < b
+	})
+
+	sourceIndex := map[string]int{}
+
+	for _, p := range sorted {
+		switch {
+		case p.Amount < 0 && !p.RefundStatus.IsIssued():
+			ledger.Refunds = append(ledger.Refunds, p)
+			ledger.PendingRefunds += p.Amount.Abs()
+			continue
+		case p.Amount < 0:
+			ledger.Refunds = append(ledger.Refunds, p)
+			ledger.TotalRefunds += p.Amount.Abs()
+		case p.Source == FeeReductionSource:
+			ledger.FeeReductions = append(ledger.FeeReductions, p)
+			ledger.TotalReductions += p.Amount
+		default:
+			ledger.Payments = append(ledger.Payments, p)
+			ledger.TotalPaid += p.Amount
+		}
+
+		ledger.Balance -= p.Amount
+		ledger.Entries = append(ledger.Entries, PaymentLedgerEntry{Payment: p, Balance: ledger.Balance})
+
+		i, ok := sourceIndex[p.Source]
+		if !ok {
+			i = len(ledger.Sources)
+			sourceIndex[p.Source] = i
+			ledger.Sources = append(ledger.Sources, PaymentSourceTotal{Source: p.Source})
+		}
+		ledger.Sources[i].Total += p.Amount
+	}
+
+	if ledger.TotalReductions > 0 && ledger.ExpectedTotal > 0 && ledger.TotalPaid >= ledger.ExpectedTotal {
+		ledger.Inconsistencies = append(ledger.Inconsistencies, LedgerReductionWithFullFeePaid)
+	}
+	if ledger.TotalReductions > ledger.ExpectedTotal {
+		ledger.Inconsistencies = append(ledger.Inconsistencies, LedgerReductionExceedsFee)
+	}
+	if ledger.TotalRefunds > ledger.TotalPaid {
+		ledger.Inconsistencies = append(ledger.Inconsistencies, LedgerRefundsExceedPaid)
+	}
+	if ledger.TotalRefunds > 0 && ledger.Balance > 0 {
+		ledger.Inconsistencies = append(ledger.Inconsistencies, LedgerRefundWhileAmountIsDue)
+	}
+	if ledger.ExpectedTotal == 0 && ledger.TotalPaid > 0 {
+		ledger.Inconsistencies = append(ledger.Inconsistencies, LedgerPaymentWithoutExpectedFee)
+	}
+
+	return ledger
+}
+
+// AmountDue is the amount still to be paid, or zero when the fee is covered
+func (l PaymentLedger) AmountDue() shared.Money {
+	if l.Balance > 0 {
+		return l.Balance
+	}
+
+	return 0
+}
+
+// Overpaid is the amount paid over the expected fee, which is due as a refund
+func (l PaymentLedger) Overpaid() shared.Money {
+	if l.Balance < 0 {
+		return l.Balance.Abs()
+	}
+
+	return 0
+}
+
+// RefundableAmount is the overpayment that has not already been requested as a
+// refund
+func (l PaymentLedger) RefundableAmount() shared.Money {
+	if l.Overpaid() > l.PendingRefunds {
+		return l.Overpaid() - l.PendingRefunds
+	}
+
+	return 0
+}
@@ -0,0 +1,167 @@
+package sirius
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaymentLedger(t *testing.T) {
+	testCases := map[string]struct {
+		expectedTotal   shared.Money
+		payments        []Payment
+		totalPaid       shared.Money
+		totalReductions shared.Money
+		totalRefunds    shared.Money
+		pendingRefunds  shared.Money
+		refundable      shared.Money
+		amountDue       shared.Money
+		overpaid        shared.Money
+		inconsistencies []string
+	}{
+		"no payments": {
+			expectedTotal: 8200,
+			amountDue:     8200,
+		},
+		"underpaid": {
+			expectedTotal: 8200,
+			payments:      []Payment{{ID: 2, Amount: 4100}, {ID: 3, Amount: 1500}},
+			totalPaid:     5600,
+			amountDue:     2600,
+		},
+		"paid in full": {
+			expectedTotal: 8200,
+			payments:      []Payment{{ID: 2, Amount: 8200}},
+			totalPaid:     8200,
+		},
+		"overpaid": {
+			expectedTotal: 8200,
+			payments:      []Payment{{ID: 2, Amount: 8200}, {ID: 3, Amount: 1000}},
+			totalPaid:     9200,
+			overpaid:      1000,
+			refundable:    1000,
+		},
+		"refund requested": {
+			expectedTotal:  8200,
+			payments:       []Payment{{ID: 2, Amount: 9200}, {ID: 3, Amount: -600, RefundStatus: shared.RefundStatusRequested}},
+			totalPaid:      9200,
+			pendingRefunds: 600,
+			overpaid:       1000,
+			refundable:     400,
+		},
+		"refund approved": {
+			expectedTotal:  8200,
+			payments:       []Payment{{ID: 2, Amount: 9200}, {ID: 3, Amount: -1000, RefundStatus: shared.RefundStatusApproved}},
+			totalPaid:      9200,
+			pendingRefunds: 1000,
+			overpaid:       1000,
+		},
+		"refund issued": {
+			expectedTotal: 8200,
+			payments:      []Payment{{ID: 2, Amount: 9200}, {ID: 3, Amount: -1000, RefundStatus: shared.RefundStatusIssued}},
+			totalPaid:     9200,
+			totalRefunds:  1000,
+		},
+		"reduction covers remainder": {
+			expectedTotal:   8200,
+			payments:        []Payment{{ID: 2, Amount: 4100}, {ID: 3, Amount: 4100, Source: FeeReductionSource}},
+			totalPaid:       4100,
+			totalReductions: 4100,
+		},
+		"refund after overpayment": {
+			expectedTotal: 8200,
+			payments:      []Payment{{ID: 2, Amount: 9200}, {ID: 3, Amount: -1000}},
+			totalPaid:     9200,
+			totalRefunds:  1000,
+		},
+		"reduction with full fee paid": {
+			expectedTotal:   8200,
+			payments:        []Payment{{ID: 2, Amount: 8200}, {ID: 3, Amount: 8200, Source: FeeReductionSource}},
+			totalPaid:       8200,
+			totalReductions: 8200,
+			overpaid:        8200,
+			refundable:      8200,
+			inconsistencies: []string{LedgerReductionWithFullFeePaid},
+		},
+		"reduction more than fee": {
+			expectedTotal:   4100,
+			payments:        []Payment{{ID: 3, Amount: 8200, Source: FeeReductionSource}},
+			totalReductions: 8200,
+			overpaid:        4100,
+			refundable:      4100,
+			inconsistencies: []string{LedgerReductionExceedsFee},
+		},
+		"refund while amount due": {
+			expectedTotal:   8200,
+			payments:        []Payment{{ID: 2, Amount: 4100}, {ID: 3, Amount: 1500}, {ID: 4, Amount: -4100}},
+			totalPaid:       5600,
+			totalRefunds:    4100,
+			amountDue:       6700,
+			inconsistencies: []string{LedgerRefundWhileAmountIsDue},
+		},
+		"refund more than paid": {
+			expectedTotal:   8200,
+			payments:        []Payment{{ID: 2, Amount: 1000}, {ID: 3, Amount: -2000}},
+			totalPaid:       1000,
+			totalRefunds:    2000,
+			amountDue:       9200,
+			inconsistencies: []string{LedgerRefundsExceedPaid, LedgerRefundWhileAmountIsDue},
+		},
+		"payment without expected fee": {
+			payments:        []Payment{{ID: 2, Amount: 1000}},
+			totalPaid:       1000,
+			overpaid:        1000,
+			refundable:      1000,
+			inconsistencies: []string{LedgerPaymentWithoutExpectedFee},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ledger := NewPaymentLedger(tc.expectedTotal, tc.payments)
+
+			assert.Equal(t, tc.expectedTotal, ledger.ExpectedTotal)
+			assert.Equal(t, tc.totalPaid, ledger.TotalPaid)
+			assert.Equal(t, tc.totalReductions, ledger.TotalReductions)
+			assert.Equal(t, tc.totalRefunds, ledger.TotalRefunds)
+			assert.Equal(t, tc.pendingRefunds, ledger.PendingRefunds)
+			assert.Equal(t, tc.refundable, ledger.RefundableAmount())
+			assert.Equal(t, tc.amountDue, ledger.AmountDue())
+			assert.Equal(t, tc.overpaid, ledger.Overpaid())
+			assert.Equal(t, tc.inconsistencies, ledger.Inconsistencies)
+		})
+	}
+}
+
+func TestNewPaymentLedgerEntries(t *testing.T) {
+	payments := []Payment{
+		{ID: 1, Amount: 4100, Source: "PHONE", PaymentDate: "2022-03-01"},
+		{ID: 2, Amount: -500, Source: "PHONE", PaymentDate: "2022-04-01"},
+		{ID: 3, Amount: 2000, Source: FeeReductionSource, PaymentDate: "2022-02-01"},
+		{ID: 4, Amount: 1000, Source: "CHEQUE"},
+		{ID: 5, Amount: 1600, Source: "CHEQUE", PaymentDate: "2022-03-15"},
+		{ID: 6, Amount: -300, Source: "CHEQUE", PaymentDate: "2022-04-02", RefundStatus: shared.RefundStatusRequested},
+	}
+
+	ledger := NewPaymentLedger(8200, payments)
+
+	assert.Equal(t, []PaymentLedgerEntry{
+		{Payment: payments[2], Balance: 6200},
+		{Payment: payments[0], Balance: 2100},
+		{Payment: payments[4], Balance: 500},
+		{Payment: payments[1], Balance: 1000},
+		{Payment: payments[3], Balance: 0},
+	}, ledger.Entries)
+
+	assert.Equal(t, []PaymentSourceTotal{
+		{Source: FeeReductionSource, Total: 2000},
+		{Source: "PHONE", Total: 3600},
+		{Source: "CHEQUE", Total: 2600},
+	}, ledger.Sources)
+
+	assert.Equal(t, []Payment{payments[0], payments[4], payments[3]}, ledger.Payments)
+	assert.Equal(t, []Payment{payments[2]}, ledger.FeeReductions)
+	assert.Equal(t, []Payment{payments[1], payments[5]}, ledger.Refunds)
+	assert.Equal(t, shared.Money(300), ledger.PendingRefunds)
+}
@@ -0,0 +1,30 @@
+package sirius
+
+import (
+	"fmt"
+
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
+)
+
+// Refund is a request to pay money back on a case. The amount is given as a
+// positive number, Sirius records it against the case as a negative payment.
+type Refund struct {
+	Amount     shared.Money `json:"amount"`
+	Source     string       `json:"source"`
+	RefundDate DateString   `json:"refundDate"`
+	Reason     string       `json:"reason"`
+}
+
+func (c *Client) AddRefund(ctx Context, caseID int, refund Refund) error {
+	return c.post(ctx, fmt.Sprintf("/lpa-api/v1/cases/%d/refunds", caseID), refund, nil)
+}
+
+func (c *Client) EditRefundStatus(ctx Context, paymentID int, status shared.RefundStatus) error {
+	data := struct {
+		Status shared.RefundStatus `json:"status"`
+	}{
+		Status: status,
+	}
+
+	return c.put(ctx, fmt.Sprintf("/lpa-api/v1/refunds/%d/status", paymentID), data, nil)
+}
@@ -0,0 +1,80 @@
+package sirius
+
+import (
+	"time"
+)
+
+// FollowUpTrigger is an action on a case that can be followed by a task
+type FollowUpTrigger string
+
+const (
+	FollowUpObjectionAdded      FollowUpTrigger = "objection-added"
+	FollowUpInvestigationOnHold FollowUpTrigger = "investigation-on-hold"
+	FollowUpFeeReductionApplied FollowUpTrigger = "fee-reduction-applied"
+)
+
+func (t FollowUpTrigger) Translation() string {
+	switch t {
+	case FollowUpObjectionAdded:
+		return "adding an objection"
+	case FollowUpInvestigationOnHold:
+		return "placing an investigation on hold"
+	case FollowUpFeeReductionApplied:
+		return "applying a fee reduction"
+	default:
+		return ""
+	}
+}
+
+// TaskTemplate is a reusable starting point for a task. When Trigger is set
+// the template is offered as a follow-up to that action.
+type TaskTemplate struct {
+	ID               int             `json:"id"`
+	Name             string          `json:"name"`
+	Type             string          `json:"type"`
+	Description      string          `json:"description"`
+	DueInWorkingDays int             `json:"dueInWorkingDays"`
+	TeamID           int             `json:"teamId,omitempty"`
+	Trigger          FollowUpTrigger `json:"trigger,omitempty"`
+}
+
+// Task fills in a task from the template, due the given number of working
+// days after today
+func (t TaskTemplate) Task(today time.Time, bankHolidays BankHolidays) TaskRequest {
+	return TaskRequest{
+		AssigneeID:  t.TeamID,
+		Type:        t.Type,
+		Name:        t.Name,
+		Description: t.Description,
+		DueDate:     DateString(bankHolidays.AddWorkingDays(today, t.DueInWorkingDays).Format("2006-01-02")),
+	}
+}
+
+func (c *Client) TaskTemplates(ctx Context) ([]TaskTemplate, error) {
+	var v []TaskTemplate
+	err := c.get(ctx, "/lpa-api/v1/task-templates", &v)
+
+	return v, err
+}
+
+// FindTaskTemplate returns the template with the given ID
+func FindTaskTemplate(templates []TaskTemplate, id int) (TaskTemplate, bool) {
+	for _, template := range templates {
+		if template.ID == id {
+			return template, true
+		}
+	}
+
+	return TaskTemplate{}, false
+}
+
+// FollowUpTaskTemplate returns the first template to offer after the action
+func FollowUpTaskTemplate(templates []TaskTemplate, trigger FollowUpTrigger) (TaskTemplate, bool) {
+	for _, template := range templates {
+		if template.Trigger == trigger {
+			return template, true
+		}
+	}
+
+	return TaskTemplate{}, false
+}
@@ -0,0 +1,130 @@
+package sirius
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pact-foundation/pact-go/v2/consumer"
+	"github.com/pact-foundation/pact-go/v2/matchers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskTemplates(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		setup            func()
+		expectedResponse []TaskTemplate
+		expectedError    func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("Some task templates exist").
+					UponReceiving("A request for task templates").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodGet,
+						Path:   matchers.String("/lpa-api/v1/task-templates"),
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusOK,
+						Body: matchers.EachLike(map[string]interface{}{
+							"id":               matchers.Like(4),
+							"name":             matchers.String("Review objection"),
+							"type":             matchers.String("Review"),
+							"description":      matchers.String("Check the objection evidence"),
+							"dueInWorkingDays": matchers.Like(5),
+							"teamId":           matchers.Like(66),
+							"trigger":          matchers.String("objection-added"),
+						}, 1),
+						Headers: matchers.MapMatcher{"Content-Type": matchers.String("application/json")},
+					})
+			},
+			expectedResponse: []TaskTemplate{
+				{
+					ID:               4,
+					Name:             "Review objection",
+					Type:             "Review",
+					Description:      "Check the objection evidence",
+					DueInWorkingDays: 5,
+					TeamID:           66,
+					Trigger:          FollowUpObjectionAdded,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				templates, err := client.TaskTemplates(Context{Context: context.Background()})
+
+				assert.Equal(t, tc.expectedResponse, templates)
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+				return nil
+			}))
+		})
+	}
+}
+
+func TestTaskTemplateTask(t *testing.T) {
+	template := TaskTemplate{
+		ID:               4,
+		Name:             "Review objection",
+		Type:             "Review",
+		Description:      "Check the objection evidence",
+		DueInWorkingDays: 3,
+		TeamID:           66,
+	}
+
+	// Friday, with the Monday a bank holiday
+	today := time.Date(2024, time.May, 3, 14, 0, 0, 0, time.UTC)
+	bankHolidays := BankHolidays{"england-and-wales": {"Early May bank holiday": "2024-05-06T00:00:00+00:00"}}
+
+	assert.Equal(t, TaskRequest{
+		AssigneeID:  66,
+		Type:        "Review",
+		Name:        "Review objection",
+		Description: "Check the objection evidence",
+		DueDate:     "2024-05-09",
+	}, template.Task(today, bankHolidays))
+}
+
+func TestFindTaskTemplates(t *testing.T) {
+	templates := []TaskTemplate{
+		{ID: 1, Name: "Chase evidence"},
+		{ID: 2, Name: "Review objection", Trigger: FollowUpObjectionAdded},
+		{ID: 3, Name: "Second review", Trigger: FollowUpObjectionAdded},
+	}
+
+	template, ok := FindTaskTemplate(templates, 3)
+	assert.True(t, ok)
+	assert.Equal(t, "Second review", template.Name)
+
+	_, ok = FindTaskTemplate(templates, 9)
+	assert.False(t, ok)
+
+	template, ok = FollowUpTaskTemplate(templates, FollowUpObjectionAdded)
+	assert.True(t, ok)
+	assert.Equal(t, 2, template.ID)
+
+	_, ok = FollowUpTaskTemplate(templates, FollowUpFeeReductionApplied)
+	assert.False(t, ok)
+}
@@ -7,12 +7,47 @@ import (
 	"time"
 )
 
+const (
+	WarningSeverityStandard = "standard"
+	WarningSeverityHigh     = "high"
+)
+
 type Warning struct {
-	ID          int    `json:"id"`
-	DateAdded   string `json:"dateAdded"`
-	WarningType string `json:"warningType"`
-	WarningText string `json:"warningText"`
-	CaseItems   []Case `json:"caseItems"`
+	ID          int        `json:"id"`
+	DateAdded   string     `json:"dateAdded"`
+	WarningType string     `json:"warningType"`
+	WarningText string     `json:"warningText"`
+	CaseItems   []Case     `json:"caseItems"`
+	Severity    string     `json:"severity,omitempty"`
+	ReviewDate  DateString `json:"reviewDate,omitempty"`
+	ExpiryDate  DateString `json:"expiryDate,omitempty"`
+	Expired     bool       `json:"-"`
+	ReviewDue   bool       `json:"-"`
+}
+
+// IsExpired is true from the warning's expiry date onwards
+func (w Warning) IsExpired(today time.Time) bool {
+	return onOrBefore(w.ExpiryDate, today)
+}
+
+// IsReviewDue is true from the warning's review date onwards, until it expires
+func (w Warning) IsReviewDue(today time.Time) bool {
+	return onOrBefore(w.ReviewDate, today) && !w.IsExpired(today)
+}
+
+// NeedsAcknowledgement is true for a high severity warning that is still in
+// place, which must be read each time the case is changed
+func (w Warning) NeedsAcknowledgement() bool {
+	return w.Severity == WarningSeverityHigh && !w.Expired
+}
+
+func onOrBefore(date DateString, today time.Time) bool {
+	t, err := date.Time()
+	if err != nil {
+		return false
+	}
+
+	return !t.After(today)
 }
 
 func (c *Client) WarningsForCase(ctx Context, caseId int) ([]Warning, error) {
@@ -24,11 +59,31 @@ func (c *Client) WarningsForCase(ctx Context, caseId int) ([]Warning, error) {
 		return nil, err
 	}
 
+	today := time.Now()
+	for i, warning := range warningList {
+		warningList[i].Expired = warning.IsExpired(today)
+		warningList[i].ReviewDue = warning.IsReviewDue(today)
+	}
+
 	return sortWarningsForCaseSummary(warningList), nil
 }
 
+type acknowledgeWarningRequest struct {
+	Action string `json:"action"`
+}
+
+// AcknowledgeWarning records that the current user read a warning before
+// taking an action on the case
+func (c *Client) AcknowledgeWarning(ctx Context, warningID int, action string) error {
+	return c.post(ctx, fmt.Sprintf("/lpa-api/v1/warnings/%d/acknowledgements", warningID), acknowledgeWarningRequest{Action: action}, nil)
+}
+
 func sortWarningsForCaseSummary(warnings []Warning) []Warning {
 	sort.Slice(warnings, func(i, j int) bool {
+		if warnings[i].Expired != warnings[j].Expired {
+			return warnings[j].Expired
+		}
+
 		if warnings[i].WarningType == "Donor Deceased" {
 			return true
 		} else if warnings[j].WarningType == "Donor Deceased" {
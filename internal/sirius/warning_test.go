@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/pact-foundation/pact-go/v2/consumer"
 	"github.com/pact-foundation/pact-go/v2/matchers"
@@ -208,3 +209,115 @@ func TestSortWarningsForCaseSummary(t *testing.T) {
 	val := sortWarningsForCaseSummary(warnings)
 	assert.Equal(t, expected, val)
 }
+
+func TestSortWarningsForCaseSummaryPutsExpiredLast(t *testing.T) {
+	warnings := []Warning{
+		{WarningType: "Donor Deceased", DateAdded: "01/01/2020 00:02:03", Expired: true},
+		{WarningType: "Safeguarding", DateAdded: "20/02/2016 00:02:03", Expired: true},
+		{WarningType: "Welsh Language", DateAdded: "11/07/2012 11:02:03"},
+	}
+
+	expected := []Warning{
+		{WarningType: "Welsh Language", DateAdded: "11/07/2012 11:02:03"},
+		{WarningType: "Donor Deceased", DateAdded: "01/01/2020 00:02:03", Expired: true},
+		{WarningType: "Safeguarding", DateAdded: "20/02/2016 00:02:03", Expired: true},
+	}
+
+	assert.Equal(t, expected, sortWarningsForCaseSummary(warnings))
+}
+
+func TestWarningExpiryAndReview(t *testing.T) {
+	today := time.Date(2024, time.June, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		warning   Warning
+		expired   bool
+		reviewDue bool
+	}{
+		"permanent":         {warning: Warning{}},
+		"expires later":     {warning: Warning{ExpiryDate: "2024-06-02"}},
+		"expires today":     {warning: Warning{ExpiryDate: "2024-06-01"}, expired: true},
+		"expired":           {warning: Warning{ExpiryDate: "2024-01-01", ReviewDate: "2023-12-01"}, expired: true},
+		"review later":      {warning: Warning{ReviewDate: "2024-07-01"}},
+		"review due":        {warning: Warning{ReviewDate: "2024-06-01", ExpiryDate: "2024-12-01"}, reviewDue: true},
+		"unreadable expiry": {warning: Warning{ExpiryDate: "soon"}},
+		"unreadable review": {warning: Warning{ReviewDate: "soon"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expired, tc.warning.IsExpired(today))
+			assert.Equal(t, tc.reviewDue, tc.warning.IsReviewDue(today))
+		})
+	}
+}
+
+func TestWarningsToAcknowledge(t *testing.T) {
+	cs := CaseSummary{
+		WarningList: []Warning{
+			{ID: 1, Severity: WarningSeverityHigh},
+			{ID: 2, Severity: WarningSeverityHigh, Expired: true},
+			{ID: 3, Severity: WarningSeverityStandard},
+			{ID: 4},
+			{ID: 5, Severity: WarningSeverityHigh},
+		},
+	}
+
+	assert.Equal(t, []Warning{{ID: 1, Severity: WarningSeverityHigh}, {ID: 5, Severity: WarningSeverityHigh}}, cs.WarningsToAcknowledge())
+	assert.Nil(t, CaseSummary{}.WarningsToAcknowledge())
+}
+
+func TestAcknowledgeWarning(t *testing.T) {
+	t.Parallel()
+
+	pact, err := newPact()
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		setup         func()
+		expectedError func(int) error
+	}{
+		{
+			name: "OK",
+			setup: func() {
+				pact.
+					AddInteraction().
+					Given("I have a case with a high severity warning").
+					UponReceiving("A request to acknowledge a warning").
+					WithCompleteRequest(consumer.Request{
+						Method: http.MethodPost,
+						Path:   matchers.String("/lpa-api/v1/warnings/9901/acknowledgements"),
+						Headers: matchers.MapMatcher{
+							"Content-Type": matchers.String("application/json"),
+						},
+						Body: map[string]interface{}{
+							"action": "change-case-status",
+						},
+					}).
+					WithCompleteResponse(consumer.Response{
+						Status: http.StatusCreated,
+					})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			assert.Nil(t, pact.ExecuteTest(t, func(config consumer.MockServerConfig) error {
+				client := NewClient(http.DefaultClient, fmt.Sprintf("http://127.0.0.1:%d", config.Port))
+
+				err := client.AcknowledgeWarning(Context{Context: context.Background()}, 9901, "change-case-status")
+				if tc.expectedError == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.Equal(t, tc.expectedError(config.Port), err)
+				}
+
+				return nil
+			}))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package templatefn
 import (
 	"fmt"
 	"html/template"
+	"math"
 	"net/url"
 	"reflect"
 	"sort"
@@ -12,6 +13,7 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/localize"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/server"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"golang.org/x/text/cases"
@@ -21,7 +23,25 @@ import (
 )
 
 func All(siriusPublicURL, prefix, staticHash string) map[string]interface{} {
+	return ForLanguage(localize.English, siriusPublicURL, prefix, staticHash)
+}
+
+// ForLanguage returns the template functions with any user facing labels
+// translated into lang
+func ForLanguage(lang language.Tag, siriusPublicURL, prefix, staticHash string) map[string]interface{} {
+	printer := localize.NewPrinter(lang)
+
 	return map[string]interface{}{
+		"t": func(key string, args ...interface{}) string {
+			if len(args) == 0 {
+				return localize.Translate(lang, key)
+			}
+
+			return printer.Sprintf(key, args...)
+		},
+		"lang": func() string {
+			return localize.Code(lang)
+		},
 		"sirius": func(s string) string {
 			return siriusPublicURL + s
 		},
@@ -55,7 +75,11 @@ func All(siriusPublicURL, prefix, staticHash string) map[string]interface{} {
 		"caseTabs":              caseTab,
 		"casesWarningAppliedTo": sirius.CasesWarningAppliedTo,
 		"fee":                   shared.FormatMonetaryValue,
+		"money":                 shared.Money.String,
 		"feeFromFloat":          shared.FormatMonetaryFloat,
+		"refundFromFloat": func(amount float64) string {
+			return shared.FormatMonetaryFloat(math.Abs(amount))
+		},
 		"formatDate": func(s sirius.DateString) (string, error) {
 			if s != "" {
 				return s.ToSirius()
@@ -172,6 +196,10 @@ func All(siriusPublicURL, prefix, staticHash string) map[string]interface{} {
 			//Fixes extra newline appearing in text editor due to newline present between the doctype and html tags
 			return strings.ReplaceAll(content, "<!DOCTYPE html>\n<html lang=\"en\">", "<!DOCTYPE html><html lang=\"en\">")
 		},
+		// notes can contain simple formatting, anything else is removed
+		"richText": func(content string) template.HTML {
+			return template.HTML(shared.SanitiseRichText(content)) //#nosec G203 -- sanitised to a small set of elements
+		},
 		"abs": func(num int) int {
 			if num < 0 {
 				return -num
@@ -193,70 +221,70 @@ func All(siriusPublicURL, prefix, staticHash string) map[string]interface{} {
 		"progressIndicatorContext": func(s string) string {
 			switch s {
 			case "FEES":
-				return "Fees"
+				return printer.Sprintf("Fees")
 			case "DONOR":
-				return "Donor section"
+				return printer.Sprintf("Donor section")
 			case "DONOR_ID":
-				return "Donor identity confirmation"
+				return printer.Sprintf("Donor identity confirmation")
 			case "CERTIFICATE_PROVIDER_ID":
-				return "Certificate provider identity confirmation"
+				return printer.Sprintf("Certificate provider identity confirmation")
 			case "CERTIFICATE_PROVIDER_SIGNATURE":
-				return "Certificate provider certificate"
+				return printer.Sprintf("Certificate provider certificate")
 			case "ATTORNEY_SIGNATURES":
-				return "Attorney signatures"
+				return printer.Sprintf("Attorney signatures")
 			case "PREREGISTRATION_NOTICES":
-				return "Pre-registration notices"
+				return printer.Sprintf("Pre-registration notices")
 			case "REGISTRATION_NOTICES":
-				return "Registration notices"
+				return printer.Sprintf("Registration notices")
 			case "RESTRICTIONS_AND_CONDITIONS":
-				return "Restrictions and conditions"
+				return printer.Sprintf("Restrictions and conditions")
 			case "":
-				return "Not specified"
+				return printer.Sprintf("Not specified")
 			default:
-				return "indicator NOT RECOGNISED: " + s
+				return printer.Sprintf("indicator NOT RECOGNISED: %s", s)
 			}
 		},
 		// translate progress indicator status for application progress page
 		"progressIndicatorStatus": func(s string) string {
 			switch s {
 			case "IN_PROGRESS":
-				return "In progress"
+				return printer.Sprintf("In progress")
 			case "COMPLETE":
-				return "Complete"
+				return printer.Sprintf("Complete")
 			case "CANNOT_START":
-				return "Not started"
+				return printer.Sprintf("Not started")
 			case "":
-				return "Not specified"
+				return printer.Sprintf("Not specified")
 			default:
-				return "status NOT RECOGNISED: " + s
+				return printer.Sprintf("status NOT RECOGNISED: %s", s)
 			}
 		},
 		// translate objection type for confirm objection page
 		"objectionType": func(s string) string {
 			switch s {
 			case "factual":
-				return "Factual"
+				return printer.Sprintf("Factual")
 			case "prescribed":
-				return "Prescribed"
+				return printer.Sprintf("Prescribed")
 			case "thirdParty":
-				return "Third Party"
+				return printer.Sprintf("Third Party")
 			case "":
-				return "Not specified"
+				return printer.Sprintf("Not specified")
 			default:
-				return "objection type NOT RECOGNISED: " + s
+				return printer.Sprintf("objection type NOT RECOGNISED: %s", s)
 			}
 		},
 		// translate resolution outcome for case summary page
 		"resolutionOutcome": func(s string) string {
 			switch s {
 			case "upheld":
-				return "upheld"
+				return printer.Sprintf("upheld")
 			case "notUpheld":
-				return "not upheld"
+				return printer.Sprintf("not upheld")
 			case "":
-				return "Not specified"
+				return printer.Sprintf("Not specified")
 			default:
-				return "resolution outcome NOT RECOGNISED: " + s
+				return printer.Sprintf("resolution outcome NOT RECOGNISED: %s", s)
 			}
 		},
 		"caseLabel": func(s string) string {
@@ -303,6 +331,10 @@ func All(siriusPublicURL, prefix, staticHash string) map[string]interface{} {
 		"complaintProperty":          shared.TranslateComplaintProperty,
 		"translateNumberEventValue":  translateNumberEventValue,
 		"investigationEventProperty": shared.TranslateInvestigationEventProperty,
+		"refundStatus": func(value any) string {
+			s, _ := value.(string)
+			return shared.RefundStatus(s).Translation()
+		},
 		"eventWithContext": func(event sirius.LpaEvent, values ...any) LpaEventWithContext {
 			context := EventContext{}
 			for i := 0; i+1 < len(values); i += 2 {
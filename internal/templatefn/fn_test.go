@@ -1,10 +1,12 @@
 package templatefn
 
 import (
+	"html/template"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/localize"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/server"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/shared"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
@@ -149,6 +151,78 @@ func TestResolutionOutcome(t *testing.T) {
 	testStringMapper(t, "resolutionOutcome", expectations)
 }
 
+func TestT(t *testing.T) {
+	fn := All("", "", "")["t"].(func(string, ...interface{}) string)
+	assert.Equal(t, "Objection - upheld", fn("Objection - %s", "upheld"))
+	assert.Equal(t, "Reduced by 50%", fn("Reduced by 50%"))
+
+	fn = ForLanguage(localize.Welsh, "", "", "")["t"].(func(string, ...interface{}) string)
+	assert.Equal(t, "Gwrthwynebiad - cadarnhawyd", fn("Objection - %s", "cadarnhawyd"))
+	assert.Equal(t, "Not in the catalogue", fn("Not in the catalogue"))
+	assert.Equal(t, "Ffeithiol", fn("Factual"))
+	assert.Equal(t, "Reduced by 50%", fn("Reduced by 50%"))
+}
+
+func TestLang(t *testing.T) {
+	assert.Equal(t, "en", All("", "", "")["lang"].(func() string)())
+	assert.Equal(t, "cy", ForLanguage(localize.Welsh, "", "", "")["lang"].(func() string)())
+}
+
+func TestWelshLabels(t *testing.T) {
+	fns := ForLanguage(localize.Welsh, "", "", "")
+
+	assert.Equal(t, "Trydydd Parti", fns["objectionType"].(func(string) string)("thirdParty"))
+	assert.Equal(t, "ni chadarnhawyd", fns["resolutionOutcome"].(func(string) string)("notUpheld"))
+	assert.Equal(t, "Adran y rhoddwr", fns["progressIndicatorContext"].(func(string) string)("DONOR"))
+	assert.Equal(t, "Heb ddechrau", fns["progressIndicatorStatus"].(func(string) string)("CANNOT_START"))
+	assert.Equal(t, "dangosydd HEB EI ADNABOD: foo", fns["progressIndicatorContext"].(func(string) string)("foo"))
+}
+
+func TestLabelsAreTranslated(t *testing.T) {
+	englishFns := All("", "", "")
+	var labels []string
+
+	for fnName, inputs := range map[string][]string{
+		"objectionType":            {"factual", "prescribed", "thirdParty", ""},
+		"resolutionOutcome":        {"upheld", "notUpheld", ""},
+		"progressIndicatorContext": {"FEES", "DONOR", "DONOR_ID", "CERTIFICATE_PROVIDER_ID", "CERTIFICATE_PROVIDER_SIGNATURE", "ATTORNEY_SIGNATURES", "PREREGISTRATION_NOTICES", "REGISTRATION_NOTICES", "RESTRICTIONS_AND_CONDITIONS", ""},
+		"progressIndicatorStatus":  {"IN_PROGRESS", "COMPLETE", "CANNOT_START", ""},
+	} {
+		fn := englishFns[fnName].(func(string) string)
+		for _, input := range inputs {
+			labels = append(labels, fn(input))
+		}
+	}
+
+	for status := shared.CaseStatusTypeRegistered; status <= shared.CaseStatusTypeProcessing; status++ {
+		labels = append(labels, status.ReadableString())
+	}
+
+	ruleTypes := []sirius.AnomalyRuleType{
+		sirius.Empty,
+		sirius.LastNameMatchesAttorney,
+		sirius.LastNameMatchesDonor,
+		sirius.NoCountry,
+		sirius.InvalidAddress,
+		sirius.DonorIdAndSignedDateFarApart,
+		sirius.CpIdAndSignedDateFarApart,
+		sirius.CpSignedTooLate,
+		sirius.AttorneySignedTooLate,
+	}
+	afo := sirius.AnomaliesForObject{}
+	for _, whoHasTheAnomaly := range []string{"", "attorney's", "certificate provider's"} {
+		for _, ruleType := range ruleTypes {
+			labels = append(labels, afo.GetHintTextForAnomalyField([]sirius.Anomaly{{RuleType: ruleType}}, whoHasTheAnomaly))
+		}
+		labels = append(labels, afo.GetHintTextForAnomalyField([]sirius.Anomaly{{RuleType: sirius.LastNameMatchesDonor}, {RuleType: sirius.LastNameMatchesAttorney}}, whoHasTheAnomaly))
+		labels = append(labels, afo.GetHintTextForAnomalyField([]sirius.Anomaly{{RuleType: sirius.CpIdAndSignedDateFarApart}, {RuleType: sirius.CpSignedTooLate}}, whoHasTheAnomaly))
+	}
+
+	for _, label := range labels {
+		assert.True(t, localize.HasTranslation(localize.Welsh, label), "untranslated label %q", label)
+	}
+}
+
 func TestCaseLabel(t *testing.T) {
 	expectations := map[string]string{
 		"EPA": "colour-sirius-brown",
@@ -499,6 +573,13 @@ func TestFee(t *testing.T) {
 	assert.Equal(t, expected, val)
 }
 
+func TestMoney(t *testing.T) {
+	fns := All("", "", "")
+	fn := fns["money"].(func(shared.Money) string)
+
+	assert.Equal(t, "£1,234.50", fn(shared.Money(123450)))
+}
+
 func TestFeeFromFloat(t *testing.T) {
 	fns := All("", "", "")
 	fn := fns["feeFromFloat"].(func(float64) string)
@@ -508,6 +589,24 @@ func TestFeeFromFloat(t *testing.T) {
 	assert.Equal(t, expected, val)
 }
 
+func TestRefundFromFloat(t *testing.T) {
+	fns := All("", "", "")
+	fn := fns["refundFromFloat"].(func(float64) string)
+
+	assert.Equal(t, "10.50", fn(float64(-1050)))
+	assert.Equal(t, "10.50", fn(float64(1050)))
+}
+
+func TestRefundStatus(t *testing.T) {
+	fns := All("", "", "")
+	fn := fns["refundStatus"].(func(any) string)
+
+	assert.Equal(t, "Requested", fn("REQUESTED"))
+	assert.Equal(t, "Approved", fn("APPROVED"))
+	assert.Equal(t, "Issued", fn("ISSUED"))
+	assert.Equal(t, "Issued", fn(nil))
+}
+
 func TestFormatDateForAnEmptyDate(t *testing.T) {
 	fns := All("", "", "")
 	fn := fns["formatDate"].(func(sirius.DateString) (string, error))
@@ -625,6 +724,14 @@ func TestFilterContent(t *testing.T) {
 	assert.Equal(t, "<>Testing<!DOCTYPE html><html lang=\"en\">!@£$%^&*()<>", val)
 }
 
+func TestRichText(t *testing.T) {
+	fns := All("", "", "")
+	fn := fns["richText"].(func(string) template.HTML)
+
+	val := fn(`<p><strong>Caller:</strong> Jo</p><script>alert(1)</script>`)
+	assert.Equal(t, template.HTML("<p><strong>Caller:</strong> Jo</p>alert(1)"), val)
+}
+
 func TestAbs(t *testing.T) {
 	fns := All("", "", "")
 	fn := fns["abs"].(func(int) int)
@@ -12,6 +12,8 @@ import (
 	"github.com/ministryofjustice/opg-go-common/env"
 	"github.com/ministryofjustice/opg-go-common/telemetry"
 	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/companies"
+	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/localize"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/server"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/sirius"
 	"github.com/ministryofjustice/opg-sirius-lpa-frontend/internal/templatefn"
@@ -36,15 +38,19 @@ func run(ctx context.Context, logger *slog.Logger) error {
 	siriusPublicURL := env.Get("SIRIUS_PUBLIC_URL", "")
 	prefix := env.Get("PREFIX", "")
 	exportTraces := env.Get("TRACING_ENABLED", "0") == "1"
+	companyRegistryFile := env.Get("COMPANY_REGISTRY_FILE", "")
 
 	staticHash, err := dirhash.HashDir(webDir+"/static", webDir, dirhash.DefaultHash)
 	if err != nil {
 		return err
 	}
 
-	tmpls, err := template.Parse(webDir+"/template", templatefn.All(siriusPublicURL, prefix, staticHash))
-	if err != nil {
-		return err
+	tmpls := server.LocalisedTemplates{}
+	for _, lang := range localize.Supported {
+		tmpls[lang], err = template.Parse(webDir+"/template", templatefn.ForLanguage(lang, siriusPublicURL, prefix, staticHash))
+		if err != nil {
+			return err
+		}
 	}
 
 	shutdown, err := telemetry.StartTracerProvider(ctx, logger, exportTraces)
@@ -58,9 +64,17 @@ func run(ctx context.Context, logger *slog.Logger) error {
 
 	client := sirius.NewClient(httpClient, siriusURL)
 
+	var companyRegistry companies.CompanyRegistry
+	if companyRegistryFile != "" {
+		companyRegistry, err = companies.NewFileRegistry(companyRegistryFile)
+		if err != nil {
+			return err
+		}
+	}
+
 	server := &http.Server{
 		Addr:              ":" + port,
-		Handler:           server.New(logger, client, tmpls, prefix, siriusPublicURL, webDir),
+		Handler:           server.New(logger, client, companyRegistry, tmpls, prefix, siriusPublicURL, webDir),
 		ReadHeaderTimeout: 20 * time.Second,
 		WriteTimeout:      60 * time.Second,
 	}
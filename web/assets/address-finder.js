@@ -10,6 +10,8 @@ import { nodeListForEach } from "./lib/nodeListForEach";
  * @param {HTMLElement} $module
  * @param {Options} options
  */
+class LookupUnavailableError extends Error {}
+
 function AddressFinder($module, options) {
   this.$module = $module;
   this.results = [];
@@ -38,6 +40,10 @@ function AddressFinder($module, options) {
   this.$dropdown = $container.querySelector("select");
   /** @type {HTMLDivElement} */
   this.$error = $container.querySelector(".govuk-error-message");
+  /** @type {HTMLSpanElement} */
+  this.$errorText = $container.querySelector(".address-finder__error-text");
+  /** @type {HTMLDetailsElement} */
+  this.$details = $container.querySelector("details");
   /** @type {HTMLButtonElement} */
   this.$button = $container.querySelector("button");
   /** @type {HTMLParagraphElement} */
@@ -70,6 +76,12 @@ function AddressFinder($module, options) {
   });
 }
 
+AddressFinder.notFoundMessage =
+  "No matching address found. Please try again using a UK postcode or enter the address manually";
+
+AddressFinder.unavailableMessage =
+  "Postcode lookup is not available at the moment. Enter the address manually";
+
 AddressFinder.template = (id) => `
   <div class="govuk-form-group govuk-!-margin-bottom-4">
     <label class="govuk-label" for="f-${id}-input"></label>
@@ -78,7 +90,7 @@ AddressFinder.template = (id) => `
     </div>
     <p id="f-${id}-error" class="govuk-error-message govuk-!-display-none">
       <span class="govuk-visually-hidden">Error:</span>
-      No matching address found. Please try again using a UK postcode or enter the address manually
+      <span class="address-finder__error-text"></span>
     </p>
     <div class="address-finder__container">
       <input
@@ -122,7 +134,7 @@ AddressFinder.editModeTemplate = (id) => `
       </div>
       <p id="f-${id}-error" class="govuk-error-message govuk-!-display-none">
         <span class="govuk-visually-hidden">Error:</span>
-        No matching address found. Please try again using a UK postcode or enter the address manually
+        <span class="address-finder__error-text"></span>
       </p>
       <div class="address-finder__container">
         <input
@@ -151,7 +163,8 @@ AddressFinder.prototype.showControls = function (e) {
   this.$linkContainer?.classList.add("govuk-!-display-none");
 };
 
-AddressFinder.prototype.showError = function () {
+AddressFinder.prototype.showError = function (message) {
+  this.$errorText.innerText = message;
   this.$input.classList.add("govuk-input--error");
   this.$input.setAttribute(
     "aria-describedby",
@@ -195,8 +208,17 @@ AddressFinder.prototype.handleSearch = function () {
 
   this.resetError();
 
-  fetch(`${this.baseUrl}/search-postcode?postcode=${this.$input.value}`)
-    .then((r) => r.json())
+  fetch(
+    `${this.baseUrl}/search-postcode?postcode=${encodeURIComponent(this.$input.value)}`,
+    { headers: { Accept: "application/json" } },
+  )
+    .then((r) => {
+      if (r.status === 503) {
+        throw new LookupUnavailableError();
+      }
+
+      return r.json();
+    })
     .then((results) => {
       if (!results || !Array.isArray(results)) {
         throw new Error("No results found");
@@ -229,10 +251,21 @@ AddressFinder.prototype.handleSearch = function () {
     .catch((err) => {
       this.$button.disabled = false;
 
-      this.showError();
+      if (err instanceof LookupUnavailableError) {
+        this.showError(AddressFinder.unavailableMessage);
+        this.showManualEntry();
+      } else {
+        this.showError(AddressFinder.notFoundMessage);
+      }
     });
 };
 
+AddressFinder.prototype.showManualEntry = function () {
+  if (this.$details) {
+    this.$details.open = true;
+  }
+};
+
 AddressFinder.prototype.underwriteValue = function (field, value) {
   let $input = this.$module.querySelector(`[name="${field}"]`);
   if (!$input) {
@@ -2,6 +2,7 @@ import hugerte from "hugerte/hugerte.min.js";
 import "hugerte/icons/default";
 import "hugerte/themes/silver";
 import "hugerte/plugins/lists";
+import "hugerte/plugins/link";
 import "hugerte/models/dom";
 
 const textEditor = () => {
@@ -28,6 +29,33 @@ const textEditor = () => {
       ? "app-!-html-class--dark"
       : "",
   });
+
+  hugerte.remove("textarea[data-rich-text]");
+
+  hugerte.init({
+    selector: "textarea[data-rich-text]",
+    menubar: false,
+    statusbar: false,
+    toolbar: "bold italic | bullist numlist | link",
+    plugins: "lists link",
+    link_default_protocol: "https",
+    link_target_list: false,
+    paste_as_text: true,
+    browser_spellcheck: true,
+    gecko_spellcheck: true,
+    height: 200,
+    content_css: prefix + "/stylesheets/all.css",
+    base_url: prefix + "/javascript",
+    body_class: document.documentElement.classList.contains(
+      "app-!-html-class--dark",
+    )
+      ? "app-!-html-class--dark"
+      : "",
+    setup: (editor) => {
+      // keep the textarea up to date so the form posts the formatted text
+      editor.on("change input", () => editor.save());
+    },
+  });
 };
 
 export default textEditor;